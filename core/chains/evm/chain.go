@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/url"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -23,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -49,16 +51,19 @@ type chain struct {
 	id  *big.Int
 	cfg evmconfig.ChainScopedConfig
 	// https://app.shortcut.com/chainlinklabs/story/33622/remove-legacy-config - immutability becomes default
-	cfgImmutable    bool // toml config is immutable
-	client          evmclient.Client
-	txm             txmgr.TxManager
-	logger          logger.Logger
-	headBroadcaster httypes.HeadBroadcaster
-	headTracker     httypes.HeadTracker
-	logBroadcaster  log.Broadcaster
-	logPoller       logpoller.LogPoller
-	balanceMonitor  monitor.BalanceMonitor
-	keyStore        keystore.Eth
+	cfgImmutable     bool // toml config is immutable
+	client           evmclient.Client
+	txm              txmgr.TxManager
+	logger           logger.Logger
+	headBroadcaster  httypes.HeadBroadcaster
+	headTracker      httypes.HeadTracker
+	logBroadcaster   log.Broadcaster
+	logPoller        logpoller.LogPoller
+	balanceMonitor   monitor.BalanceMonitor
+	walletFunder     services.ServiceCtx
+	gasPriceAdjuster services.ServiceCtx
+	keyStore         keystore.Eth
+	startedAt        time.Time
 }
 
 type errChainDisabled struct {
@@ -119,7 +124,7 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 	}
 
 	db := opts.DB
-	headBroadcaster := headtracker.NewHeadBroadcaster(l)
+	headBroadcaster := headtracker.NewHeadBroadcaster(l, cfg)
 	headSaver := headtracker.NullSaver
 	var headTracker httypes.HeadTracker
 	if !cfg.EVMRPCEnabled() {
@@ -127,12 +132,12 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 	} else if opts.GenHeadTracker == nil {
 		orm := headtracker.NewORM(db, l, cfg, *chainID)
 		headSaver = headtracker.NewHeadSaver(l, orm, cfg)
-		headTracker = headtracker.NewHeadTracker(l, client, cfg, headBroadcaster, headSaver)
+		headTracker = headtracker.NewHeadTracker(l, client, cfg, headBroadcaster, headSaver, orm)
 	} else {
 		headTracker = opts.GenHeadTracker(chainID, headBroadcaster)
 	}
 
-	var logPoller logpoller.LogPoller = logpoller.NewLogPoller(logpoller.NewORM(chainID, db, l, cfg), client, l, cfg.EvmLogPollInterval(), int64(cfg.EvmFinalityDepth()), int64(cfg.EvmLogBackfillBatchSize()), int64(cfg.EvmRPCDefaultBatchSize()), int64(cfg.EvmLogKeepBlocksDepth()))
+	var logPoller logpoller.LogPoller = logpoller.NewLogPoller(logpoller.NewORM(chainID, db, l, cfg, pg.WithDefaultQueryTimeout(cfg.EvmDatabaseQueryTimeout())), client, l, cfg.EvmLogPollInterval(), int64(cfg.EvmFinalityDepth()), int64(cfg.EvmLogBackfillBatchSize()), int64(cfg.EvmRPCDefaultBatchSize()), int64(cfg.EvmLogKeepBlocksDepth()), cfg.EvmLogTTL(), cfg.EvmLogPruneInterval(), cfg.EvmLogFetcherUseBlockHash(), headSaver)
 	if opts.GenLogPoller != nil {
 		logPoller = opts.GenLogPoller(chainID)
 	}
@@ -142,7 +147,15 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 		txm = &txmgr.NullTxManager{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
 	} else if opts.GenTxManager == nil {
 		checker := &txmgr.CheckerFactory{Client: client}
-		txm = txmgr.NewTxm(db, client, cfg, opts.KeyStore, opts.EventBroadcaster, l, checker, logPoller)
+		txmClient := client
+		if sequencerURL := cfg.EvmL2SequencerHTTPURL(); sequencerURL != "" {
+			u, err2 := url.Parse(sequencerURL)
+			if err2 != nil {
+				return nil, errors.Wrapf(err2, "invalid L2 sequencer URL for chain with ID %s", chainID.String())
+			}
+			txmClient = evmclient.NewL2SequencerTxSender(l, client, *u)
+		}
+		txm = txmgr.NewTxm(db, txmClient, cfg, opts.KeyStore, opts.EventBroadcaster, l, checker, logPoller)
 	} else {
 		txm = opts.GenTxManager(chainID)
 	}
@@ -161,6 +174,16 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
+	var walletFunder services.ServiceCtx
+	if cfg.EVMRPCEnabled() && cfg.EvmAutoWalletFundEnabled() {
+		walletFunder = monitor.NewWalletFunder(client, opts.KeyStore, txm, txmgr.NewORM(db, l, cfg), cfg, l)
+	}
+
+	var gasPriceAdjuster services.ServiceCtx
+	if cfg.EVMRPCEnabled() && cfg.EvmGasPriceAutoAdjust() {
+		gasPriceAdjuster = txmgr.NewAutoAdjustGasPriceDefault(l, db, cfg, *chainID)
+	}
+
 	var logBroadcaster log.Broadcaster
 	if !cfg.EVMRPCEnabled() {
 		logBroadcaster = &log.NullBroadcaster{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
@@ -179,17 +202,19 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 	headBroadcaster.Subscribe(logBroadcaster)
 
 	return &chain{
-		id:              chainID,
-		cfg:             cfg,
-		client:          client,
-		txm:             txm,
-		logger:          l,
-		headBroadcaster: headBroadcaster,
-		headTracker:     headTracker,
-		logBroadcaster:  logBroadcaster,
-		logPoller:       logPoller,
-		balanceMonitor:  balanceMonitor,
-		keyStore:        opts.KeyStore,
+		id:               chainID,
+		cfg:              cfg,
+		client:           client,
+		txm:              txm,
+		logger:           l,
+		headBroadcaster:  headBroadcaster,
+		headTracker:      headTracker,
+		logBroadcaster:   logBroadcaster,
+		logPoller:        logPoller,
+		balanceMonitor:   balanceMonitor,
+		walletFunder:     walletFunder,
+		gasPriceAdjuster: gasPriceAdjuster,
+		keyStore:         opts.KeyStore,
 	}, nil
 }
 
@@ -201,6 +226,13 @@ func (c *chain) Start(ctx context.Context) error {
 		if err := c.client.Dial(ctx); err != nil {
 			return errors.Wrap(err, "failed to dial ethclient")
 		}
+		// Must happen before the txm and wallet funder start, so that they
+		// pick up the new key
+		if c.cfg.EvmAutoCreateSendingKey() {
+			if err := c.keyStore.EnsureKeys(c.id); err != nil {
+				return errors.Wrap(err, "failed to create sending key")
+			}
+		}
 		// We do not start the log poller here, it gets
 		// started after the jobs so they have a chance to apply their filters.
 		var ms services.MultiStart
@@ -212,6 +244,17 @@ func (c *chain) Start(ctx context.Context) error {
 				return err
 			}
 		}
+		if c.walletFunder != nil {
+			if err := ms.Start(ctx, c.walletFunder); err != nil {
+				return err
+			}
+		}
+		if c.gasPriceAdjuster != nil {
+			if err := ms.Start(ctx, c.gasPriceAdjuster); err != nil {
+				return err
+			}
+		}
+		c.startedAt = time.Now()
 
 		return nil
 	})
@@ -221,9 +264,17 @@ func (c *chain) Close() error {
 	return c.StopOnce("Chain", func() (merr error) {
 		c.logger.Debug("Chain: stopping")
 
+		if c.gasPriceAdjuster != nil {
+			c.logger.Debug("Chain: stopping gas price adjuster")
+			merr = c.gasPriceAdjuster.Close()
+		}
+		if c.walletFunder != nil {
+			c.logger.Debug("Chain: stopping wallet funder")
+			merr = multierr.Combine(merr, c.walletFunder.Close())
+		}
 		if c.balanceMonitor != nil {
 			c.logger.Debug("Chain: stopping balance monitor")
-			merr = c.balanceMonitor.Close()
+			merr = multierr.Combine(merr, c.balanceMonitor.Close())
 		}
 		c.logger.Debug("Chain: stopping logBroadcaster")
 		merr = multierr.Combine(merr, c.logBroadcaster.Close())
@@ -251,23 +302,48 @@ func (c *chain) Ready() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Ready())
 	}
+	if c.walletFunder != nil {
+		merr = multierr.Combine(merr, c.walletFunder.Ready())
+	}
+	if c.gasPriceAdjuster != nil {
+		merr = multierr.Combine(merr, c.gasPriceAdjuster.Ready())
+	}
 	return
 }
 
 func (c *chain) Healthy() (merr error) {
+	htErr := c.headTracker.Healthy()
 	merr = multierr.Combine(
 		c.StartStopOnce.Healthy(),
 		c.txm.Healthy(),
 		c.headBroadcaster.Healthy(),
-		c.headTracker.Healthy(),
+		htErr,
 		c.logBroadcaster.Healthy(),
 	)
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Healthy())
 	}
+	if c.walletFunder != nil {
+		merr = multierr.Combine(merr, c.walletFunder.Healthy())
+	}
+	if c.gasPriceAdjuster != nil {
+		merr = multierr.Combine(merr, c.gasPriceAdjuster.Healthy())
+	}
+	// a re-org halt needs an operator to acknowledge it, so waiting out the
+	// grace period would only hide it
+	var haltErr headtracker.ReorgHaltedError
+	if merr != nil && !errors.As(htErr, &haltErr) && c.StartStopOnce.Healthy() == nil && c.inHealthCheckGracePeriod() {
+		c.logger.Debugw("Chain is unhealthy, but still within health check grace period", "err", merr, "gracePeriod", c.cfg.EvmHealthCheckGracePeriod())
+		return nil
+	}
 	return
 }
 
+// inHealthCheckGracePeriod must only be called once the chain has started.
+func (c *chain) inHealthCheckGracePeriod() bool {
+	return time.Since(c.startedAt) < c.cfg.EvmHealthCheckGracePeriod()
+}
+
 func (c *chain) ID() *big.Int                        { return c.id }
 func (c *chain) Client() evmclient.Client            { return c.client }
 func (c *chain) Config() evmconfig.ChainScopedConfig { return c.cfg }
@@ -309,5 +385,9 @@ func newPrimary(cfg evmclient.NodeConfig, lggr logger.Logger, n *v2.Node, id int
 		return nil, errors.New("cannot cast send-only node to primary")
 	}
 
-	return evmclient.NewNode(cfg, lggr, (url.URL)(*n.WSURL), (*url.URL)(n.HTTPURL), *n.Name, id, chainID), nil
+	var priority uint8
+	if n.Priority != nil {
+		priority = *n.Priority
+	}
+	return evmclient.NewNode(cfg, lggr, (url.URL)(*n.WSURL), (*url.URL)(n.HTTPURL), *n.Name, id, chainID, priority), nil
 }
@@ -0,0 +1,60 @@
+package evm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configmocks "github.com/smartcontractkit/chainlink/core/chains/evm/config/mocks"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker"
+	htmocks "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/mocks"
+	logmocks "github.com/smartcontractkit/chainlink/core/chains/evm/log/mocks"
+	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestChain_Healthy_GracePeriod(t *testing.T) {
+	t.Parallel()
+
+	newChain := func(t *testing.T, startedAt time.Time, headTrackerErr error) *chain {
+		cfg := configmocks.NewChainScopedConfig(t)
+		cfg.On("EvmHealthCheckGracePeriod").Return(time.Hour).Maybe()
+		txm := txmmocks.NewTxManager(t)
+		txm.On("Healthy").Return(nil)
+		hb := htmocks.NewHeadBroadcaster(t)
+		hb.On("Healthy").Return(nil)
+		ht := htmocks.NewHeadTracker(t)
+		ht.On("Healthy").Return(headTrackerErr)
+		lb := logmocks.NewBroadcaster(t)
+		lb.On("Healthy").Return(nil)
+		c := &chain{
+			cfg:             cfg,
+			txm:             txm,
+			logger:          logger.TestLogger(t),
+			headBroadcaster: hb,
+			headTracker:     ht,
+			logBroadcaster:  lb,
+			startedAt:       startedAt,
+		}
+		require.NoError(t, c.StartOnce("Chain", func() error { return nil }))
+		return c
+	}
+
+	t.Run("hides errors within the grace period", func(t *testing.T) {
+		c := newChain(t, time.Now(), errors.New("Listener is not receiving heads"))
+		assert.NoError(t, c.Healthy())
+	})
+
+	t.Run("reports errors after the grace period", func(t *testing.T) {
+		c := newChain(t, time.Now().Add(-2*time.Hour), errors.New("Listener is not receiving heads"))
+		assert.EqualError(t, c.Healthy(), "Listener is not receiving heads")
+	})
+
+	t.Run("never hides a re-org halt", func(t *testing.T) {
+		c := newChain(t, time.Now(), headtracker.ReorgHaltedError{Reason: "halted after a re-org"})
+		assert.EqualError(t, c.Healthy(), "halted after a re-org")
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
 	v2 "github.com/smartcontractkit/chainlink/core/chains/evm/config/v2"
 	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
@@ -84,23 +85,12 @@ func (cll *chainSet) Start(ctx context.Context) error {
 	if !cll.opts.Config.EVMRPCEnabled() {
 		cll.logger.Warn("EVM RPC connections are disabled. Chainlink will not connect to any EVM RPC node.")
 	}
-	if cll.immutable {
-		var ms services.MultiStart
-		for id, c := range cll.Chains() {
-			if err := ms.Start(ctx, c); err != nil {
-				return errors.Wrapf(err, "failed to start chain %q", id)
-			}
-			cll.startedChains = append(cll.startedChains, c)
-		}
-	} else {
-		for _, c := range cll.Chains() {
-			if err := c.Start(ctx); err != nil {
-				id := c.ID().String()
-				cll.logger.Criticalw(fmt.Sprintf("EVM: Chain with ID %s failed to start. You will need to fix this issue and restart the Chainlink node before any services that use this chain will work properly. Got error: %v", id, err), "evmChainID", id, "err", err)
-				continue
-			}
-			cll.startedChains = append(cll.startedChains, c)
-		}
+	// Chains are started concurrently, and only if none of them start is it
+	// fatal to the node, since the remaining chains can still be served.
+	started, err := startChains(ctx, cll.logger, cll.Chains())
+	cll.startedChains = started
+	if err != nil && len(started) == 0 {
+		return errors.Wrap(err, "failed to start any EVM chain")
 	}
 	evmChainIDs := make([]*big.Int, len(cll.startedChains))
 	for i, c := range cll.startedChains {
@@ -209,6 +199,12 @@ func (cll *chainSet) Add(ctx context.Context, id utils.Big, config *types.ChainC
 		return types.DBChain{}, errors.Errorf("chain already exists with id %s", id.String())
 	}
 
+	// Reject an invalid config before it is persisted, rather than saving a
+	// chain which then fails to start
+	if err := cll.validateConfig(id, config); err != nil {
+		return types.DBChain{}, err
+	}
+
 	dbchain, err := cll.opts.ORM.CreateChain(id, config)
 	if err != nil {
 		return types.DBChain{}, err
@@ -216,6 +212,20 @@ func (cll *chainSet) Add(ctx context.Context, id utils.Big, config *types.ChainC
 	return dbchain, cll.initializeChain(ctx, &dbchain)
 }
 
+// validateConfig applies the same validation to config as newDBChain does
+// when the chain is started
+func (cll *chainSet) validateConfig(id utils.Big, config *types.ChainCfg) error {
+	var cfg types.ChainCfg
+	if config != nil {
+		cfg = *config
+	}
+	lggr := cll.logger.With("evmChainID", id.String())
+	if err := evmconfig.NewChainScopedConfig(id.ToInt(), cfg, cll.opts.ORM, lggr, cll.opts.Config).Validate(); err != nil {
+		return errors.Wrapf(err, "cannot create new chain with ID %s, config validation failed", id.String())
+	}
+	return nil
+}
+
 func (cll *chainSet) Remove(id utils.Big) error {
 	if cll.immutable {
 		return cfgv2.ErrUnsupported
@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
@@ -20,6 +22,7 @@ import (
 	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -126,3 +129,67 @@ func TestAddClose(t *testing.T) {
 	assert.Error(t, chains[0].Ready())
 	assert.Error(t, chains[1].Ready())
 }
+
+func TestAutoCreateSendingKey(t *testing.T) {
+	t.Parallel()
+
+	newId := testutils.NewRandomEVMChainID()
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		enabled, autoCreate, disabled := true, true, false
+		c.EVM[0].AutoCreateSendingKey = &autoCreate
+		// Avoid RPC calls for the new key
+		c.EVM[0].NonceAutoSync = &disabled
+		c.EVM[0].BalanceMonitor.Enabled = &disabled
+		c.EVM = append(c.EVM, &v2.EVMConfig{ChainID: utils.NewBig(newId), Enabled: &enabled, Chain: v2.DefaultsFrom(nil, nil)})
+	})
+	db := pgtest.NewSqlxDB(t)
+	kst := cltest.NewKeyStore(t, db, cfg)
+	require.NoError(t, kst.Unlock(cltest.Password))
+
+	opts, _, _ := evmtest.NewChainSetOpts(t, evmtest.TestChainOpts{DB: db, KeyStore: kst.Eth(), GeneralConfig: cfg})
+	opts.GenEthClient = func(*big.Int) evmclient.Client {
+		return cltest.NewEthMocksWithStartupAssertions(t)
+	}
+	cfgs := cfg.(v2.HasEVMConfigs).EVMConfigs()
+	chainSet, err := evm.NewTOMLChainSet(testutils.Context(t), opts, cfgs)
+	require.NoError(t, err)
+
+	require.NoError(t, chainSet.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, chainSet.Close()) })
+
+	states, err := kst.Eth().GetStatesForChain(&cltest.FixtureChainID)
+	require.NoError(t, err)
+	assert.Len(t, states, 1)
+
+	// Not enabled for the other chain
+	states, err = kst.Eth().GetStatesForChain(newId)
+	require.NoError(t, err)
+	assert.Len(t, states, 0)
+}
+
+func TestStartChains(t *testing.T) {
+	t.Parallel()
+
+	newChain := func(id int64, err error) *evmmocks.Chain {
+		c := evmmocks.NewChain(t)
+		c.On("ID").Return(big.NewInt(id))
+		c.On("Start", mock.Anything).Return(err).Once()
+		return c
+	}
+
+	t.Run("returns the started chains in order", func(t *testing.T) {
+		c1, c2, c3 := newChain(1, nil), newChain(2, errors.New("kaboom")), newChain(3, nil)
+
+		started, err := evm.StartChains(testutils.Context(t), logger.TestLogger(t), []evm.Chain{c1, c2, c3})
+		assert.EqualError(t, err, `failed to start chain "2": kaboom`)
+		assert.Equal(t, []evm.Chain{c1, c3}, started)
+	})
+
+	t.Run("returns all errors if no chains start", func(t *testing.T) {
+		c1, c2 := newChain(1, errors.New("foo")), newChain(2, errors.New("bar"))
+
+		started, err := evm.StartChains(testutils.Context(t), logger.TestLogger(t), []evm.Chain{c1, c2})
+		assert.EqualError(t, err, `failed to start chain "1": foo; failed to start chain "2": bar`)
+		assert.Empty(t, started)
+	})
+}
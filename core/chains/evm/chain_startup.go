@@ -0,0 +1,54 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+var promChainStartDuration = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "evm_chain_start_duration_seconds",
+	Help: "How long the EVM chain took to start, in seconds",
+}, []string{"evmChainID"})
+
+// startChains starts chains concurrently, so that a chain which is slow to
+// dial or sync does not hold up the others. It returns the chains which
+// started, in their original order, and the combined errors of those which
+// did not. Each failure is also logged.
+func startChains(ctx context.Context, lggr logger.Logger, chains []Chain) (started []Chain, err error) {
+	errs := make([]error, len(chains))
+	var wg sync.WaitGroup
+	wg.Add(len(chains))
+	for i, c := range chains {
+		go func(i int, c Chain) {
+			defer wg.Done()
+			id := c.ID().String()
+			start := time.Now()
+			if errs[i] = c.Start(ctx); errs[i] != nil {
+				lggr.Criticalw(fmt.Sprintf("EVM: Chain with ID %s failed to start. You will need to fix this issue and restart the Chainlink node before any services that use this chain will work properly. Got error: %v", id, errs[i]), "evmChainID", id, "err", errs[i])
+				return
+			}
+			elapsed := time.Since(start)
+			promChainStartDuration.WithLabelValues(id).Set(elapsed.Seconds())
+			lggr.Debugw("EVM: Started chain", "evmChainID", id, "elapsed", elapsed)
+		}(i, c)
+	}
+	wg.Wait()
+
+	for i, c := range chains {
+		if errs[i] != nil {
+			err = multierr.Append(err, errors.Wrapf(errs[i], "failed to start chain %q", c.ID().String()))
+			continue
+		}
+		started = append(started, c)
+	}
+	return
+}
@@ -125,4 +125,5 @@ func (e *erroringNode) DeclareOutOfSync()            {}
 func (e *erroringNode) DeclareInSync()               {}
 func (e *erroringNode) DeclareUnreachable()          {}
 func (e *erroringNode) ID() int32                    { return 0 }
+func (e *erroringNode) Priority() uint8              { return 0 }
 func (e *erroringNode) NodeStates() map[int32]string { return nil }
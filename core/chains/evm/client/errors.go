@@ -51,6 +51,7 @@ const (
 	L2FeeTooHigh
 	L2Full
 	TransactionAlreadyMined
+	IntrinsicGasTooLow
 	Fatal
 )
 
@@ -66,6 +67,7 @@ var parity = ClientErrors{
 	TransactionAlreadyInMempool:       regexp.MustCompile("Transaction with the same hash was already imported."),
 	TerminallyUnderpriced:             regexp.MustCompile("^Transaction gas price is too low. It does not satisfy your node's minimal gas price"),
 	InsufficientEth:                   regexp.MustCompile("^(Insufficient funds. The account you tried to send transaction from does not have enough funds.|Insufficient balance for transaction.)"),
+	IntrinsicGasTooLow:                regexp.MustCompile("^Transaction gas is too low. There is not enough gas to cover minimal cost of the transaction"),
 	Fatal:                             parFatal,
 }
 
@@ -79,6 +81,7 @@ var geth = ClientErrors{
 	TerminallyUnderpriced:             regexp.MustCompile(`(: |^)transaction underpriced$`),
 	InsufficientEth:                   regexp.MustCompile(`(: |^)(insufficient funds for transfer|insufficient funds for gas \* price \+ value|insufficient balance for transfer)$`),
 	TxFeeExceedsCap:                   regexp.MustCompile(`(: |^)tx fee \([0-9\.]+ [a-zA-Z]+\) exceeds the configured cap \([0-9\.]+ [a-zA-Z]+\)$`),
+	IntrinsicGasTooLow:                regexp.MustCompile(`(: |^)intrinsic gas too low$`),
 	Fatal:                             gethFatal,
 }
 
@@ -92,6 +95,7 @@ var besu = ClientErrors{
 	TerminallyUnderpriced:             regexp.MustCompile(`^Gas price below configured minimum gas price$`),
 	InsufficientEth:                   regexp.MustCompile(`^Upfront cost exceeds account balance$`),
 	TxFeeExceedsCap:                   regexp.MustCompile(`^Transaction fee cap exceeded$`),
+	IntrinsicGasTooLow:                regexp.MustCompile(`^Intrinsic gas exceeds gas limit$`),
 	Fatal:                             besuFatal,
 }
 
@@ -110,6 +114,7 @@ var erigon = ClientErrors{
 	TerminallyUnderpriced:             regexp.MustCompile(`(: |^)transaction underpriced$`),
 	InsufficientEth:                   regexp.MustCompile(`(: |^)(insufficient funds for transfer|insufficient funds for gas \* price \+ value|insufficient balance for transfer)$`),
 	TxFeeExceedsCap:                   regexp.MustCompile(`(: |^)tx fee \([0-9\.]+ [a-zA-Z]+\) exceeds the configured cap \([0-9\.]+ [a-zA-Z]+\)$`),
+	IntrinsicGasTooLow:                regexp.MustCompile(`(: |^)intrinsic gas too low$`),
 	Fatal:                             erigonFatal,
 }
 
@@ -253,6 +258,13 @@ func (s *SendError) IsL2Full() bool {
 	return s.is(L2Full)
 }
 
+// IsIntrinsicGasTooLow indicates that the gas limit of the transaction is too
+// low to cover its intrinsic cost. Resending it with the same gas limit will
+// never succeed, but a higher gas limit might.
+func (s *SendError) IsIntrinsicGasTooLow() bool {
+	return s.is(IntrinsicGasTooLow)
+}
+
 // IsTimeout indicates if the error was caused by an exceeded context deadline
 func (s *SendError) IsTimeout() bool {
 	if s == nil {
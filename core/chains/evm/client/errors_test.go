@@ -245,6 +245,27 @@ func Test_Eth_Errors(t *testing.T) {
 		assert.False(t, err.IsNonceTooLowError())
 		assert.False(t, err.Fatal())
 	})
+
+	t.Run("IsIntrinsicGasTooLow", func(t *testing.T) {
+		assert.False(t, randomError.IsIntrinsicGasTooLow())
+
+		tests := []errorCase{
+			{"intrinsic gas too low", true, "Geth"},
+			{"Intrinsic gas exceeds gas limit", true, "Besu"},
+			{"intrinsic gas too low", true, "Erigon"},
+			{"Transaction gas is too low. There is not enough gas to cover minimal cost of the transaction (minimal: 100 got: 50) Try increasing supplied gas.", true, "Parity"},
+			{"exceeds block gas limit", false, "Geth"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.network, func(t *testing.T) {
+				err = evmclient.NewSendErrorS(test.message)
+				assert.Equal(t, err.IsIntrinsicGasTooLow(), test.expect)
+				err = newSendErrorWrapped(test.message)
+				assert.Equal(t, err.IsIntrinsicGasTooLow(), test.expect)
+			})
+		}
+	})
 }
 
 func Test_Eth_Errors_Fatal(t *testing.T) {
@@ -15,12 +15,20 @@ type TestNodeConfig struct {
 	PollFailureThreshold uint32
 	PollInterval         time.Duration
 	SelectionMode        string
+	RPCMaxInFlight       uint32
+	SyncThreshold        uint32
+	BlockTime            time.Duration
+	ReadTimeoutFactor    float64
 }
 
 func (tc TestNodeConfig) NodeNoNewHeadsThreshold() time.Duration { return tc.NoNewHeadsThreshold }
 func (tc TestNodeConfig) NodePollFailureThreshold() uint32       { return tc.PollFailureThreshold }
 func (tc TestNodeConfig) NodePollInterval() time.Duration        { return tc.PollInterval }
 func (tc TestNodeConfig) NodeSelectionMode() string              { return tc.SelectionMode }
+func (tc TestNodeConfig) EvmRPCMaxInFlight() uint32              { return tc.RPCMaxInFlight }
+func (tc TestNodeConfig) EvmNodeSyncThreshold() uint32           { return tc.SyncThreshold }
+func (tc TestNodeConfig) EvmBlockTime() time.Duration            { return tc.BlockTime }
+func (tc TestNodeConfig) EvmChainReadTimeoutFactor() float64     { return tc.ReadTimeoutFactor }
 
 func NewClientWithTestNode(cfg NodeConfig, lggr logger.Logger, rpcUrl string, rpcHTTPURL *url.URL, sendonlyRPCURLs []url.URL, id int32, chainID *big.Int) (*client, error) {
 	parsed, err := url.ParseRequestURI(rpcUrl)
@@ -32,7 +40,7 @@ func NewClientWithTestNode(cfg NodeConfig, lggr logger.Logger, rpcUrl string, rp
 		return nil, errors.Errorf("ethereum url scheme must be websocket: %s", parsed.String())
 	}
 
-	n := NewNode(cfg, lggr, *parsed, rpcHTTPURL, "eth-primary-0", id, chainID)
+	n := NewNode(cfg, lggr, *parsed, rpcHTTPURL, "eth-primary-0", id, chainID, 0)
 	n.(*node).setLatestReceivedBlockNumber(0)
 	primaries := []Node{n}
 
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	clhttp "github.com/smartcontractkit/chainlink/core/utils/http"
+)
+
+// sequencerSendTimeout bounds how long the sequencer is given to accept a
+// transaction before it is sent to the primary node instead
+const sequencerSendTimeout = 10 * time.Second
+
+// sequencerResponseLimit is the largest sequencer response that is read
+const sequencerResponseLimit = 1 << 20
+
+var _ Client = &L2SequencerTxSender{}
+
+// L2SequencerTxSender wraps a Client and sends transactions directly to the
+// HTTP endpoint of the sequencer of an L2 chain, such as Optimism or
+// Arbitrum, so that they are ordered before the public mempool sees them.
+//
+// If the sequencer cannot be reached or responds with a status other than
+// 200, the transaction is sent with the wrapped Client instead. Errors
+// returned by the sequencer in a 200 response, such as a nonce being too low,
+// are returned as is. All other calls go to the wrapped Client.
+type L2SequencerTxSender struct {
+	Client
+	url        url.URL
+	httpClient *http.Client
+	lggr       logger.Logger
+}
+
+// NewL2SequencerTxSender returns a Client which sends transactions to the
+// sequencer at sequencerURL, falling back to client
+func NewL2SequencerTxSender(lggr logger.Logger, client Client, sequencerURL url.URL) *L2SequencerTxSender {
+	return &L2SequencerTxSender{
+		Client:     client,
+		url:        sequencerURL,
+		httpClient: clhttp.NewUnrestrictedHTTPClient(),
+		lggr:       lggr.Named("L2SequencerTxSender"),
+	}
+}
+
+type sequencerRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type sequencerResponse struct {
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// SendTransaction sends tx to the sequencer, or with the wrapped Client if
+// the sequencer does not accept it
+func (s *L2SequencerTxSender) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	ok, err := s.sendToSequencer(ctx, tx)
+	if ok {
+		return err
+	}
+	s.lggr.Warnw("Failed to send transaction to the sequencer, sending it to the primary node instead", "txHash", tx.Hash(), "err", err)
+	return s.Client.SendTransaction(ctx, tx)
+}
+
+// sendToSequencer returns ok if the sequencer responded with a status of 200,
+// in which case err is the error returned by the sequencer, if any
+func (s *L2SequencerTxSender) sendToSequencer(ctx context.Context, tx *types.Transaction) (ok bool, err error) {
+	rawTx, err := tx.MarshalBinary()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to encode transaction")
+	}
+	body, err := json.Marshal(sequencerRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  "eth_sendRawTransaction",
+		Params:  []interface{}{hexutil.Encode(rawTx)},
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to encode request")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sequencerSendTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url.String(), bytes.NewReader(body))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return false, errors.Wrap(err, "request failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("sequencer responded with status %d", resp.StatusCode)
+	}
+
+	var res sequencerResponse
+	if err = json.NewDecoder(io.LimitReader(resp.Body, sequencerResponseLimit)).Decode(&res); err != nil {
+		return false, errors.Wrap(err, "failed to decode response")
+	}
+	if res.Error != nil {
+		return true, errors.New(res.Error.Message)
+	}
+	return true, nil
+}
@@ -0,0 +1,85 @@
+package client_test
+
+import (
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestL2SequencerTxSender_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	tx := types.NewTransaction(uint64(42), testutils.NewAddress(), big.NewInt(142), 242, big.NewInt(342), []byte{1, 2, 3})
+	rawTx, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	newSequencer := func(t *testing.T, status int, response string) *evmclient.L2SequencerTxSender {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var req struct {
+				Method string   `json:"method"`
+				Params []string `json:"params"`
+			}
+			require.NoError(t, json.Unmarshal(body, &req))
+			assert.Equal(t, "eth_sendRawTransaction", req.Method)
+			assert.Equal(t, []string{hexutil.Encode(rawTx)}, req.Params)
+			w.WriteHeader(status)
+			_, err = w.Write([]byte(response))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(ts.Close)
+
+		client := evmmocks.NewClient(t)
+		if status != http.StatusOK {
+			client.On("SendTransaction", mock.Anything, tx).Return(nil).Once()
+		}
+		return evmclient.NewL2SequencerTxSender(logger.TestLogger(t), client, *cltest.MustParseURL(t, ts.URL))
+	}
+
+	t.Run("sends the transaction to the sequencer", func(t *testing.T) {
+		sender := newSequencer(t, http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":"`+tx.Hash().Hex()+`"}`)
+
+		require.NoError(t, sender.SendTransaction(testutils.Context(t), tx))
+	})
+
+	t.Run("returns errors from the sequencer", func(t *testing.T) {
+		sender := newSequencer(t, http.StatusOK, `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"nonce too low"}}`)
+
+		err := sender.SendTransaction(testutils.Context(t), tx)
+		require.EqualError(t, err, "nonce too low")
+		assert.True(t, evmclient.NewSendError(err).IsNonceTooLowError())
+	})
+
+	t.Run("falls back to the client if the sequencer does not respond with 200", func(t *testing.T) {
+		sender := newSequencer(t, http.StatusServiceUnavailable, "unavailable")
+
+		require.NoError(t, sender.SendTransaction(testutils.Context(t), tx))
+	})
+
+	t.Run("falls back to the client if the sequencer cannot be reached", func(t *testing.T) {
+		client := evmmocks.NewClient(t)
+		client.On("SendTransaction", mock.Anything, tx).Return(errors.New("already known")).Once()
+		sender := evmclient.NewL2SequencerTxSender(logger.TestLogger(t), client, *cltest.MustParseURL(t, "http://127.0.0.1:1"))
+
+		require.EqualError(t, sender.SendTransaction(testutils.Context(t), tx), "already known")
+	})
+}
@@ -92,6 +92,9 @@ type Node interface {
 	// Unique identifier for node
 	ID() int32
 	ChainID() *big.Int
+	// Priority is used by the PriorityOrdered node selector, lower values
+	// are preferred
+	Priority() uint8
 
 	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
 	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
@@ -127,14 +130,15 @@ type rawclient struct {
 // It must have a ws url and may have a http url
 type node struct {
 	utils.StartStopOnce
-	ws      rawclient
-	http    *rawclient
-	lfcLog  logger.Logger
-	rpcLog  logger.Logger
-	name    string
-	id      int32
-	chainID *big.Int
-	cfg     NodeConfig
+	ws       rawclient
+	http     *rawclient
+	lfcLog   logger.Logger
+	rpcLog   logger.Logger
+	name     string
+	id       int32
+	chainID  *big.Int
+	priority uint8
+	cfg      NodeConfig
 
 	state   NodeState
 	stateMu sync.RWMutex
@@ -156,6 +160,11 @@ type node struct {
 	cancelNodeCtx context.CancelFunc
 	// wg waits for subsidiary goroutines
 	wg sync.WaitGroup
+	// inFlight is a semaphore limiting concurrent RPC requests to this node.
+	// nil if there is no limit.
+	inFlight chan struct{}
+	// queryTimeout is the timeout of each RPC request to this node
+	queryTimeout time.Duration
 
 	// nLiveNodes is a passed in function that allows this node to
 	// query a parent object to see how many live nodes there are in total.
@@ -171,20 +180,29 @@ type NodeConfig interface {
 	NodePollFailureThreshold() uint32
 	NodePollInterval() time.Duration
 	NodeSelectionMode() string
+	EvmNodeSyncThreshold() uint32
+	EvmRPCMaxInFlight() uint32
+	EvmBlockTime() time.Duration
+	EvmChainReadTimeoutFactor() float64
 }
 
 // NewNode returns a new *node as Node
-func NewNode(nodeCfg NodeConfig, lggr logger.Logger, wsuri url.URL, httpuri *url.URL, name string, id int32, chainID *big.Int) Node {
+func NewNode(nodeCfg NodeConfig, lggr logger.Logger, wsuri url.URL, httpuri *url.URL, name string, id int32, chainID *big.Int, priority uint8) Node {
 	n := new(node)
 	n.name = name
 	n.id = id
 	n.chainID = chainID
+	n.priority = priority
 	n.cfg = nodeCfg
 	n.ws.uri = wsuri
 	if httpuri != nil {
 		n.http = &rawclient{uri: *httpuri}
 	}
 	n.chStopInFlight = make(chan struct{})
+	if max := nodeCfg.EvmRPCMaxInFlight(); max > 0 {
+		n.inFlight = make(chan struct{}, max)
+	}
+	n.queryTimeout = readTimeout(nodeCfg)
 	n.nodeCtx, n.cancelNodeCtx = context.WithCancel(context.Background())
 	lggr = lggr.Named("Node").With(
 		"nodeTier", "primary",
@@ -994,7 +1012,8 @@ func wrap(err error, tp string) error {
 }
 
 // makeLiveQueryCtx wraps makeQueryCtx but returns error if node is not
-// "alive".
+// "alive". It also blocks until an in-flight slot is available, which is
+// released by calling cancel.
 func (n *node) makeLiveQueryCtx(parentCtx context.Context) (ctx context.Context, cancel context.CancelFunc, err error) {
 	// Need to wrap in mutex because state transition can cancel and replace the
 	// context
@@ -1006,22 +1025,48 @@ func (n *node) makeLiveQueryCtx(parentCtx context.Context) (ctx context.Context,
 	}
 	cancelCh := n.chStopInFlight
 	n.stateMu.RUnlock()
-	ctx, cancel = makeQueryCtx(parentCtx, cancelCh)
+	release, err := n.acquireInFlight(parentCtx, cancelCh)
+	if err != nil {
+		return
+	}
+	ctx, cancel = makeQueryCtx(parentCtx, cancelCh, n.queryTimeout)
+	queryCancel := cancel
+	cancel = func() {
+		queryCancel()
+		release()
+	}
 	return
 }
 
+// acquireInFlight waits for a free in-flight request slot, and returns a func
+// to release it. It returns early with an error if ctx is done or chStop is
+// closed.
+func (n *node) acquireInFlight(ctx context.Context, chStop chan struct{}) (release func(), err error) {
+	if n.inFlight == nil {
+		return func() {}, nil
+	}
+	select {
+	case n.inFlight <- struct{}{}:
+		return func() { <-n.inFlight }, nil
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "timed out waiting for an in-flight RPC slot")
+	case <-chStop:
+		return nil, errors.New("in-flight requests cancelled while waiting for an RPC slot")
+	}
+}
+
 func (n *node) makeQueryCtx(ctx context.Context) (context.Context, context.CancelFunc) {
-	return makeQueryCtx(ctx, n.getChStopInflight())
+	return makeQueryCtx(ctx, n.getChStopInflight(), n.queryTimeout)
 }
 
 // makeQueryCtx returns a context that cancels if:
 // 1. Passed in ctx cancels
 // 2. Passed in channel is closed
-// 3. Default timeout is reached (queryTimeout)
-func makeQueryCtx(ctx context.Context, ch chan struct{}) (context.Context, context.CancelFunc) {
+// 3. The passed in timeout is reached
+func makeQueryCtx(ctx context.Context, ch chan struct{}, timeout time.Duration) (context.Context, context.CancelFunc) {
 	var chCancel, timeoutCancel context.CancelFunc
 	ctx, chCancel = utils.WithCloseChan(ctx, ch)
-	ctx, timeoutCancel = context.WithTimeout(ctx, queryTimeout)
+	ctx, timeoutCancel = context.WithTimeout(ctx, timeout)
 	cancel := func() {
 		chCancel()
 		timeoutCancel()
@@ -1029,6 +1074,18 @@ func makeQueryCtx(ctx context.Context, ch chan struct{}) (context.Context, conte
 	return ctx, cancel
 }
 
+// readTimeout returns the timeout of each RPC request: the expected block
+// time multiplied by EvmChainReadTimeoutFactor, or queryTimeout if either is
+// not set
+func readTimeout(cfg NodeConfig) time.Duration {
+	factor := cfg.EvmChainReadTimeoutFactor()
+	blockTime := cfg.EvmBlockTime()
+	if factor <= 0 || blockTime <= 0 {
+		return queryTimeout
+	}
+	return time.Duration(float64(blockTime) * factor)
+}
+
 func switching(n *node) string {
 	if n.http != nil {
 		return "http"
@@ -1047,3 +1104,7 @@ func (n *node) String() string {
 func (n *node) ID() int32 {
 	return n.id
 }
+
+func (n *node) Priority() uint8 {
+	return n.priority
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"testing"
@@ -27,7 +28,7 @@ func newTestNode(t *testing.T, cfg NodeConfig) *node {
 
 func newTestNodeWithCallback(t *testing.T, cfg NodeConfig, callback testutils.JSONRPCHandler) *node {
 	s := testutils.NewWSServer(t, testutils.FixtureChainID, callback)
-	iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
+	iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID, 0)
 	n := iN.(*node)
 	return n
 }
@@ -198,7 +199,7 @@ func TestUnit_NodeLifecycle_aliveLoop(t *testing.T) {
 				return "", ""
 			})
 
-		iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
+		iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID, 0)
 		n := iN.(*node)
 
 		dial(t, n)
@@ -241,7 +242,7 @@ func TestUnit_NodeLifecycle_aliveLoop(t *testing.T) {
 				return "", ""
 			})
 
-		iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
+		iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID, 0)
 		n := iN.(*node)
 
 		dial(t, n)
@@ -274,7 +275,7 @@ func TestUnit_NodeLifecycle_aliveLoop(t *testing.T) {
 				return "", ""
 			})
 
-		iN := NewNode(pollDisabledCfg, lggr, *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
+		iN := NewNode(pollDisabledCfg, lggr, *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID, 0)
 		n := iN.(*node)
 		n.nLiveNodes = func() int { return 1 }
 		dial(t, n)
@@ -342,7 +343,7 @@ func TestUnit_NodeLifecycle_outOfSyncLoop(t *testing.T) {
 				return "", ""
 			})
 
-		iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
+		iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID, 0)
 		n := iN.(*node)
 
 		dial(t, n)
@@ -388,7 +389,7 @@ func TestUnit_NodeLifecycle_outOfSyncLoop(t *testing.T) {
 				return "", ""
 			})
 
-		iN := NewNode(cfg, lggr, *s.WSURL(), nil, "test node", 0, testutils.FixtureChainID)
+		iN := NewNode(cfg, lggr, *s.WSURL(), nil, "test node", 0, testutils.FixtureChainID, 0)
 		n := iN.(*node)
 
 		start(t, n)
@@ -438,7 +439,7 @@ func TestUnit_NodeLifecycle_outOfSyncLoop(t *testing.T) {
 				return "", ""
 			})
 
-		iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
+		iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID, 0)
 		n := iN.(*node)
 		n.nLiveNodes = func() int { return 0 }
 
@@ -494,7 +495,7 @@ func TestUnit_NodeLifecycle_unreachableLoop(t *testing.T) {
 		cfg := TestNodeConfig{}
 		s := testutils.NewWSServer(t, testutils.FixtureChainID, standardHandler)
 		lggr, observedLogs := logger.TestLoggerObserved(t, zap.ErrorLevel)
-		iN := NewNode(cfg, lggr, *s.WSURL(), nil, "test node", 0, big.NewInt(42))
+		iN := NewNode(cfg, lggr, *s.WSURL(), nil, "test node", 0, big.NewInt(42), 0)
 		n := iN.(*node)
 		defer n.Close()
 		start(t, n)
@@ -513,7 +514,7 @@ func TestUnit_NodeLifecycle_unreachableLoop(t *testing.T) {
 	t.Run("on failed redial, keeps trying to redial", func(t *testing.T) {
 		cfg := TestNodeConfig{}
 		lggr, observedLogs := logger.TestLoggerObserved(t, zap.DebugLevel)
-		iN := NewNode(cfg, lggr, *testutils.MustParseURL(t, "ws://test.invalid"), nil, "test node", 0, big.NewInt(42))
+		iN := NewNode(cfg, lggr, *testutils.MustParseURL(t, "ws://test.invalid"), nil, "test node", 0, big.NewInt(42), 0)
 		n := iN.(*node)
 		defer n.Close()
 		start(t, n)
@@ -565,7 +566,7 @@ func TestUnit_NodeLifecycle_invalidChainIDLoop(t *testing.T) {
 		cfg := TestNodeConfig{}
 		s := testutils.NewWSServer(t, testutils.FixtureChainID, standardHandler)
 		lggr, observedLogs := logger.TestLoggerObserved(t, zap.ErrorLevel)
-		iN := NewNode(cfg, lggr, *s.WSURL(), nil, "test node", 0, big.NewInt(42))
+		iN := NewNode(cfg, lggr, *s.WSURL(), nil, "test node", 0, big.NewInt(42), 0)
 		n := iN.(*node)
 		defer n.Close()
 		dial(t, n)
@@ -579,3 +580,57 @@ func TestUnit_NodeLifecycle_invalidChainIDLoop(t *testing.T) {
 		assert.Equal(t, NodeStateInvalidChainID, n.State())
 	})
 }
+
+func TestUnit_Node_RPCMaxInFlight(t *testing.T) {
+	t.Parallel()
+
+	n := newTestNode(t, TestNodeConfig{RPCMaxInFlight: 1})
+	n.setState(NodeStateAlive)
+
+	_, cancel, err := n.makeLiveQueryCtx(testutils.Context(t))
+	require.NoError(t, err)
+
+	// All slots are taken, so the next caller blocks until its context is done
+	ctx, ctxCancel := context.WithTimeout(testutils.Context(t), 10*time.Millisecond)
+	defer ctxCancel()
+	_, _, err = n.makeLiveQueryCtx(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out waiting for an in-flight RPC slot")
+
+	// Releasing the slot lets the next caller through
+	cancel()
+	_, cancel, err = n.makeLiveQueryCtx(testutils.Context(t))
+	require.NoError(t, err)
+	cancel()
+}
+
+func TestUnit_Node_ReadTimeout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  TestNodeConfig
+		want time.Duration
+	}{
+		{"defaults without a factor", TestNodeConfig{BlockTime: 12 * time.Second}, queryTimeout},
+		{"defaults without a block time", TestNodeConfig{ReadTimeoutFactor: 3}, queryTimeout},
+		{"scales the block time", TestNodeConfig{BlockTime: 12 * time.Second, ReadTimeoutFactor: 3}, 36 * time.Second},
+		{"scales by a fraction", TestNodeConfig{BlockTime: 2 * time.Second, ReadTimeoutFactor: 1.5}, 3 * time.Second},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			n := newTestNode(t, tt.cfg)
+			assert.Equal(t, tt.want, n.queryTimeout)
+
+			n.setState(NodeStateAlive)
+			// Not testutils.Context, whose deadline may be earlier than the timeout
+			ctx, cancel, err := n.makeLiveQueryCtx(context.Background())
+			require.NoError(t, err)
+			defer cancel()
+			deadline, ok := ctx.Deadline()
+			require.True(t, ok)
+			assert.WithinDuration(t, time.Now().Add(tt.want), deadline, time.Second)
+		})
+	}
+}
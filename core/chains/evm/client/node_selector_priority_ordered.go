@@ -0,0 +1,32 @@
+package client
+
+type priorityOrderedNodeSelector struct {
+	nodes         []Node
+	syncThreshold uint32
+}
+
+// NewPriorityOrderedNodeSelector returns a NodeSelector which always picks the
+// alive node with the lowest Priority, so that lower priority nodes are only
+// used as fallbacks. Nodes with equal Priority are preferred in the order they
+// were configured. Nodes which lag more than syncThreshold blocks behind the
+// highest head are skipped.
+func NewPriorityOrderedNodeSelector(nodes []Node, syncThreshold uint32) NodeSelector {
+	return &priorityOrderedNodeSelector{
+		nodes:         nodes,
+		syncThreshold: syncThreshold,
+	}
+}
+
+func (s *priorityOrderedNodeSelector) Select() Node {
+	var node Node
+	for _, n := range aliveSyncedNodes(s.nodes, s.syncThreshold) {
+		if node == nil || n.Priority() < node.Priority() {
+			node = n
+		}
+	}
+	return node
+}
+
+func (s *priorityOrderedNodeSelector) Name() string {
+	return NodeSelectionMode_PriorityOrdered
+}
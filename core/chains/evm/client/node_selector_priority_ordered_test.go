@@ -0,0 +1,72 @@
+package client_test
+
+import (
+	"testing"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityOrderedNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	newNode := func(t *testing.T, state evmclient.NodeState, priority uint8) *evmmocks.Node {
+		node := evmmocks.NewNode(t)
+		node.On("StateAndLatestBlockNumber").Return(state, int64(-1))
+		node.On("Priority").Return(priority).Maybe()
+		return node
+	}
+
+	t.Run("picks the alive node with the lowest priority", func(t *testing.T) {
+		nodes := []evmclient.Node{
+			newNode(t, evmclient.NodeStateAlive, 2),
+			newNode(t, evmclient.NodeStateAlive, 0),
+			newNode(t, evmclient.NodeStateAlive, 1),
+		}
+		selector := evmclient.NewPriorityOrderedNodeSelector(nodes, 0)
+		assert.Equal(t, nodes[1], selector.Select())
+		assert.Equal(t, nodes[1], selector.Select())
+	})
+
+	t.Run("falls back to the next priority if the preferred node is not alive", func(t *testing.T) {
+		nodes := []evmclient.Node{
+			newNode(t, evmclient.NodeStateOutOfSync, 0),
+			newNode(t, evmclient.NodeStateAlive, 2),
+			newNode(t, evmclient.NodeStateAlive, 1),
+		}
+		selector := evmclient.NewPriorityOrderedNodeSelector(nodes, 0)
+		assert.Equal(t, nodes[2], selector.Select())
+	})
+
+	t.Run("prefers configuration order for equal priorities", func(t *testing.T) {
+		nodes := []evmclient.Node{
+			newNode(t, evmclient.NodeStateUnreachable, 0),
+			newNode(t, evmclient.NodeStateAlive, 1),
+			newNode(t, evmclient.NodeStateAlive, 1),
+		}
+		selector := evmclient.NewPriorityOrderedNodeSelector(nodes, 0)
+		assert.Equal(t, nodes[1], selector.Select())
+	})
+
+	t.Run("skips nodes which lag behind the highest head", func(t *testing.T) {
+		lagging := evmmocks.NewNode(t)
+		lagging.On("StateAndLatestBlockNumber").Return(evmclient.NodeStateAlive, int64(89))
+		synced := evmmocks.NewNode(t)
+		synced.On("StateAndLatestBlockNumber").Return(evmclient.NodeStateAlive, int64(100))
+		synced.On("Priority").Return(uint8(1)).Maybe()
+		nodes := []evmclient.Node{lagging, synced}
+		selector := evmclient.NewPriorityOrderedNodeSelector(nodes, 10)
+		assert.Equal(t, nodes[1], selector.Select())
+	})
+
+	t.Run("returns nil if no nodes are alive", func(t *testing.T) {
+		nodes := []evmclient.Node{
+			newNode(t, evmclient.NodeStateOutOfSync, 0),
+			newNode(t, evmclient.NodeStateUnreachable, 1),
+		}
+		selector := evmclient.NewPriorityOrderedNodeSelector(nodes, 0)
+		assert.Nil(t, selector.Select())
+	})
+}
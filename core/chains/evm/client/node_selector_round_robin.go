@@ -4,22 +4,22 @@ import "go.uber.org/atomic"
 
 type roundRobinSelector struct {
 	nodes           []Node
+	syncThreshold   uint32
 	roundRobinCount atomic.Uint32
 }
 
-func NewRoundRobinSelector(nodes []Node) NodeSelector {
+// NewRoundRobinSelector returns a NodeSelector which cycles through the alive
+// nodes, skipping any which lag more than syncThreshold blocks behind the
+// highest head.
+func NewRoundRobinSelector(nodes []Node, syncThreshold uint32) NodeSelector {
 	return &roundRobinSelector{
-		nodes: nodes,
+		nodes:         nodes,
+		syncThreshold: syncThreshold,
 	}
 }
 
 func (s *roundRobinSelector) Select() Node {
-	var liveNodes []Node
-	for _, n := range s.nodes {
-		if n.State() == NodeStateAlive {
-			liveNodes = append(liveNodes, n)
-		}
-	}
+	liveNodes := aliveSyncedNodes(s.nodes, s.syncThreshold)
 
 	nNodes := len(liveNodes)
 	if nNodes == 0 {
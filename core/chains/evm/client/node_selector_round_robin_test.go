@@ -18,15 +18,15 @@ func TestRoundRobinNodeSelector(t *testing.T) {
 		node := evmmocks.NewNode(t)
 		if i == 0 {
 			// first node is out of sync
-			node.On("State").Return(evmclient.NodeStateOutOfSync)
+			node.On("StateAndLatestBlockNumber").Return(evmclient.NodeStateOutOfSync, int64(-1))
 		} else {
 			// second & third nodes are alive
-			node.On("State").Return(evmclient.NodeStateAlive)
+			node.On("StateAndLatestBlockNumber").Return(evmclient.NodeStateAlive, int64(-1))
 		}
 		nodes = append(nodes, node)
 	}
 
-	selector := evmclient.NewRoundRobinSelector(nodes)
+	selector := evmclient.NewRoundRobinSelector(nodes, 0)
 	assert.Equal(t, nodes[1], selector.Select())
 	assert.Equal(t, nodes[2], selector.Select())
 	assert.Equal(t, nodes[1], selector.Select())
@@ -42,14 +42,39 @@ func TestRoundRobinNodeSelector_None(t *testing.T) {
 		node := evmmocks.NewNode(t)
 		if i == 0 {
 			// first node is out of sync
-			node.On("State").Return(evmclient.NodeStateOutOfSync)
+			node.On("StateAndLatestBlockNumber").Return(evmclient.NodeStateOutOfSync, int64(-1))
 		} else {
 			// others are unreachable
-			node.On("State").Return(evmclient.NodeStateUnreachable)
+			node.On("StateAndLatestBlockNumber").Return(evmclient.NodeStateUnreachable, int64(-1))
 		}
 		nodes = append(nodes, node)
 	}
 
-	selector := evmclient.NewRoundRobinSelector(nodes)
+	selector := evmclient.NewRoundRobinSelector(nodes, 0)
 	assert.Nil(t, selector.Select())
 }
+
+func TestRoundRobinNodeSelector_SyncThreshold(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for _, latest := range []int64{100, 89, 90} {
+		node := evmmocks.NewNode(t)
+		node.On("StateAndLatestBlockNumber").Return(evmclient.NodeStateAlive, latest)
+		nodes = append(nodes, node)
+	}
+
+	// second node is 11 blocks behind
+	selector := evmclient.NewRoundRobinSelector(nodes, 10)
+	assert.Equal(t, nodes[0], selector.Select())
+	assert.Equal(t, nodes[2], selector.Select())
+	assert.Equal(t, nodes[0], selector.Select())
+	assert.Equal(t, nodes[2], selector.Select())
+
+	// a threshold of zero disables the check
+	selector = evmclient.NewRoundRobinSelector(nodes, 0)
+	assert.Equal(t, nodes[0], selector.Select())
+	assert.Equal(t, nodes[1], selector.Select())
+	assert.Equal(t, nodes[2], selector.Select())
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/big"
 	"sync"
 	"time"
@@ -29,8 +30,9 @@ var (
 )
 
 const (
-	NodeSelectionMode_HighestHead = "HighestHead"
-	NodeSelectionMode_RoundRobin  = "RoundRobin"
+	NodeSelectionMode_HighestHead     = "HighestHead"
+	NodeSelectionMode_RoundRobin      = "RoundRobin"
+	NodeSelectionMode_PriorityOrdered = "PriorityOrdered"
 )
 
 // NodeSelector represents a strategy to select the next node from the pool.
@@ -38,13 +40,45 @@ type NodeSelector interface {
 	// Select() returns a Node, or nil if none can be selected.
 	// Implementation must be thread-safe.
 	Select() Node
-	// Name() returns the strategy name, e.g. "HighestHead" or "RoundRobin"
+	// Name() returns the strategy name, e.g. "HighestHead", "RoundRobin" or "PriorityOrdered"
 	Name() string
 }
 
+// aliveSyncedNodes returns the alive nodes whose latest received block number
+// is no more than syncThreshold blocks behind the highest of any alive node.
+// A syncThreshold of zero disables the check.
+func aliveSyncedNodes(nodes []Node, syncThreshold uint32) []Node {
+	var alive []Node
+	var latest []int64
+	var highest int64 = math.MinInt64
+	for _, n := range nodes {
+		state, latestReceivedBlockNumber := n.StateAndLatestBlockNumber()
+		if state != NodeStateAlive {
+			continue
+		}
+		alive = append(alive, n)
+		latest = append(latest, latestReceivedBlockNumber)
+		if latestReceivedBlockNumber > highest {
+			highest = latestReceivedBlockNumber
+		}
+	}
+	if syncThreshold == 0 {
+		return alive
+	}
+	synced := alive[:0]
+	for i, n := range alive {
+		// NodeNoNewHeadsThreshold may not be enabled, in this case all nodes have latestReceivedBlockNumber == -1
+		if highest-latest[i] <= int64(syncThreshold) {
+			synced = append(synced, n)
+		}
+	}
+	return synced
+}
+
 // PoolConfig represents settings for the Pool
 type PoolConfig interface {
 	NodeSelectionMode() string
+	EvmNodeSyncThreshold() uint32
 	NodeNoNewHeadsThreshold() time.Duration
 }
 
@@ -73,7 +107,9 @@ func NewPool(logger logger.Logger, cfg PoolConfig, nodes []Node, sendonlys []Sen
 		case NodeSelectionMode_HighestHead:
 			return NewHighestHeadNodeSelector(nodes)
 		case NodeSelectionMode_RoundRobin:
-			return NewRoundRobinSelector(nodes)
+			return NewRoundRobinSelector(nodes, cfg.EvmNodeSyncThreshold())
+		case NodeSelectionMode_PriorityOrdered:
+			return NewPriorityOrderedNodeSelector(nodes, cfg.EvmNodeSyncThreshold())
 		default:
 			panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", cfg.NodeSelectionMode()))
 		}
@@ -83,7 +119,7 @@ func NewPool(logger logger.Logger, cfg PoolConfig, nodes []Node, sendonlys []Sen
 
 	if cfg.NodeNoNewHeadsThreshold() == 0 && cfg.NodeSelectionMode() == NodeSelectionMode_HighestHead {
 		lggr.Warn("NODE_SELECTION_MODE=HighestHead will not work for NODE_NO_NEW_HEADS_THRESHOLD=0, the pool will use RoundRobin mode.")
-		nodeSelector = NewRoundRobinSelector(nodes)
+		nodeSelector = NewRoundRobinSelector(nodes, cfg.EvmNodeSyncThreshold())
 	}
 
 	p := &Pool{
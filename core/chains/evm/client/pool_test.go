@@ -5,10 +5,12 @@ import (
 	"math/big"
 	"net/http/httptest"
 	"net/url"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +29,7 @@ import (
 type poolConfig struct {
 	selectionMode       string
 	noNewHeadsThreshold time.Duration
+	syncThreshold       uint32
 }
 
 func (c poolConfig) NodeSelectionMode() string {
@@ -37,6 +40,10 @@ func (c poolConfig) NodeNoNewHeadsThreshold() time.Duration {
 	return c.noNewHeadsThreshold
 }
 
+func (c poolConfig) EvmNodeSyncThreshold() uint32 {
+	return c.syncThreshold
+}
+
 var defaultConfig evmclient.PoolConfig = &poolConfig{
 	selectionMode:       evmclient.NodeSelectionMode_RoundRobin,
 	noNewHeadsThreshold: 0,
@@ -218,7 +225,7 @@ func (r *chainIDResps) newNode(t *testing.T, nodeChainID int64) evmclient.Node {
 	}
 
 	defer func() { r.id++ }()
-	return evmclient.NewNode(evmclient.TestNodeConfig{}, logger.TestLogger(t), *wsURL, httpURL, t.Name(), r.id, big.NewInt(nodeChainID))
+	return evmclient.NewNode(evmclient.TestNodeConfig{}, logger.TestLogger(t), *wsURL, httpURL, t.Name(), r.id, big.NewInt(nodeChainID), 0)
 }
 
 type chainIDService struct {
@@ -305,7 +312,7 @@ func TestUnit_Pool_BatchCallContextAll(t *testing.T) {
 
 	for i := 0; i < nodeCount; i++ {
 		node := evmmocks.NewNode(t)
-		node.On("State").Return(evmclient.NodeStateAlive).Maybe()
+		node.On("StateAndLatestBlockNumber").Return(evmclient.NodeStateAlive, int64(-1)).Maybe()
 		node.On("BatchCallContext", ctx, b).Return(nil).Once()
 		nodes = append(nodes, node)
 	}
@@ -319,3 +326,37 @@ func TestUnit_Pool_BatchCallContextAll(t *testing.T) {
 
 	p.BatchCallContextAll(ctx, b)
 }
+
+func TestUnit_Pool_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	tx := types.NewTransaction(uint64(42), testutils.NewAddress(), big.NewInt(142), 242, big.NewInt(342), []byte{1, 2, 3})
+	sameTx := mock.MatchedBy(func(sent *types.Transaction) bool { return sent.Hash() == tx.Hash() })
+
+	primary := evmmocks.NewNode(t)
+	primary.On("StateAndLatestBlockNumber").Return(evmclient.NodeStateAlive, int64(-1)).Maybe()
+	primary.On("SendTransaction", ctx, tx).Return(nil).Once()
+	primary.On("TransactionReceipt", ctx, tx.Hash()).Return(&types.Receipt{TxHash: tx.Hash()}, nil).Once()
+
+	sendOnlyCount := 3
+	var sent sync.WaitGroup
+	sent.Add(sendOnlyCount)
+	var sendonlys []evmclient.SendOnlyNode
+	for i := 0; i < sendOnlyCount; i++ {
+		s := evmmocks.NewSendOnlyNode(t)
+		s.On("String").Return("sendonly").Maybe()
+		s.On("SendTransaction", mock.Anything, sameTx).Return(nil).Once().Run(func(mock.Arguments) { sent.Done() })
+		sendonlys = append(sendonlys, s)
+	}
+
+	p := evmclient.NewPool(logger.TestLogger(t), defaultConfig, []evmclient.Node{primary}, sendonlys, &cltest.FixtureChainID)
+
+	require.NoError(t, p.SendTransaction(ctx, tx))
+	sent.Wait()
+
+	// Reads only go to the primary; the sendonly mocks fail on any other call
+	receipt, err := p.TransactionReceipt(ctx, tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, tx.Hash(), receipt.TxHash)
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -26,6 +27,11 @@ type (
 	// https://app.shortcut.com/chainlinklabs/story/33622/remove-legacy-config
 	chainSpecificConfigDefaultSet struct {
 		balanceMonitorEnabled                         bool
+		autoWalletFundEnabled                         bool
+		autoCreateSendingKey                          bool
+		autoWalletFundThreshold                       assets.Wei
+		autoWalletFundAmount                          assets.Wei
+		walletBalancePollInterval                     time.Duration
 		blockEmissionIdleWarningThreshold             time.Duration
 		blockHistoryEstimatorBatchSize                uint32
 		blockHistoryEstimatorBlockDelay               uint16
@@ -33,61 +39,106 @@ type (
 		blockHistoryEstimatorCheckInclusionBlocks     uint16
 		blockHistoryEstimatorCheckInclusionPercentile uint16
 		blockHistoryEstimatorTransactionPercentile    uint16
+		gasEstimatorTransactionPriceHistory           uint16
+		blockHistoryEstimatorEWMAAlpha                float64
+		gasEstimatorMinSampleCount                    uint16
+		gasHistoryExcludeZeroPriceTxs                 bool
+		blockTime                                     time.Duration
 		chainType                                     config.ChainType
+		databaseQueryTimeout                          time.Duration
+		contractCallTimeout                           time.Duration
 		eip1559DynamicFees                            bool
+		minimumFeeMarket                              bool
 		ethTxReaperInterval                           time.Duration
 		ethTxReaperThreshold                          time.Duration
 		ethTxResendAfterThreshold                     time.Duration
+		ethTxExpiry                                   time.Duration
+		receiptPollingInterval                        time.Duration
+		maxRevertedTransactionsPerBlock               uint16
+		callDataSizeLimit                             uint32
 		finalityDepth                                 uint32
 		flagsContractAddress                          string
 		gasBumpPercent                                uint16
 		gasBumpThreshold                              uint64
+		gasNoBumpThreshold                            uint64
 		gasBumpTxDepth                                uint16
 		gasBumpWei                                    assets.Wei
+		gasCapacityBuffer                             float64
 		gasEstimatorMode                              string
+		gasEstimatorFallbackMode                      string
+		gasEstimatorCheckRetries                      uint8
+		gasOraclePollInterval                         time.Duration
 		gasFeeCapDefault                              assets.Wei
 		gasLimitDefault                               uint32
 		gasLimitMax                                   uint32
+		gasLimitIncrementOnFailure                    uint32
 		gasLimitMultiplier                            float32
 		gasLimitTransfer                              uint32
+		contractCreationGasLimit                      uint32
 		gasLimitOCRJobType                            *uint32
 		gasLimitDRJobType                             *uint32
 		gasLimitVRFJobType                            *uint32
 		gasLimitFMJobType                             *uint32
 		gasLimitKeeperJobType                         *uint32
 		gasPriceDefault                               assets.Wei
+		gasPriceAutoAdjustInterval                    time.Duration
 		gasTipCapDefault                              assets.Wei
 		gasTipCapMinimum                              assets.Wei
 		headTrackerHistoryDepth                       uint32
 		headTrackerMaxBufferSize                      uint32
 		headTrackerSamplingInterval                   time.Duration
+		headTrackerCallbackTimeout                    time.Duration
+		headTrackerBlockDelay                         uint16
+		maxReorgDepth                                 uint32
+		maxBlockAge                                   time.Duration
+		headTrackerFlushInterval                      time.Duration
+		healthCheckGracePeriod                        time.Duration
 		linkContractAddress                           string
 		operatorFactoryAddress                        string
 		logBackfillBatchSize                          uint32
 		logKeepBlocksDepth                            uint32
 		logPollInterval                               time.Duration
+		logPruneInterval                              time.Duration
+		logTTL                                        time.Duration
+		logFetcherUseBlockHash                        bool
 		maxGasPriceWei                                assets.Wei
+		maxGasPriceWarningThresholdPercent            uint8
 		maxInFlightTransactions                       uint32
 		maxQueuedTransactions                         uint64
 		minGasPriceWei                                assets.Wei
+		gasPriceCacheTTL                              time.Duration
+		blobGasPriceDefault                           assets.Wei
+		maxBlobGasPrice                               assets.Wei
+		feeHistoryBlocks                              uint16
 		minIncomingConfirmations                      uint32
 		minimumContractPayment                        *assets.Link
 		nodeDeadAfterNoNewHeadersThreshold            time.Duration
 		nodePollFailureThreshold                      uint32
 		nodePollInterval                              time.Duration
 		nodeSelectionMode                             string
-
-		nonceAutoSync       bool
-		useForwarders       bool
-		rpcDefaultBatchSize uint32
+		nodeSyncThreshold                             uint32
+
+		nonceAutoSync        bool
+		nonceAutoFillGap     bool
+		useForwarders        bool
+		simulateTransactions bool
+		txPriorityQueue      bool
+		allowUnprotectedTxs  bool
+		rpcDefaultBatchSize  uint32
+		rpcMaxInFlight       uint32
+		rpcReadTimeoutFactor float64
+		readOnly             bool
+		chainLocked          bool
 		// set true if fully configured
 		complete bool
 
 		// Chain specific OCR1 config
 		ocrContractConfirmations              uint16
+		ocrContractLookbackBlocks             uint64
 		ocrContractTransmitterTransmitTimeout time.Duration
 		ocrDatabaseTimeout                    time.Duration
 		ocrObservationGracePeriod             time.Duration
+		ocrMinObservationTimeout              time.Duration
 
 		// Chain specific OCR2 config
 		ocr2AutomationGasLimit uint32
@@ -96,6 +147,51 @@ type (
 
 var chainSpecificConfigDefaultSets map[int64]chainSpecificConfigDefaultSet
 
+// chainNames are human-readable names for known chains, used in logs and metrics
+var chainNames = map[int64]string{
+	1:          "Ethereum Mainnet",
+	3:          "Ethereum Ropsten",
+	4:          "Ethereum Rinkeby",
+	5:          "Ethereum Goerli",
+	42:         "Ethereum Kovan",
+	11155111:   "Ethereum Sepolia",
+	10:         "Optimism Mainnet",
+	69:         "Optimism Kovan",
+	420:        "Optimism Goerli",
+	28528:      "Optimism Alpha",
+	42161:      "Arbitrum Mainnet",
+	421611:     "Arbitrum Rinkeby",
+	421613:     "Arbitrum Goerli",
+	56:         "BSC Mainnet",
+	128:        "Heco Mainnet",
+	250:        "Fantom Mainnet",
+	4002:       "Fantom Testnet",
+	137:        "Polygon Mainnet",
+	80001:      "Polygon Mumbai",
+	100:        "xDai Mainnet",
+	30:         "RSK Mainnet",
+	31:         "RSK Testnet",
+	43113:      "Avalanche Fuji",
+	43114:      "Avalanche Mainnet",
+	1666600000: "Harmony Mainnet",
+	1666700000: "Harmony Testnet",
+	65:         "OKX Testnet",
+	66:         "OKX Mainnet",
+	588:        "Metis Rinkeby",
+	1088:       "Metis Mainnet",
+	1337:       "Simulated",
+}
+
+// chainName returns the name of a known chain, or a generic one including the ID.
+func chainName(id *big.Int) string {
+	if id.IsInt64() {
+		if name, ok := chainNames[id.Int64()]; ok {
+			return name
+		}
+	}
+	return fmt.Sprintf("EVM chain %s", id)
+}
+
 // fallbackDefaultSet represents the "base layer" of config defaults
 // It can be overridden on a per-chain basis and may be used if the chain is unknown
 var fallbackDefaultSet chainSpecificConfigDefaultSet
@@ -116,6 +212,11 @@ func setChainSpecificConfigDefaultSets() {
 
 	fallbackDefaultSet = chainSpecificConfigDefaultSet{
 		balanceMonitorEnabled:                 true,
+		autoWalletFundEnabled:                 false,
+		autoCreateSendingKey:                  false,
+		autoWalletFundThreshold:               *assets.GWei(100_000_000),
+		autoWalletFundAmount:                  *assets.GWei(500_000_000),
+		walletBalancePollInterval:             1 * time.Minute,
 		blockEmissionIdleWarningThreshold:     1 * time.Minute,
 		blockHistoryEstimatorBatchSize:        4, // FIXME: Workaround `websocket: read limit exceeded` until https://app.clubhouse.io/chainlinklabs/story/6717/geth-websockets-can-sometimes-go-bad-under-heavy-load-proposal-for-eth-node-balancer
 		blockHistoryEstimatorBlockDelay:       1,
@@ -126,51 +227,96 @@ func setChainSpecificConfigDefaultSets() {
 		blockHistoryEstimatorCheckInclusionBlocks:     12,
 		blockHistoryEstimatorCheckInclusionPercentile: 90,
 		blockHistoryEstimatorTransactionPercentile:    60,
+		blockTime:                             15 * time.Second,
 		chainType:                             "",
+		databaseQueryTimeout:                  10 * time.Second,
+		contractCallTimeout:                   5 * time.Second,
 		eip1559DynamicFees:                    false,
+		minimumFeeMarket:                      false,
 		ethTxReaperInterval:                   1 * time.Hour,
 		ethTxReaperThreshold:                  168 * time.Hour,
 		ethTxResendAfterThreshold:             1 * time.Minute,
+		ethTxExpiry:                           0,
+		receiptPollingInterval:                0,
+		maxRevertedTransactionsPerBlock:       0,
+		callDataSizeLimit:                     0,
 		finalityDepth:                         50,
 		gasBumpPercent:                        20,
 		gasBumpThreshold:                      3,
+		gasNoBumpThreshold:                    0,
 		gasBumpTxDepth:                        10,
 		gasBumpWei:                            *assets.GWei(5),
+		gasCapacityBuffer:                     1.05,
 		gasEstimatorMode:                      "BlockHistory",
+		gasEstimatorFallbackMode:              "FixedPrice",
+		gasEstimatorCheckRetries:              3,
+		gasOraclePollInterval:                 15 * time.Second,
+		gasEstimatorTransactionPriceHistory:   0,
+		blockHistoryEstimatorEWMAAlpha:        0,
+		gasEstimatorMinSampleCount:            5,
+		gasHistoryExcludeZeroPriceTxs:         true,
 		gasFeeCapDefault:                      *DefaultGasFeeCap,
 		gasLimitDefault:                       DefaultGasLimit,
 		gasLimitMax:                           DefaultGasLimit, // equal since no effect other than Arbitrum
+		gasLimitIncrementOnFailure:            0,
 		gasLimitMultiplier:                    1.0,
 		gasLimitTransfer:                      21000,
+		contractCreationGasLimit:              5_000_000,
 		gasPriceDefault:                       *DefaultGasPrice,
+		gasPriceAutoAdjustInterval:            5 * time.Minute,
 		gasTipCapDefault:                      *DefaultGasTip,
 		gasTipCapMinimum:                      *assets.NewWeiI(1),
 		headTrackerHistoryDepth:               100,
 		headTrackerMaxBufferSize:              3,
 		headTrackerSamplingInterval:           1 * time.Second,
+		headTrackerCallbackTimeout:            2 * time.Second,
+		headTrackerBlockDelay:                 0,
+		maxReorgDepth:                         0,
+		maxBlockAge:                           0,
+		headTrackerFlushInterval:              0,
+		healthCheckGracePeriod:                0,
 		linkContractAddress:                   "",
 		logBackfillBatchSize:                  100,
 		logKeepBlocksDepth:                    100_000,
 		logPollInterval:                       15 * time.Second,
+		logPruneInterval:                      1 * time.Hour,
+		logTTL:                                0,
+		logFetcherUseBlockHash:                false,
 		maxGasPriceWei:                        *MaxLegalGasPrice,
+		maxGasPriceWarningThresholdPercent:    80,
 		maxInFlightTransactions:               16,
 		maxQueuedTransactions:                 250,
 		minGasPriceWei:                        *assets.GWei(1),
+		gasPriceCacheTTL:                      0,
+		blobGasPriceDefault:                   *assets.GWei(1),
+		maxBlobGasPrice:                       *assets.GWei(1000),
+		feeHistoryBlocks:                      25,
 		minIncomingConfirmations:              3,
 		minimumContractPayment:                DefaultMinimumContractPayment,
 		nodeDeadAfterNoNewHeadersThreshold:    3 * time.Minute,
 		nodePollFailureThreshold:              5,
 		nodePollInterval:                      10 * time.Second,
 		nodeSelectionMode:                     client.NodeSelectionMode_HighestHead,
+		nodeSyncThreshold:                     10,
 		nonceAutoSync:                         true,
+		nonceAutoFillGap:                      false,
 		ocrContractConfirmations:              4,
+		ocrContractLookbackBlocks:             100,
 		ocrContractTransmitterTransmitTimeout: 10 * time.Second,
 		ocrDatabaseTimeout:                    10 * time.Second,
 		ocrObservationGracePeriod:             1 * time.Second,
+		ocrMinObservationTimeout:              0,         // no minimum
 		ocr2AutomationGasLimit:                5_300_000, // 5.3M: 5M upkeep gas limit + 300K overhead
 		operatorFactoryAddress:                "",
 		rpcDefaultBatchSize:                   100,
+		rpcMaxInFlight:                        256,
+		rpcReadTimeoutFactor:                  0,
+		readOnly:                              false,
+		chainLocked:                           false,
 		useForwarders:                         false,
+		simulateTransactions:                  false,
+		txPriorityQueue:                       false,
+		allowUnprotectedTxs:                   false,
 		complete:                              true,
 	}
 
@@ -181,6 +327,7 @@ func setChainSpecificConfigDefaultSets() {
 	mainnet.linkContractAddress = "0x514910771AF9Ca656af840dff83E8264EcF986CA"
 	mainnet.minimumContractPayment = assets.NewLinkFromJuels(100000000000000000) // 0.1 LINK
 	mainnet.operatorFactoryAddress = "0x3e64cd889482443324f91bfa9c84fe72a511f48a"
+	mainnet.blockTime = 12 * time.Second
 
 	// NOTE: There are probably other variables we can tweak for Kovan and other
 	// test chains, but the defaults have been working fine and if it ain't
@@ -188,26 +335,31 @@ func setChainSpecificConfigDefaultSets() {
 	ropsten := mainnet
 	ropsten.linkContractAddress = "0x20fe562d797a42dcb3399062ae9546cd06f63280"
 	ropsten.operatorFactoryAddress = ""
+	ropsten.gasHistoryExcludeZeroPriceTxs = false
 	kovan := mainnet
 	kovan.linkContractAddress = "0xa36085F69e2889c224210F603D836748e7dC0088"
 	kovan.operatorFactoryAddress = "0x8007e24251b1D2Fc518Eb843A701d9cD21fe0aA3"
 	// WONTFIX: Kovan has strange behaviour with EIP1559, see: https://app.shortcut.com/chainlinklabs/story/34098/kovan-can-emit-blocks-that-violate-assumptions-in-block-history-estimator
 	// This is a WONTFIX because support for Kovan will soon be dropped
 	kovan.eip1559DynamicFees = false
+	kovan.gasHistoryExcludeZeroPriceTxs = false
 	goerli := mainnet
 	goerli.linkContractAddress = "0x326c977e6efc84e512bb9c30f76e30c160ed06fb"
 	goerli.eip1559DynamicFees = true
 	goerli.operatorFactoryAddress = ""
+	goerli.gasHistoryExcludeZeroPriceTxs = false
 	rinkeby := mainnet
 	rinkeby.linkContractAddress = "0x01BE23585060835E02B77ef475b0Cc51aA1e0709"
 	// WONTFIX: Rinkeby has not been tested with EIP1559
 	// This is a WONTFIX because support for Rinkeby will soon be dropped
 	rinkeby.eip1559DynamicFees = false
 	rinkeby.operatorFactoryAddress = ""
+	rinkeby.gasHistoryExcludeZeroPriceTxs = false
 	sepolia := mainnet
 	sepolia.linkContractAddress = "0xb227f007804c16546Bd054dfED2E7A1fD5437678"
 	sepolia.operatorFactoryAddress = "" // doesn't exist yet
 	sepolia.eip1559DynamicFees = true
+	sepolia.gasHistoryExcludeZeroPriceTxs = false
 
 	// simulated chain is actually a local client that "pretends" to be a blockchain
 	// see: https://goethereumbook.org/en/client-simulated/
@@ -230,6 +382,7 @@ func setChainSpecificConfigDefaultSets() {
 	simulated.headTrackerSamplingInterval = 0
 	simulated.ethTxReaperThreshold = 0
 	simulated.minimumContractPayment = assets.NewLinkFromJuels(100)
+	simulated.gasHistoryExcludeZeroPriceTxs = false
 
 	// xDai currently uses AuRa (like Parity) consensus so finality rules will be similar to parity
 	// See: https://www.poa.network/for-users/whitepaper/poadao-v1/proof-of-authority
@@ -246,6 +399,8 @@ func setChainSpecificConfigDefaultSets() {
 	xDaiMainnet.maxGasPriceWei = *assets.GWei(500)
 	xDaiMainnet.linkContractAddress = "0xE2e73A1c69ecF83F464EFCE6A5be353a37cA09b2"
 	xDaiMainnet.logPollInterval = 5 * time.Second
+	xDaiMainnet.blockTime = 5 * time.Second
+	xDaiMainnet.ocrMinObservationTimeout = 20 * time.Second // xDai data sources are slow to respond, 20s is the most OCR allows
 
 	// BSC uses Clique consensus with ~3s block times
 	// Clique offers finality within (N/2)+1 blocks where N is number of signers
@@ -269,6 +424,11 @@ func setChainSpecificConfigDefaultSets() {
 	bscMainnet.ocrContractTransmitterTransmitTimeout = 2 * time.Second
 	bscMainnet.ocrObservationGracePeriod = 500 * time.Millisecond
 	bscMainnet.logPollInterval = 3 * time.Second
+	bscMainnet.blockTime = 3 * time.Second
+	bscMainnet.ocrContractLookbackBlocks = 500
+	bscMainnet.ethTxReaperInterval = 15 * time.Minute
+	bscMainnet.ethTxReaperThreshold = 24 * time.Hour
+	bscMainnet.feeHistoryBlocks = 10
 
 	hecoMainnet := bscMainnet
 
@@ -292,10 +452,14 @@ func setChainSpecificConfigDefaultSets() {
 	polygonMainnet.linkContractAddress = "0xb0897686c545045afc77cf20ec7a532e3120e0f1"
 	polygonMainnet.minIncomingConfirmations = 5
 	polygonMainnet.logPollInterval = 1 * time.Second
+	polygonMainnet.blockTime = 2 * time.Second
+	polygonMainnet.ocrContractLookbackBlocks = 500
+	polygonMainnet.ocrMinObservationTimeout = 5 * time.Second
 	polygonMumbai := polygonMainnet
 	polygonMumbai.gasPriceDefault = *assets.GWei(1)
 	polygonMumbai.minGasPriceWei = *assets.GWei(1)
 	polygonMumbai.linkContractAddress = "0x326C977E6efc84E512bB9C30f76E30c160eD06FB"
+	polygonMumbai.gasHistoryExcludeZeroPriceTxs = false
 
 	// Arbitrum is an L2 chain. Pending proper L2 support, for now we rely on their sequencer
 	arbitrumMainnet := fallbackDefaultSet
@@ -311,8 +475,10 @@ func setChainSpecificConfigDefaultSets() {
 	arbitrumMainnet.blockHistoryEstimatorBlockHistorySize = 0 // Force an error if someone set GAS_UPDATER_ENABLED=true by accident; we never want to run the block history estimator on arbitrum
 	arbitrumMainnet.linkContractAddress = "0xf97f4df75117a78c1A5a0DBb814Af92458539FB4"
 	arbitrumMainnet.ocrContractConfirmations = 1
+	arbitrumMainnet.contractCallTimeout = 30 * time.Second
 	arbitrumRinkeby := arbitrumMainnet
 	arbitrumRinkeby.linkContractAddress = "0x615fBe6372676474d9e6933d310469c9b68e9726"
+	arbitrumRinkeby.gasHistoryExcludeZeroPriceTxs = false
 	arbitrumGoerli := arbitrumRinkeby
 	arbitrumGoerli.linkContractAddress = "0xdc2CC710e42857672E7907CF474a69B63B93089f"
 
@@ -333,9 +499,11 @@ func setChainSpecificConfigDefaultSets() {
 	optimismMainnet.minGasPriceWei = *assets.NewWeiI(0) // Optimism uses the L2Suggested estimator; we don't want to place any limits on the minimum gas price
 	optimismMainnet.ocrContractConfirmations = 1
 	optimismMainnet.ocr2AutomationGasLimit = 6_500_000 // 5M (upkeep limit) + 1.5M. Optimism requires a larger overhead than normal chains
+	optimismMainnet.receiptPollingInterval = 1 * time.Second
 	optimismKovan := optimismMainnet
 	optimismKovan.blockEmissionIdleWarningThreshold = 30 * time.Minute
 	optimismKovan.linkContractAddress = "0x4911b761993b9c8c0d14Ba2d86902AF6B0074F5B"
+	optimismKovan.gasHistoryExcludeZeroPriceTxs = false
 	optimismGoerli := optimismKovan
 	optimismGoerli.linkContractAddress = "0xdc2CC710e42857672E7907CF474a69B63B93089f"
 
@@ -354,8 +522,10 @@ func setChainSpecificConfigDefaultSets() {
 	// (The proper way to consider finalization would be to mark an L2 block final when it gets included in a final L1 block, which requires special handling (new txm))
 	optimismBedrock.finalityDepth = 200
 	optimismBedrock.headTrackerHistoryDepth = 300
+	optimismBedrock.blockTime = 2 * time.Second
 	// TODO: remove this testnet when all Optimism networks have migrated: https://app.shortcut.com/chainlinklabs/story/55389/remove-optimism-pre-bedrock-error-messages
 	optimismAlpha := optimismBedrock
+	optimismAlpha.gasHistoryExcludeZeroPriceTxs = false
 
 	// Fantom
 	fantomMainnet := fallbackDefaultSet
@@ -366,10 +536,12 @@ func setChainSpecificConfigDefaultSets() {
 	fantomMainnet.logPollInterval = 1 * time.Second
 	fantomMainnet.minIncomingConfirmations = 3
 	fantomMainnet.nodeDeadAfterNoNewHeadersThreshold = 30 * time.Second
+	fantomMainnet.blockTime = 1 * time.Second
 	fantomTestnet := fantomMainnet
 	fantomTestnet.linkContractAddress = "0xfafedb041c0dd4fa2dc0d87a6b0979ee6fa7af5f"
 	fantomTestnet.blockEmissionIdleWarningThreshold = 0
 	fantomTestnet.nodeDeadAfterNoNewHeadersThreshold = 0 // Fantom testnet only emits blocks when a new tx is received, so this method of liveness detection is not useful
+	fantomTestnet.gasHistoryExcludeZeroPriceTxs = false
 
 	// RSK
 	// RSK prices its txes in sats not wei
@@ -381,8 +553,10 @@ func setChainSpecificConfigDefaultSets() {
 	rskMainnet.minGasPriceWei = *assets.NewWeiI(0)
 	rskMainnet.minimumContractPayment = assets.NewLinkFromJuels(1000000000000000)
 	rskMainnet.logPollInterval = 30 * time.Second
+	rskMainnet.blockTime = 30 * time.Second
 	rskTestnet := rskMainnet
 	rskTestnet.linkContractAddress = "0x8bbbd80981fe76d44854d8df305e8985c19f0e78"
+	rskTestnet.gasHistoryExcludeZeroPriceTxs = false
 
 	// Avalanche
 	avalancheMainnet := fallbackDefaultSet
@@ -398,9 +572,11 @@ func setChainSpecificConfigDefaultSets() {
 	avalancheMainnet.minIncomingConfirmations = 1
 	avalancheMainnet.ocrContractConfirmations = 1
 	avalancheMainnet.logPollInterval = 3 * time.Second
+	avalancheMainnet.blockTime = 2 * time.Second
 
 	avalancheFuji := avalancheMainnet
 	avalancheFuji.linkContractAddress = "0x0b9d5D9136855f6FEc3c0993feE6E9CE8a297846"
+	avalancheFuji.gasHistoryExcludeZeroPriceTxs = false
 
 	// Harmony
 	harmonyMainnet := fallbackDefaultSet
@@ -410,12 +586,16 @@ func setChainSpecificConfigDefaultSets() {
 	harmonyMainnet.gasPriceDefault = *assets.GWei(5)
 	harmonyMainnet.minIncomingConfirmations = 1
 	harmonyMainnet.logPollInterval = 2 * time.Second
+	harmonyMainnet.blockTime = 2 * time.Second
+	harmonyMainnet.healthCheckGracePeriod = 5 * time.Minute // Harmony nodes are slow to sync on startup
 	harmonyTestnet := harmonyMainnet
 	harmonyTestnet.linkContractAddress = "0x8b12Ac23BFe11cAb03a634C1F117D64a7f2cFD3e"
+	harmonyTestnet.gasHistoryExcludeZeroPriceTxs = false
 
 	// OKExChain
 	okxMainnet := fallbackDefaultSet
 	okxTestnet := okxMainnet
+	okxTestnet.gasHistoryExcludeZeroPriceTxs = false
 
 	// Metis is an L2 chain based on Optimism.
 	metisMainnet := fallbackDefaultSet
@@ -432,6 +612,7 @@ func setChainSpecificConfigDefaultSets() {
 	metisMainnet.ocrContractConfirmations = 1
 	metisRinkeby := metisMainnet
 	metisRinkeby.linkContractAddress = ""
+	metisRinkeby.gasHistoryExcludeZeroPriceTxs = false
 
 	chainSpecificConfigDefaultSets = make(map[int64]chainSpecificConfigDefaultSet)
 	chainSpecificConfigDefaultSets[1] = mainnet
@@ -11,6 +11,8 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
+	"golang.org/x/exp/slices"
+	"gopkg.in/guregu/null.v4"
 
 	ocr "github.com/smartcontractkit/libocr/offchainreporting"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
@@ -25,10 +27,23 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+// Names of EVM features which can be rolled out gradually with feature flags.
+// See ChainScopedConfig.FeatureEnabled.
+const (
+	FeatureForwarders           = "Forwarders"
+	FeatureSimulateTransactions = "SimulateTransactions"
+)
+
 type ChainScopedOnlyConfig interface {
 	evmclient.NodeConfig
 
 	BalanceMonitorEnabled() bool
+	EvmAutoWalletFundEnabled() bool
+	EvmAutoWalletFundThreshold() *assets.Eth
+	EvmAutoWalletFundAmount() *assets.Eth
+	EvmAutoWalletFundTreasuryAddress() gethcommon.Address
+	EvmWalletBalancePollInterval() time.Duration
+	EvmMaxOutstandingBalance() *assets.Eth
 	BlockEmissionIdleWarningThreshold() time.Duration
 	BlockHistoryEstimatorBatchSize() (size uint32)
 	BlockHistoryEstimatorBlockDelay() uint16
@@ -37,46 +52,99 @@ type ChainScopedOnlyConfig interface {
 	BlockHistoryEstimatorCheckInclusionPercentile() uint16
 	BlockHistoryEstimatorEIP1559FeeCapBufferBlocks() uint16
 	BlockHistoryEstimatorTransactionPercentile() uint16
+	EvmGasEstimatorTransactionPriceHistory() uint16
+	EvmGasEstimatorBlockHistoryEWMAAlpha() float64
+	EvmGasEstimatorMinSampleCount() uint16
+	EvmGasHistoryExcludeZeroPriceTxs() bool
 	ChainID() *big.Int
+	EvmChainName() string
+	EvmChainAlias() string
 	EvmEIP1559DynamicFees() bool
+	EvmMinimumFeeMarket() bool
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
+	EvmTxExpiry() time.Duration
+	EvmReceiptPollingInterval() time.Duration
+	EvmMaxRevertedTransactionsPerBlock() uint16
+	EvmCallDataSizeLimit() uint32
+	EvmBlockTime() time.Duration
+	EvmDatabaseQueryTimeout() time.Duration
+	EvmContractCallTimeout() time.Duration
 	EvmFinalityDepth() uint32
+	EvmHealthCheckGracePeriod() time.Duration
 	EvmGasBumpPercent() uint16
 	EvmGasBumpThreshold() uint64
+	EvmGasNoBumpThreshold() uint64
+	EvmGasBumpInterval() time.Duration
 	EvmGasBumpTxDepth() uint16
 	EvmGasBumpWei() *assets.Wei
 	EvmGasFeeCapDefault() *assets.Wei
+	EvmGasFeeCapRounding() *assets.Wei
+	EvmBlobGasPriceDefault() *assets.Wei
+	EvmMaxBlobGasPrice() *assets.Wei
+	EvmFeeHistoryBlocks() uint16
 	EvmGasLimitDefault() uint32
 	EvmGasLimitMax() uint32
+	EvmGasLimitIncrementOnFailure() uint32
 	EvmGasLimitMultiplier() float32
+	EvmGasCapacityBuffer() float64
 	EvmGasLimitTransfer() uint32
+	EvmContractCreationGasLimit() uint32
 	EvmGasLimitOCRJobType() *uint32
 	EvmGasLimitDRJobType() *uint32
 	EvmGasLimitVRFJobType() *uint32
 	EvmGasLimitFMJobType() *uint32
 	EvmGasLimitKeeperJobType() *uint32
 	EvmGasPriceDefault() *assets.Wei
+	EvmGasPriceAutoAdjust() bool
+	EvmGasPriceAutoAdjustInterval() time.Duration
 	EvmGasTipCapDefault() *assets.Wei
 	EvmGasTipCapMinimum() *assets.Wei
 	EvmHeadTrackerHistoryDepth() uint32
 	EvmHeadTrackerMaxBufferSize() uint32
 	EvmHeadTrackerSamplingInterval() time.Duration
+	EvmHeadTrackerCallbackTimeout() time.Duration
+	EvmHeadTrackerBlockDelay() uint16
+	EvmMaxReorgDepth() uint32
+	EvmMaxBlockAge() time.Duration
+	EvmTrackerBlockHistoryFlushInterval() time.Duration
 	EvmLogBackfillBatchSize() uint32
 	EvmLogKeepBlocksDepth() uint32
+	EvmLogTTL() time.Duration
+	EvmLogPruneInterval() time.Duration
+	EvmLogFetcherUseBlockHash() bool
 	EvmLogPollInterval() time.Duration
 	EvmMaxGasPriceWei() *assets.Wei
+	EvmMaxGasPriceWarningThresholdPercent() uint8
 	EvmMaxInFlightTransactions() uint32
 	EvmMaxQueuedTransactions() uint64
 	EvmMinGasPriceWei() *assets.Wei
+	EvmGasPriceFloor() *assets.Wei
+	EvmGasPriceCacheTTL() time.Duration
 	EvmNonceAutoSync() bool
+	EvmNonceAutoFillGap() bool
 	EvmUseForwarders() bool
+	EvmL2SequencerHTTPURL() string
+	EvmSimulateTransactions() bool
+	EvmTxPriorityQueue() bool
+	EvmAllowUnprotectedTxs() bool
+	FeatureEnabled(name string, seed uint64) bool
 	EvmRPCDefaultBatchSize() uint32
+	EvmConfigReadOnly() bool
+	EvmChainLocked() bool
+	EvmAutoCreateSendingKey() bool
 	FlagsContractAddress() string
 	GasEstimatorMode() string
+	EvmGasEstimatorFallbackMode() string
+	EvmGasEstimatorCheckRetries() uint8
+	EvmGasOraclePollInterval() time.Duration
+	EvmGasOracleAddress() gethcommon.Address
+	EvmGasPriceFeedEnabled() bool
+	EvmGasPriceFeedAddress() gethcommon.Address
 	ChainType() config.ChainType
 	KeySpecificMaxGasPriceWei(addr gethcommon.Address) *assets.Wei
+	IsMaxGasPriceExempt(addr gethcommon.Address) bool
 	LinkContractAddress() string
 	OperatorFactoryAddress() string
 	MinIncomingConfirmations() uint32
@@ -84,8 +152,11 @@ type ChainScopedOnlyConfig interface {
 
 	// OCR1 chain specific config
 	OCRContractConfirmations() uint16
+	EvmOCRContractLookbackBlocks() uint64
+	EvmOCRKeyBundleID() string
 	OCRContractTransmitterTransmitTimeout() time.Duration
 	OCRObservationGracePeriod() time.Duration
+	EvmOCRMinObservationTimeout() time.Duration
 	OCRDatabaseTimeout() time.Duration
 
 	// OCR2 chain specific config
@@ -102,6 +173,9 @@ type ChainScopedConfig interface {
 	// Both Configure() and PersistedConfig() should be accessed through ChainSet methods only.
 	Configure(config evmtypes.ChainCfg)
 	PersistedConfig() evmtypes.ChainCfg
+	// OnMaxGasPriceChange registers fn to be called whenever the effective
+	// EvmMaxGasPriceWei changes at runtime.
+	OnMaxGasPriceChange(fn func(chainID *big.Int, oldVal, newVal *assets.Wei))
 }
 
 // https://app.shortcut.com/chainlinklabs/story/33622/remove-legacy-config
@@ -112,6 +186,9 @@ type LegacyChainScopedConfig interface {
 
 var _ ChainScopedConfig = &chainScopedConfig{}
 
+// ErrReadOnly is returned when attempting to modify a read-only chain config.
+var ErrReadOnly = errors.New("chain config is read-only")
+
 // https://app.shortcut.com/chainlinklabs/story/33622/remove-legacy-config
 type chainScopedConfig struct {
 	config.GeneralConfig
@@ -121,6 +198,10 @@ type chainScopedConfig struct {
 	persistedCfg evmtypes.ChainCfg
 	persistMu    sync.RWMutex
 	orm          *chainScopedConfigORM // calls should be paired with persistedCfg updates while holding write lock
+	updateMu     sync.Mutex            // serializes updatePersistedCfg so that change notifications are ordered
+
+	maxGasPriceListeners   []func(chainID *big.Int, oldVal, newVal *assets.Wei)
+	maxGasPriceListenersMu sync.RWMutex
 
 	id      *big.Int
 	knownID bool // part of the default set
@@ -131,6 +212,7 @@ type chainScopedConfig struct {
 
 // https://app.shortcut.com/chainlinklabs/story/33622/remove-legacy-config
 func NewChainScopedConfig(chainID *big.Int, cfg evmtypes.ChainCfg, orm evmtypes.ChainConfigORM, lggr logger.Logger, gcfg config.GeneralConfig) LegacyChainScopedConfig {
+	lggr = lggr.With("evmChainName", chainName(chainID))
 	csorm := &chainScopedConfigORM{*utils.NewBig(chainID), orm}
 	defaultSet, exists := chainSpecificConfigDefaultSets[chainID.Int64()]
 	if !exists {
@@ -141,11 +223,11 @@ func NewChainScopedConfig(chainID *big.Int, cfg evmtypes.ChainCfg, orm evmtypes.
 		GeneralConfig: gcfg,
 		logger:        lggr,
 		defaultSet:    defaultSet,
+		persistedCfg:  cfg,
 		orm:           csorm,
 		id:            chainID,
 		knownID:       exists,
 		onceMap:       make(map[string]struct{})}
-	css.Configure(cfg)
 	return &css
 }
 
@@ -157,9 +239,50 @@ func (c *chainScopedConfig) Validate() (err error) {
 }
 
 func (c *chainScopedConfig) Configure(config evmtypes.ChainCfg) {
+	c.updatePersistedCfg(func(cfg *evmtypes.ChainCfg) {
+		if changes := evmtypes.Diff(*cfg, config); len(changes) > 0 {
+			c.logger.Infow("Applying chain config changes", "changes", changes)
+		}
+		*cfg = config
+	})
+}
+
+// updatePersistedCfg applies updateFn to the persisted config, and notifies
+// any OnMaxGasPriceChange listeners if the effective EvmMaxGasPriceWei changed
+// as a result.
+func (c *chainScopedConfig) updatePersistedCfg(updateFn func(*evmtypes.ChainCfg)) {
+	c.updateMu.Lock()
+	defer c.updateMu.Unlock()
+
+	oldVal := c.EvmMaxGasPriceWei()
 	c.persistMu.Lock()
-	defer c.persistMu.Unlock()
-	c.persistedCfg = config
+	updateFn(&c.persistedCfg)
+	c.persistMu.Unlock()
+	newVal := c.EvmMaxGasPriceWei()
+
+	if oldVal.Cmp(newVal) != 0 {
+		c.notifyMaxGasPriceChange(oldVal, newVal)
+	}
+}
+
+// OnMaxGasPriceChange registers fn to be called whenever the effective
+// EvmMaxGasPriceWei changes. Listeners are called synchronously, in the order
+// they were registered, and must not block.
+func (c *chainScopedConfig) OnMaxGasPriceChange(fn func(chainID *big.Int, oldVal, newVal *assets.Wei)) {
+	c.maxGasPriceListenersMu.Lock()
+	defer c.maxGasPriceListenersMu.Unlock()
+	c.maxGasPriceListeners = append(c.maxGasPriceListeners, fn)
+}
+
+func (c *chainScopedConfig) notifyMaxGasPriceChange(oldVal, newVal *assets.Wei) {
+	c.maxGasPriceListenersMu.RLock()
+	listeners := slices.Clone(c.maxGasPriceListeners)
+	c.maxGasPriceListenersMu.RUnlock()
+
+	c.logger.Infow("EvmMaxGasPriceWei changed", "oldVal", oldVal, "newVal", newVal, "listeners", len(listeners))
+	for _, fn := range listeners {
+		fn(c.id, oldVal, newVal)
+	}
 }
 
 func (c *chainScopedConfig) PersistedConfig() evmtypes.ChainCfg {
@@ -193,18 +316,76 @@ func (c *chainScopedConfig) validate() (err error) {
 	} else if c.EvmGasFeeCapDefault().Cmp(c.EvmMaxGasPriceWei()) > 0 {
 		err = multierr.Combine(err, errors.Errorf("EVM_GAS_FEE_CAP_DEFAULT (%s) must be less than or equal to ETH_MAX_GAS_PRICE_WEI (%s)", c.EvmGasFeeCapDefault(), c.EvmMaxGasPriceWei()))
 	}
+	if c.EvmMinimumFeeMarket() && !c.EvmEIP1559DynamicFees() {
+		err = multierr.Combine(err, errors.New("EVM_MINIMUM_FEE_MARKET requires EVM_EIP1559_DYNAMIC_FEES"))
+	}
 	if c.EvmMinGasPriceWei().Cmp(c.EvmGasPriceDefault()) > 0 {
 		err = multierr.Combine(err, errors.New("ETH_MIN_GAS_PRICE_WEI must be less than or equal to ETH_GAS_PRICE_DEFAULT"))
 	}
 	if c.EvmMaxGasPriceWei().Cmp(c.EvmGasPriceDefault()) < 0 {
 		err = multierr.Combine(err, errors.New("ETH_MAX_GAS_PRICE_WEI must be greater than or equal to ETH_GAS_PRICE_DEFAULT"))
 	}
+	if r := c.EvmGasFeeCapRounding(); r != nil && r.Cmp(assets.NewWeiI(0)) <= 0 {
+		err = multierr.Combine(err, errors.New("EVM_GAS_FEE_CAP_ROUNDING must be greater than zero"))
+	}
+	if c.EvmAllowUnprotectedTxs() && c.EvmEIP1559DynamicFees() {
+		err = multierr.Combine(err, errors.New("ETH_ALLOW_UNPROTECTED_TXS cannot be used with EVM_EIP1559_DYNAMIC_FEES"))
+	}
+	if c.EvmMaxBlobGasPrice().Cmp(c.EvmBlobGasPriceDefault()) < 0 {
+		err = multierr.Combine(err, errors.New("ETH_MAX_BLOB_GAS_PRICE must be greater than or equal to ETH_BLOB_GAS_PRICE_DEFAULT"))
+	}
+	if c.EvmFeeHistoryBlocks() < 1 {
+		err = multierr.Combine(err, errors.New("ETH_FEE_HISTORY_BLOCKS must be greater than or equal to 1"))
+	}
+	if floor := c.EvmGasPriceFloor(); floor != nil && floor.Cmp(c.EvmMaxGasPriceWei()) > 0 {
+		err = multierr.Combine(err, errors.New("ETH_GAS_PRICE_FLOOR must be less than or equal to ETH_MAX_GAS_PRICE_WEI"))
+	}
+	if c.EvmGasPriceAutoAdjustInterval() <= 0 {
+		err = multierr.Combine(err, errors.New("ETH_GAS_PRICE_AUTO_ADJUST_INTERVAL must be greater than zero"))
+	}
+	if c.EvmLogTTL() > 0 && c.EvmLogPruneInterval() <= 0 {
+		err = multierr.Combine(err, errors.New("ETH_LOG_PRUNE_INTERVAL must be greater than zero if ETH_LOG_TTL is set"))
+	}
 	if c.EvmHeadTrackerHistoryDepth() < c.EvmFinalityDepth() {
 		err = multierr.Combine(err, errors.New("ETH_HEAD_TRACKER_HISTORY_DEPTH must be equal to or greater than ETH_FINALITY_DEPTH"))
 	}
-	if c.GasEstimatorMode() == "BlockHistory" && c.BlockHistoryEstimatorBlockHistorySize() <= 0 {
+	if uint32(c.EvmHeadTrackerBlockDelay()) >= c.EvmHeadTrackerHistoryDepth() {
+		err = multierr.Combine(err, errors.New("ETH_HEAD_TRACKER_BLOCK_DELAY must be less than ETH_HEAD_TRACKER_HISTORY_DEPTH"))
+	}
+	if c.EvmHeadTrackerCallbackTimeout() <= 0 {
+		err = multierr.Combine(err, errors.New("ETH_HEAD_TRACKER_CALLBACK_TIMEOUT must be greater than zero"))
+	}
+	if c.EvmContractCallTimeout() <= 0 {
+		err = multierr.Combine(err, errors.New("ETH_CONTRACT_CALL_TIMEOUT must be greater than zero"))
+	}
+	if c.EvmAutoWalletFundEnabled() && c.EvmWalletBalancePollInterval() <= 0 {
+		err = multierr.Combine(err, errors.New("ETH_WALLET_BALANCE_POLL_INTERVAL must be greater than zero if ETH_AUTO_WALLET_FUND_ENABLED is set"))
+	}
+	if (c.GasEstimatorMode() == "BlockHistory" || c.GasEstimatorMode() == "ARIMA") && c.BlockHistoryEstimatorBlockHistorySize() <= 0 {
 		err = multierr.Combine(err, errors.New("BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE must be greater than or equal to 1 if block history estimator is enabled"))
 	}
+	if factor, ok := c.GeneralConfig.GlobalEvmChainReadTimeoutFactor(); ok && !(factor > 0) {
+		err = multierr.Combine(err, errors.Errorf("ETH_RPC_READ_TIMEOUT_FACTOR (%v) must be greater than zero", factor))
+	}
+	if alpha := c.EvmGasEstimatorBlockHistoryEWMAAlpha(); alpha < 0 || alpha > 1 {
+		err = multierr.Combine(err, errors.Errorf("BLOCK_HISTORY_ESTIMATOR_EWMA_ALPHA (%v) must be between 0 and 1", alpha))
+	}
+	if val, ok := c.GeneralConfig.GlobalEvmGasOracleAddress(); ok && !gethcommon.IsHexAddress(val) {
+		err = multierr.Combine(err, errors.Errorf("ETH_GAS_ORACLE_ADDRESS (%s) is not a valid address", val))
+	} else if c.GasEstimatorMode() == "OnChainOracle" && c.EvmGasOracleAddress() == (gethcommon.Address{}) {
+		err = multierr.Combine(err, errors.New("ETH_GAS_ORACLE_ADDRESS must be set if GAS_ESTIMATOR_MODE is OnChainOracle"))
+	}
+	if val, ok := c.GeneralConfig.GlobalEvmGasPriceFeedAddress(); ok && !gethcommon.IsHexAddress(val) {
+		err = multierr.Combine(err, errors.Errorf("ETH_GAS_PRICE_FEED_ADDRESS (%s) is not a valid address", val))
+	} else if c.EvmGasPriceFeedEnabled() && c.EvmGasPriceFeedAddress() == (gethcommon.Address{}) {
+		err = multierr.Combine(err, errors.New("ETH_GAS_PRICE_FEED_ADDRESS must be set if ETH_GAS_PRICE_FEED_ENABLED is true"))
+	}
+	c.persistMu.RLock()
+	flags := c.persistedCfg.EvmFeatureFlags
+	c.persistMu.RUnlock()
+	if ferr := flags.Validate(); ferr != nil {
+		err = multierr.Combine(err, ferr)
+	}
 	if c.EvmFinalityDepth() < 1 {
 		err = multierr.Combine(err, errors.New("ETH_FINALITY_DEPTH must be greater than or equal to 1"))
 	}
@@ -221,6 +402,9 @@ func (c *chainScopedConfig) validate() (err error) {
 		DataSourceTimeout:                      c.OCRObservationTimeout(),
 		DataSourceGracePeriod:                  c.OCRObservationGracePeriod(),
 	}
+	if min := c.EvmOCRMinObservationTimeout(); lc.DataSourceTimeout < min {
+		lc.DataSourceTimeout = min
+	}
 	if ocrerr := ocr.SanityCheckLocalConfig(lc); ocrerr != nil {
 		err = multierr.Combine(err, ocrerr)
 	}
@@ -255,6 +439,33 @@ func (c *chainScopedConfig) ChainID() *big.Int {
 	return c.id
 }
 
+// EvmChainName is a human-readable name for the chain, e.g. "Ethereum Mainnet".
+func (c *chainScopedConfig) EvmChainName() string {
+	return chainName(c.id)
+}
+
+// EvmChainAlias is the name shown for the chain in the Operator UI. It can be
+// set per chain, and defaults to EvmChainName.
+func (c *chainScopedConfig) EvmChainAlias() string {
+	c.persistMu.RLock()
+	p := c.persistedCfg.ChainAlias
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmChainAlias", p.String)
+		return p.String
+	}
+	return chainName(c.id)
+}
+
+// ChainAlias returns the alias of the chain with id and persisted config cfg,
+// as returned by EvmChainAlias.
+func ChainAlias(id *big.Int, cfg *evmtypes.ChainCfg) string {
+	if cfg != nil && cfg.ChainAlias.Valid {
+		return cfg.ChainAlias.String
+	}
+	return chainName(id)
+}
+
 func (c *chainScopedConfig) logEnvOverrideOnce(name string, envVal interface{}) {
 	k := fmt.Sprintf("env-%s", name)
 	c.onceMapMu.RLock()
@@ -272,6 +483,24 @@ func (c *chainScopedConfig) logEnvOverrideOnce(name string, envVal interface{})
 	c.onceMap[k] = struct{}{}
 }
 
+// logWarnOnce logs msg as a warning the first time it is called with key
+func (c *chainScopedConfig) logWarnOnce(key string, msg string) {
+	k := fmt.Sprintf("wrn-%s", key)
+	c.onceMapMu.RLock()
+	if _, ok := c.onceMap[k]; ok {
+		c.onceMapMu.RUnlock()
+		return
+	}
+	c.onceMapMu.RUnlock()
+	c.onceMapMu.Lock()
+	defer c.onceMapMu.Unlock()
+	if _, ok := c.onceMap[k]; ok {
+		return
+	}
+	c.logger.Warn(msg)
+	c.onceMap[k] = struct{}{}
+}
+
 func (c *chainScopedConfig) logPersistedOverrideOnce(name string, pstVal interface{}) {
 	k := fmt.Sprintf("pst-%s", name)
 	c.onceMapMu.RLock()
@@ -317,6 +546,57 @@ func (c *chainScopedConfig) EvmGasBumpThreshold() uint64 {
 	return c.defaultSet.gasBumpThreshold
 }
 
+// EvmGasNoBumpThreshold is the number of blocks after which an unconfirmed transaction is no
+// longer bumped, and its last attempt is rebroadcast instead. This is for chains where inclusion
+// is not ordered by fee, so bumping only wastes gas. Set to 0 to always bump.
+func (c *chainScopedConfig) EvmGasNoBumpThreshold() uint64 {
+	val, ok := c.GeneralConfig.GlobalEvmGasNoBumpThreshold()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasNoBumpThreshold", val)
+		return val
+	}
+	return c.defaultSet.gasNoBumpThreshold
+}
+
+// EvmBlockTime is the expected average time between blocks on this chain
+func (c *chainScopedConfig) EvmBlockTime() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmBlockTime()
+	if ok {
+		c.logEnvOverrideOnce("EvmBlockTime", val)
+		return val
+	}
+	return c.defaultSet.blockTime
+}
+
+// EvmDatabaseQueryTimeout is the deadline for database queries made by the
+// transaction manager and log poller of this chain
+func (c *chainScopedConfig) EvmDatabaseQueryTimeout() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmDatabaseQueryTimeout()
+	if ok {
+		c.logEnvOverrideOnce("EvmDatabaseQueryTimeout", val)
+		return val
+	}
+	return c.defaultSet.databaseQueryTimeout
+}
+
+// EvmContractCallTimeout is the deadline for eth_call requests made by
+// pipeline ethcall tasks on this chain
+func (c *chainScopedConfig) EvmContractCallTimeout() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmContractCallTimeout()
+	if ok {
+		c.logEnvOverrideOnce("EvmContractCallTimeout", val)
+		return val
+	}
+	return c.defaultSet.contractCallTimeout
+}
+
+// EvmGasBumpInterval is the wall-clock equivalent of EvmGasBumpThreshold, derived
+// from EvmBlockTime. Transactions are bumped once either threshold is reached.
+// Zero if gas bumping is disabled.
+func (c *chainScopedConfig) EvmGasBumpInterval() time.Duration {
+	return time.Duration(c.EvmGasBumpThreshold()) * c.EvmBlockTime()
+}
+
 // EvmGasBumpWei is the minimum fixed amount of wei by which gas is bumped on each transaction attempt
 func (c *chainScopedConfig) EvmGasBumpWei() *assets.Wei {
 	val, ok := c.GeneralConfig.GlobalEvmGasBumpWei()
@@ -366,6 +646,18 @@ func (c *chainScopedConfig) EvmMaxGasPriceWei() *assets.Wei {
 	return &n
 }
 
+// EvmMaxGasPriceWarningThresholdPercent is the percentage of EvmMaxGasPriceWei
+// above which estimated gas prices are logged and counted as nearing the cap.
+// Set to 0 to disable the warning.
+func (c *chainScopedConfig) EvmMaxGasPriceWarningThresholdPercent() uint8 {
+	val, ok := c.GeneralConfig.GlobalEvmMaxGasPriceWarningThresholdPercent()
+	if ok {
+		c.logEnvOverrideOnce("EvmMaxGasPriceWarningThresholdPercent", val)
+		return val
+	}
+	return c.defaultSet.maxGasPriceWarningThresholdPercent
+}
+
 // EvmMaxQueuedTransactions is the maximum number of unbroadcast
 // transactions per key that are allowed to be enqueued before jobs will start
 // failing and rejecting send of any further transactions.
@@ -391,6 +683,29 @@ func (c *chainScopedConfig) EvmMinGasPriceWei() *assets.Wei {
 	return &n
 }
 
+// EvmGasPriceFloor is the lowest gas price the estimator will return, for
+// chains which silently drop transactions priced below a protocol minimum.
+// If nil, EvmMinGasPriceWei is used.
+func (c *chainScopedConfig) EvmGasPriceFloor() *assets.Wei {
+	val, ok := c.GeneralConfig.GlobalEvmGasPriceFloor()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasPriceFloor", val)
+		return val
+	}
+	return nil
+}
+
+// EvmGasPriceCacheTTL is how long a gas price estimate is reused before the
+// estimator is asked for a new one. Set to 0 to disable caching.
+func (c *chainScopedConfig) EvmGasPriceCacheTTL() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmGasPriceCacheTTL()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasPriceCacheTTL", val)
+		return val
+	}
+	return c.defaultSet.gasPriceCacheTTL
+}
+
 // EvmGasLimitDefault sets the default gas limit for outgoing transactions.
 func (c *chainScopedConfig) EvmGasLimitDefault() uint32 {
 	val, ok := c.GeneralConfig.GlobalEvmGasLimitDefault()
@@ -408,94 +723,59 @@ func (c *chainScopedConfig) EvmGasLimitDefault() uint32 {
 	return c.defaultSet.gasLimitDefault
 }
 
-// EvmGasLimitOCRJobType overrides the default gas limit for OCR jobs.
-func (c *chainScopedConfig) EvmGasLimitOCRJobType() *uint32 {
-	val, ok := c.GeneralConfig.GlobalEvmGasLimitOCRJobType()
+// gasLimitJobType looks up the per-job-type gas limit override called name,
+// from the env, then the persisted config, then the chain defaults. A nil
+// result means that job type uses EvmGasLimitDefault.
+func (c *chainScopedConfig) gasLimitJobType(name string, global func() (uint32, bool), persisted func(evmtypes.ChainCfg) null.Int, def *uint32) *uint32 {
+	val, ok := global()
 	if ok {
-		c.logEnvOverrideOnce("EvmGasLimitOCRJobType", val)
+		c.logEnvOverrideOnce(name, val)
 		return &val
 	}
 	c.persistMu.RLock()
-	p := c.persistedCfg.EvmGasLimitOCRJobType
+	p := persisted(c.persistedCfg)
 	c.persistMu.RUnlock()
 	if p.Valid {
-		c.logPersistedOverrideOnce("EvmGasLimitOCRJobType", p.Int64)
+		c.logPersistedOverrideOnce(name, p.Int64)
 		v := uint32(p.Int64)
 		return &v
 	}
-	return c.defaultSet.gasLimitOCRJobType
+	return def
+}
+
+// EvmGasLimitOCRJobType overrides the default gas limit for OCR jobs.
+func (c *chainScopedConfig) EvmGasLimitOCRJobType() *uint32 {
+	return c.gasLimitJobType("EvmGasLimitOCRJobType", c.GeneralConfig.GlobalEvmGasLimitOCRJobType, func(cfg evmtypes.ChainCfg) null.Int {
+		return cfg.EvmGasLimitOCRJobType
+	}, c.defaultSet.gasLimitOCRJobType)
 }
 
 // EvmGasLimitDRJobType overrides the default gas limit for Direct Request jobs.
 func (c *chainScopedConfig) EvmGasLimitDRJobType() *uint32 {
-	val, ok := c.GeneralConfig.GlobalEvmGasLimitDRJobType()
-	if ok {
-		c.logEnvOverrideOnce("EvmGasLimitDRJobType", val)
-		return &val
-	}
-	c.persistMu.RLock()
-	p := c.persistedCfg.EvmGasLimitDRJobType
-	c.persistMu.RUnlock()
-	if p.Valid {
-		c.logPersistedOverrideOnce("EvmGasLimitDRJobType", p.Int64)
-		v := uint32(p.Int64)
-		return &v
-	}
-	return c.defaultSet.gasLimitDRJobType
+	return c.gasLimitJobType("EvmGasLimitDRJobType", c.GeneralConfig.GlobalEvmGasLimitDRJobType, func(cfg evmtypes.ChainCfg) null.Int {
+		return cfg.EvmGasLimitDRJobType
+	}, c.defaultSet.gasLimitDRJobType)
 }
 
 // EvmGasLimitVRFJobType overrides the default gas limit for VRF jobs.
 func (c *chainScopedConfig) EvmGasLimitVRFJobType() *uint32 {
-	val, ok := c.GeneralConfig.GlobalEvmGasLimitVRFJobType()
-	if ok {
-		c.logEnvOverrideOnce("EvmGasLimitVRFJobType", val)
-		return &val
-	}
-	c.persistMu.RLock()
-	p := c.persistedCfg.EvmGasLimitVRFJobType
-	c.persistMu.RUnlock()
-	if p.Valid {
-		c.logPersistedOverrideOnce("EvmGasLimitVRFJobType", p.Int64)
-		v := uint32(p.Int64)
-		return &v
-	}
-	return c.defaultSet.gasLimitVRFJobType
+	return c.gasLimitJobType("EvmGasLimitVRFJobType", c.GeneralConfig.GlobalEvmGasLimitVRFJobType, func(cfg evmtypes.ChainCfg) null.Int {
+		return cfg.EvmGasLimitVRFJobType
+	}, c.defaultSet.gasLimitVRFJobType)
 }
 
 // EvmGasLimitFMJobType overrides the default gas limit for Flux Monitor jobs.
 func (c *chainScopedConfig) EvmGasLimitFMJobType() *uint32 {
-	val, ok := c.GeneralConfig.GlobalEvmGasLimitFMJobType()
-	if ok {
-		c.logEnvOverrideOnce("EvmGasLimitFMJobType", val)
-		return &val
-	}
-	c.persistMu.RLock()
-	p := c.persistedCfg.EvmGasLimitFMJobType
-	c.persistMu.RUnlock()
-	if p.Valid {
-		c.logPersistedOverrideOnce("EvmGasLimitFMJobType", p.Int64)
-		v := uint32(p.Int64)
-		return &v
-	}
-	return c.defaultSet.gasLimitFMJobType
+	return c.gasLimitJobType("EvmGasLimitFMJobType", c.GeneralConfig.GlobalEvmGasLimitFMJobType, func(cfg evmtypes.ChainCfg) null.Int {
+		return cfg.EvmGasLimitFMJobType
+	}, c.defaultSet.gasLimitFMJobType)
 }
 
 // EvmGasLimitKeeperJobType overrides the default gas limit for Keeper jobs.
 func (c *chainScopedConfig) EvmGasLimitKeeperJobType() *uint32 {
-	val, ok := c.GeneralConfig.GlobalEvmGasLimitKeeperJobType()
-	if ok {
-		c.logEnvOverrideOnce("EvmGasLimitKeeperJobType", val)
-		return &val
-	}
-	c.persistMu.RLock()
-	p := c.persistedCfg.EvmGasLimitKeeperJobType
-	c.persistMu.RUnlock()
-	if p.Valid {
-		c.logPersistedOverrideOnce("EvmGasLimitKeeperJobType", p.Int64)
-		v := uint32(p.Int64)
-		return &v
-	}
-	return c.defaultSet.gasLimitKeeperJobType
+	return c.gasLimitJobType("EvmGasLimitKeeperJobType", c.GeneralConfig.GlobalEvmGasLimitKeeperJobType, func(cfg evmtypes.ChainCfg) null.Int {
+		return cfg.EvmGasLimitKeeperJobType
+	}, c.defaultSet.gasLimitKeeperJobType)
 }
 
 // EvmGasLimitTransfer is the gas limit for an ordinary eth->eth transfer
@@ -508,6 +788,18 @@ func (c *chainScopedConfig) EvmGasLimitTransfer() uint32 {
 	return c.defaultSet.gasLimitTransfer
 }
 
+// EvmContractCreationGasLimit is the gas limit for transactions which deploy
+// a contract, i.e. those without a to address. It is used in place of the
+// job's gas limit, since deployments need far more gas than ordinary calls.
+func (c *chainScopedConfig) EvmContractCreationGasLimit() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmContractCreationGasLimit()
+	if ok {
+		c.logEnvOverrideOnce("EvmContractCreationGasLimit", val)
+		return val
+	}
+	return c.defaultSet.contractCreationGasLimit
+}
+
 // EvmGasPriceDefault is the starting gas price for every transaction
 func (c *chainScopedConfig) EvmGasPriceDefault() *assets.Wei {
 	val, ok := c.GeneralConfig.GlobalEvmGasPriceDefault()
@@ -529,6 +821,9 @@ func (c *chainScopedConfig) EvmGasPriceDefault() *assets.Wei {
 // SetEvmGasPriceDefault saves a runtime value for the default gas price for transactions
 // nil or negative value clears
 func (c *chainScopedConfig) SetEvmGasPriceDefault(value *big.Int) error {
+	if c.EvmConfigReadOnly() {
+		return ErrReadOnly
+	}
 	if value == nil || value.Cmp(big.NewInt(0)) < 0 {
 		c.persistMu.Lock()
 		defer c.persistMu.Unlock()
@@ -549,6 +844,52 @@ func (c *chainScopedConfig) SetEvmGasPriceDefault(value *big.Int) error {
 	return c.orm.storeString("EvmGasPriceDefault", value.String())
 }
 
+// EvmGasPriceAutoAdjust enables a background service which periodically
+// lowers the default gas price by EvmGasBumpWei when transactions confirm in
+// the first block they are sent in, and raises it when they take
+// EvmGasBumpThreshold blocks or more, within the minimum and maximum gas price.
+// The adjusted value is saved as if set with SetEvmGasPriceDefault.
+//
+// It is always false while ETH_GAS_PRICE_DEFAULT is set, since the env var
+// takes precedence over the saved value.
+func (c *chainScopedConfig) EvmGasPriceAutoAdjust() bool {
+	enabled, ok := c.GeneralConfig.GlobalEvmGasPriceAutoAdjust()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasPriceAutoAdjust", enabled)
+	} else {
+		c.persistMu.RLock()
+		p := c.persistedCfg.EvmGasPriceAutoAdjust
+		c.persistMu.RUnlock()
+		if p.Valid {
+			c.logPersistedOverrideOnce("EvmGasPriceAutoAdjust", p.Bool)
+			enabled = p.Bool
+		}
+	}
+	if _, ok := c.GeneralConfig.GlobalEvmGasPriceDefault(); enabled && ok {
+		c.logWarnOnce("EvmGasPriceAutoAdjust", "EvmGasPriceAutoAdjust is disabled because ETH_GAS_PRICE_DEFAULT is set, which would override the adjusted default gas price. Unset ETH_GAS_PRICE_DEFAULT to adjust it automatically")
+		return false
+	}
+	return enabled
+}
+
+// EvmGasPriceAutoAdjustInterval is how often the default gas price is
+// adjusted when EvmGasPriceAutoAdjust is enabled
+func (c *chainScopedConfig) EvmGasPriceAutoAdjustInterval() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmGasPriceAutoAdjustInterval()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasPriceAutoAdjustInterval", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmGasPriceAutoAdjustInterval
+	c.persistMu.RUnlock()
+	if p != nil {
+		c.logPersistedOverrideOnce("EvmGasPriceAutoAdjustInterval", p.Duration())
+		return p.Duration()
+	}
+	return c.defaultSet.gasPriceAutoAdjustInterval
+}
+
 // EvmFinalityDepth is the number of blocks after which an ethereum transaction is considered "final"
 // BlocksConsideredFinal determines how deeply we look back to ensure that transactions are confirmed onto the longest chain
 // There is not a large performance penalty to setting this relatively high (on the order of hundreds)
@@ -585,6 +926,17 @@ func (c *chainScopedConfig) EvmFinalityDepth() uint32 {
 	return c.defaultSet.finalityDepth
 }
 
+// EvmHealthCheckGracePeriod is how long after starting the chain to suppress
+// unhealthy status, for chains that are slow to sync on boot
+func (c *chainScopedConfig) EvmHealthCheckGracePeriod() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmHealthCheckGracePeriod()
+	if ok {
+		c.logEnvOverrideOnce("EvmHealthCheckGracePeriod", val)
+		return val
+	}
+	return c.defaultSet.healthCheckGracePeriod
+}
+
 // EvmHeadTrackerHistoryDepth tracks the top N block numbers to keep in the `heads` database table.
 // Note that this can easily result in MORE than N records since in the case of re-orgs we keep multiple heads for a particular block height.
 // This number should be at least as large as `EvmFinalityDepth`.
@@ -624,6 +976,68 @@ func (c *chainScopedConfig) EvmHeadTrackerSamplingInterval() time.Duration {
 	return c.defaultSet.headTrackerSamplingInterval
 }
 
+// EvmHeadTrackerCallbackTimeout is the deadline for each subscriber to process a new head.
+// Subscribers which take longer have their context cancelled and a warning is logged.
+func (c *chainScopedConfig) EvmHeadTrackerCallbackTimeout() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmHeadTrackerCallbackTimeout()
+	if ok {
+		c.logEnvOverrideOnce("EvmHeadTrackerCallbackTimeout", val)
+		return val
+	}
+	return c.defaultSet.headTrackerCallbackTimeout
+}
+
+// EvmHeadTrackerBlockDelay is the number of blocks the head tracker trails
+// behind the latest head. Heads are still saved and backfilled as they
+// arrive, but subscribers are only notified once a head is this deep.
+func (c *chainScopedConfig) EvmHeadTrackerBlockDelay() uint16 {
+	val, ok := c.GeneralConfig.GlobalEvmHeadTrackerBlockDelay()
+	if ok {
+		c.logEnvOverrideOnce("EvmHeadTrackerBlockDelay", val)
+		return val
+	}
+	return c.defaultSet.headTrackerBlockDelay
+}
+
+// EvmMaxReorgDepth is the deepest re-org the head tracker tolerates. If a
+// deeper one is seen, the head tracker stops notifying subscribers of new
+// heads until an operator acknowledges it. Defaults to EvmFinalityDepth.
+func (c *chainScopedConfig) EvmMaxReorgDepth() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmMaxReorgDepth()
+	if ok {
+		c.logEnvOverrideOnce("EvmMaxReorgDepth", val)
+	} else {
+		val = c.defaultSet.maxReorgDepth
+	}
+	if val == 0 {
+		return c.EvmFinalityDepth()
+	}
+	return val
+}
+
+// EvmMaxBlockAge is the oldest a new head's timestamp may be for the head
+// tracker to accept it. Older heads are dropped. Set to 0 to disable.
+func (c *chainScopedConfig) EvmMaxBlockAge() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmMaxBlockAge()
+	if ok {
+		c.logEnvOverrideOnce("EvmMaxBlockAge", val)
+		return val
+	}
+	return c.defaultSet.maxBlockAge
+}
+
+// EvmTrackerBlockHistoryFlushInterval is how often the head tracker writes
+// new heads to the database in a single batch. Set to 0 to write every head
+// as it arrives.
+func (c *chainScopedConfig) EvmTrackerBlockHistoryFlushInterval() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmTrackerBlockHistoryFlushInterval()
+	if ok {
+		c.logEnvOverrideOnce("EvmTrackerBlockHistoryFlushInterval", val)
+		return val
+	}
+	return c.defaultSet.headTrackerFlushInterval
+}
+
 // BlockEmissionIdleWarningThreshold is the duration of time since last received head
 // to print a warning log message indicating not receiving heads
 func (c *chainScopedConfig) BlockEmissionIdleWarningThreshold() time.Duration {
@@ -657,6 +1071,54 @@ func (c *chainScopedConfig) EthTxResendAfterThreshold() time.Duration {
 	return c.defaultSet.ethTxResendAfterThreshold
 }
 
+// EvmTxExpiry is how long after it was created a keeper upkeep transaction
+// that has not been picked up for broadcasting yet is given up on and marked
+// as expired. Set to 0 to never expire transactions.
+func (c *chainScopedConfig) EvmTxExpiry() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmTxExpiry()
+	if ok {
+		c.logEnvOverrideOnce("EvmTxExpiry", val)
+		return val
+	}
+	return c.defaultSet.ethTxExpiry
+}
+
+// EvmReceiptPollingInterval is how often the EthConfirmer checks for receipts
+// of unconfirmed transactions between new heads. Set to 0 to only check on
+// new heads.
+func (c *chainScopedConfig) EvmReceiptPollingInterval() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmReceiptPollingInterval()
+	if ok {
+		c.logEnvOverrideOnce("EvmReceiptPollingInterval", val)
+		return val
+	}
+	return c.defaultSet.receiptPollingInterval
+}
+
+// EvmMaxRevertedTransactionsPerBlock is the maximum number of reverted
+// transactions the EthConfirmer processes receipts for in a single block
+// each time it checks for receipts. Set to 0 for no limit.
+func (c *chainScopedConfig) EvmMaxRevertedTransactionsPerBlock() uint16 {
+	val, ok := c.GeneralConfig.GlobalEvmMaxRevertedTransactionsPerBlock()
+	if ok {
+		c.logEnvOverrideOnce("EvmMaxRevertedTransactionsPerBlock", val)
+		return val
+	}
+	return c.defaultSet.maxRevertedTransactionsPerBlock
+}
+
+// EvmCallDataSizeLimit is the maximum size in bytes of the calldata of a
+// transaction. Larger transactions are rejected before they are queued.
+// Set to 0 for no limit.
+func (c *chainScopedConfig) EvmCallDataSizeLimit() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmCallDataSizeLimit()
+	if ok {
+		c.logEnvOverrideOnce("EvmCallDataSizeLimit", val)
+		return val
+	}
+	return c.defaultSet.callDataSizeLimit
+}
+
 // BlockHistoryEstimatorBatchSize sets the maximum number of blocks to fetch in one batch in the block history estimator
 // If the env var GAS_UPDATER_BATCH_SIZE is set to 0, it defaults to ETH_RPC_DEFAULT_BATCH_SIZE
 func (c *chainScopedConfig) BlockHistoryEstimatorBatchSize() (size uint32) {
@@ -787,6 +1249,56 @@ func (c *chainScopedConfig) BlockHistoryEstimatorTransactionPercentile() uint16
 	return c.defaultSet.blockHistoryEstimatorTransactionPercentile
 }
 
+// EvmGasEstimatorTransactionPriceHistory is the maximum number of transaction
+// prices the block history estimator keeps for each block. Blocks with more
+// transactions are sampled down to this many. Set to 0 to keep them all.
+func (c *chainScopedConfig) EvmGasEstimatorTransactionPriceHistory() uint16 {
+	val, ok := c.GeneralConfig.GlobalEvmGasEstimatorTransactionPriceHistory()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasEstimatorTransactionPriceHistory", val)
+		return val
+	}
+	return c.defaultSet.gasEstimatorTransactionPriceHistory
+}
+
+// EvmGasEstimatorBlockHistoryEWMAAlpha is the smoothing factor of an
+// exponentially weighted moving average which the block history estimator
+// applies to the per-block percentile prices, oldest first. Higher values
+// weight recent blocks more. Set to 0 to take the percentile over all
+// transactions in the history instead.
+func (c *chainScopedConfig) EvmGasEstimatorBlockHistoryEWMAAlpha() float64 {
+	val, ok := c.GeneralConfig.GlobalEvmGasEstimatorBlockHistoryEWMAAlpha()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasEstimatorBlockHistoryEWMAAlpha", val)
+		return val
+	}
+	return c.defaultSet.blockHistoryEstimatorEWMAAlpha
+}
+
+// EvmGasEstimatorMinSampleCount is the fewest transactions the block history
+// estimator must have in its history before it produces an estimate. With
+// fewer, it returns ErrInsufficientSamples. Set to 0 to disable.
+func (c *chainScopedConfig) EvmGasEstimatorMinSampleCount() uint16 {
+	val, ok := c.GeneralConfig.GlobalEvmGasEstimatorMinSampleCount()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasEstimatorMinSampleCount", val)
+		return val
+	}
+	return c.defaultSet.gasEstimatorMinSampleCount
+}
+
+// EvmGasHistoryExcludeZeroPriceTxs makes the block history estimator ignore
+// transactions with a gas price of zero, which would otherwise drag the
+// estimate towards zero on chains that allow free transactions.
+func (c *chainScopedConfig) EvmGasHistoryExcludeZeroPriceTxs() bool {
+	val, ok := c.GeneralConfig.GlobalEvmGasHistoryExcludeZeroPriceTxs()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasHistoryExcludeZeroPriceTxs", val)
+		return val
+	}
+	return c.defaultSet.gasHistoryExcludeZeroPriceTxs
+}
+
 // GasEstimatorMode controls what type of gas estimator is used
 func (c *chainScopedConfig) GasEstimatorMode() string {
 	val, ok := c.GeneralConfig.GlobalGasEstimatorMode()
@@ -814,19 +1326,108 @@ func (c *chainScopedConfig) GasEstimatorMode() string {
 	return c.defaultSet.gasEstimatorMode
 }
 
+// EvmGasEstimatorFallbackMode is the gas estimator used in place of GasEstimatorMode
+// while that estimator is failing. An empty string disables the fallback.
+func (c *chainScopedConfig) EvmGasEstimatorFallbackMode() string {
+	val, ok := c.GeneralConfig.GlobalEvmGasEstimatorFallbackMode()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasEstimatorFallbackMode", val)
+		return val
+	}
+	return c.defaultSet.gasEstimatorFallbackMode
+}
+
+// EvmGasEstimatorCheckRetries is how many times a failed gas estimate is
+// retried, with exponential back-off, before the error is returned.
+func (c *chainScopedConfig) EvmGasEstimatorCheckRetries() uint8 {
+	val, ok := c.GeneralConfig.GlobalEvmGasEstimatorCheckRetries()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasEstimatorCheckRetries", val)
+		return val
+	}
+	return c.defaultSet.gasEstimatorCheckRetries
+}
+
+// EvmGasOraclePollInterval is how often the Plugin estimator refreshes its
+// prices from the external gas oracle. Set to 0 to query the oracle for
+// every estimate.
+func (c *chainScopedConfig) EvmGasOraclePollInterval() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmGasOraclePollInterval()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasOraclePollInterval", val)
+		return val
+	}
+	return c.defaultSet.gasOraclePollInterval
+}
+
+// EvmGasOracleAddress is the address of an on-chain gas price oracle contract
+// queried by the OnChainOracle estimator. The zero address means no oracle is
+// configured.
+func (c *chainScopedConfig) EvmGasOracleAddress() gethcommon.Address {
+	val, ok := c.GeneralConfig.GlobalEvmGasOracleAddress()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasOracleAddress", val)
+		return gethcommon.HexToAddress(val)
+	}
+	return gethcommon.Address{}
+}
+
+// EvmGasPriceFeedEnabled enables the DataFeed gas estimator, which uses the
+// answer of the data feed at EvmGasPriceFeedAddress as the gas price.
+func (c *chainScopedConfig) EvmGasPriceFeedEnabled() bool {
+	val, ok := c.GeneralConfig.GlobalEvmGasPriceFeedEnabled()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasPriceFeedEnabled", val)
+		return val
+	}
+	return false
+}
+
+// EvmGasPriceFeedAddress is the address of the data feed used by the DataFeed
+// gas estimator. The zero address means no feed is configured.
+func (c *chainScopedConfig) EvmGasPriceFeedAddress() gethcommon.Address {
+	val, ok := c.GeneralConfig.GlobalEvmGasPriceFeedAddress()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasPriceFeedAddress", val)
+		return gethcommon.HexToAddress(val)
+	}
+	return gethcommon.Address{}
+}
+
 func (c *chainScopedConfig) KeySpecificMaxGasPriceWei(addr gethcommon.Address) *assets.Wei {
 	c.persistMu.RLock()
 	keySpecific := c.persistedCfg.KeySpecific[addr.Hex()].EvmMaxGasPriceWei
 	c.persistMu.RUnlock()
+	hasKeySpecific := keySpecific != nil && !keySpecific.Equal(assets.NewWeiI(0))
+
+	if c.IsMaxGasPriceExempt(addr) {
+		// exempt keys are not capped by EvmMaxGasPriceWei, but their own maximum still applies
+		if hasKeySpecific {
+			c.logKeySpecificOverrideOnce("EvmMaxGasPriceWei", addr, keySpecific)
+			return keySpecific
+		}
+		c.logKeySpecificOverrideOnce("EvmMaxGasPriceExemptAddresses", addr, MaxLegalGasPrice)
+		return MaxLegalGasPrice
+	}
 
 	chainSpecific := c.EvmMaxGasPriceWei()
-	if keySpecific != nil && !keySpecific.Equal(assets.NewWeiI(0)) && keySpecific.Cmp(chainSpecific) < 0 {
+	if hasKeySpecific && keySpecific.Cmp(chainSpecific) < 0 {
 		c.logKeySpecificOverrideOnce("EvmMaxGasPriceWei", addr, keySpecific)
 		return keySpecific
 	}
 	return c.EvmMaxGasPriceWei()
 }
 
+// IsMaxGasPriceExempt returns true if addr is listed in
+// EvmMaxGasPriceExemptAddresses. Transactions from exempt addresses are not
+// subject to EvmMaxGasPriceWei, and are capped only by their key-specific
+// EvmMaxGasPriceWei, or MaxLegalGasPrice if that is not set.
+func (c *chainScopedConfig) IsMaxGasPriceExempt(addr gethcommon.Address) bool {
+	c.persistMu.RLock()
+	defer c.persistMu.RUnlock()
+	return slices.Contains(c.persistedCfg.EvmMaxGasPriceExemptAddresses, addr)
+}
+
 func (c *chainScopedConfig) ChainType() config.ChainType {
 	val, ok := c.GeneralConfig.GlobalChainType()
 	if ok {
@@ -971,6 +1572,17 @@ func (c *chainScopedConfig) EvmNonceAutoSync() bool {
 	return c.defaultSet.nonceAutoSync
 }
 
+// EvmNonceAutoFillGap enables/disables replacing lost transactions with zero-value self-transfers on application start,
+// so that later transactions are not stuck behind a nonce gap
+func (c *chainScopedConfig) EvmNonceAutoFillGap() bool {
+	val, ok := c.GeneralConfig.GlobalEvmNonceAutoFillGap()
+	if ok {
+		c.logEnvOverrideOnce("EvmNonceAutoFillGap", val)
+		return val
+	}
+	return c.defaultSet.nonceAutoFillGap
+}
+
 // EvmUseForwarders enables/disables sending transactions through forwarder contracts
 func (c *chainScopedConfig) EvmUseForwarders() bool {
 	val, ok := c.GeneralConfig.GlobalEvmUseForwarders()
@@ -988,6 +1600,66 @@ func (c *chainScopedConfig) EvmUseForwarders() bool {
 	return c.defaultSet.useForwarders
 }
 
+// EvmL2SequencerHTTPURL is the HTTP endpoint of the sequencer of an L2 chain
+// such as Optimism or Arbitrum. If set, transactions are sent directly to the
+// sequencer before the public mempool sees them, falling back to the primary
+// node if the sequencer does not accept them. It can only be set per chain.
+func (c *chainScopedConfig) EvmL2SequencerHTTPURL() string {
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmL2SequencerHTTPURL
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmL2SequencerHTTPURL", p.String)
+		return p.String
+	}
+	return ""
+}
+
+// EvmSimulateTransactions enables/disables simulating every transaction with eth_call before it is broadcast.
+// Transactions which revert during simulation are marked as fatally errored without being sent.
+func (c *chainScopedConfig) EvmSimulateTransactions() bool {
+	val, ok := c.GeneralConfig.GlobalEvmSimulateTransactions()
+	if ok {
+		c.logEnvOverrideOnce("EvmSimulateTransactions", val)
+		return val
+	}
+	return c.defaultSet.simulateTransactions
+}
+
+// EvmTxPriorityQueue makes the broadcaster assign the next nonce of a key to
+// the unstarted transaction with the highest estimated revenue per unit of
+// gas, instead of the oldest one.
+func (c *chainScopedConfig) EvmTxPriorityQueue() bool {
+	val, ok := c.GeneralConfig.GlobalEvmTxPriorityQueue()
+	if ok {
+		c.logEnvOverrideOnce("EvmTxPriorityQueue", val)
+		return val
+	}
+	return c.defaultSet.txPriorityQueue
+}
+
+// EvmAllowUnprotectedTxs makes transactions be signed without EIP-155 replay
+// protection, for chains whose signing algorithm does not include the chain
+// ID. It cannot be used with EIP-1559 dynamic fees.
+func (c *chainScopedConfig) EvmAllowUnprotectedTxs() bool {
+	val, ok := c.GeneralConfig.GlobalEvmAllowUnprotectedTxs()
+	if ok {
+		c.logEnvOverrideOnce("EvmAllowUnprotectedTxs", val)
+		return val
+	}
+	return c.defaultSet.allowUnprotectedTxs
+}
+
+// FeatureEnabled returns true if the feature called name, such as
+// FeatureForwarders, is rolled out to the subject identified by seed,
+// according to the percentages in EvmFeatureFlags.
+func (c *chainScopedConfig) FeatureEnabled(name string, seed uint64) bool {
+	c.persistMu.RLock()
+	flags := c.persistedCfg.EvmFeatureFlags
+	c.persistMu.RUnlock()
+	return flags.Enabled(name, seed)
+}
+
 func (c *chainScopedConfig) EvmGasLimitMax() uint32 {
 	val, ok := c.GeneralConfig.GlobalEvmGasLimitMax()
 	if ok {
@@ -1004,6 +1676,20 @@ func (c *chainScopedConfig) EvmGasLimitMax() uint32 {
 	return c.defaultSet.gasLimitMax
 }
 
+// EvmGasLimitIncrementOnFailure is the amount by which the gas limit of a
+// transaction is increased when a node rejects it for having too little gas
+// to cover its intrinsic cost. The transaction is retried with the higher
+// limit, up to EvmGasLimitMax. Set to 0 to mark such transactions as fatally
+// errored instead.
+func (c *chainScopedConfig) EvmGasLimitIncrementOnFailure() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmGasLimitIncrementOnFailure()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasLimitIncrementOnFailure", val)
+		return val
+	}
+	return c.defaultSet.gasLimitIncrementOnFailure
+}
+
 // EvmGasLimitMultiplier is a factor by which a transaction's GasLimit is
 // multiplied before transmission. So if the value is 1.1, and the GasLimit for
 // a transaction is 10, 10% will be added before transmission.
@@ -1026,6 +1712,18 @@ func (c *chainScopedConfig) EvmGasLimitMultiplier() float32 {
 	return c.defaultSet.gasLimitMultiplier
 }
 
+// EvmGasCapacityBuffer is a factor by which an estimated gas limit is multiplied
+// to give headroom for contract code paths that use more gas than the one that
+// was estimated. The result is still capped by the configured gas limit.
+func (c *chainScopedConfig) EvmGasCapacityBuffer() float64 {
+	val, ok := c.GeneralConfig.GlobalEvmGasCapacityBuffer()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasCapacityBuffer", val)
+		return val
+	}
+	return c.defaultSet.gasCapacityBuffer
+}
+
 // EvmHeadTrackerMaxBufferSize is the maximum number of heads that may be
 // buffered in front of the head tracker before older heads start to be
 // dropped. You may think of it as something like the maximum permittable "lag"
@@ -1115,6 +1813,38 @@ func (c *chainScopedConfig) EvmLogKeepBlocksDepth() uint32 {
 	return c.defaultSet.logKeepBlocksDepth
 }
 
+// EvmLogTTL is how long logs are kept in the log poller table before being
+// pruned, regardless of block depth. Zero disables time-based pruning.
+func (c *chainScopedConfig) EvmLogTTL() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmLogTTL()
+	if ok {
+		c.logEnvOverrideOnce("EvmLogTTL", val)
+		return val
+	}
+	return c.defaultSet.logTTL
+}
+
+// EvmLogPruneInterval is how often logs older than EvmLogTTL are pruned
+func (c *chainScopedConfig) EvmLogPruneInterval() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmLogPruneInterval()
+	if ok {
+		c.logEnvOverrideOnce("EvmLogPruneInterval", val)
+		return val
+	}
+	return c.defaultSet.logPruneInterval
+}
+
+// EvmLogFetcherUseBlockHash makes the log poller backfill one block at a time,
+// querying logs by block hash (EIP-234) rather than by block number range
+func (c *chainScopedConfig) EvmLogFetcherUseBlockHash() bool {
+	val, ok := c.GeneralConfig.GlobalEvmLogFetcherUseBlockHash()
+	if ok {
+		c.logEnvOverrideOnce("EvmLogFetcherUseBlockHash", val)
+		return val
+	}
+	return c.defaultSet.logFetcherUseBlockHash
+}
+
 // EvmLogBackfillBatchSize sets the batch size for calling FilterLogs when we backfill missing logs
 func (c *chainScopedConfig) EvmLogBackfillBatchSize() uint32 {
 	val, ok := c.GeneralConfig.GlobalEvmLogBackfillBatchSize()
@@ -1150,6 +1880,29 @@ func (c *chainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
 	return c.defaultSet.rpcDefaultBatchSize
 }
 
+// EvmRPCMaxInFlight limits the number of concurrent RPC requests to each node.
+// Callers block until a slot is free. Zero means unlimited.
+func (c *chainScopedConfig) EvmRPCMaxInFlight() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmRPCMaxInFlight()
+	if ok {
+		c.logEnvOverrideOnce("EvmRPCMaxInFlight", val)
+		return val
+	}
+	return c.defaultSet.rpcMaxInFlight
+}
+
+// EvmChainReadTimeoutFactor scales the timeout of each RPC request to the
+// expected block time: requests time out after EvmBlockTime multiplied by
+// this factor. Zero means it is unset and the fixed default timeout is used.
+func (c *chainScopedConfig) EvmChainReadTimeoutFactor() float64 {
+	val, ok := c.GeneralConfig.GlobalEvmChainReadTimeoutFactor()
+	if ok {
+		c.logEnvOverrideOnce("EvmChainReadTimeoutFactor", val)
+		return val
+	}
+	return c.defaultSet.rpcReadTimeoutFactor
+}
+
 // FlagsContractAddress represents the Flags contract address
 func (c *chainScopedConfig) FlagsContractAddress() string {
 	val, ok := c.GeneralConfig.GlobalFlagsContractAddress()
@@ -1177,6 +1930,70 @@ func (c *chainScopedConfig) BalanceMonitorEnabled() bool {
 	return c.defaultSet.balanceMonitorEnabled
 }
 
+// EvmAutoWalletFundEnabled enables automatically funding keys whose balance falls below
+// EvmAutoWalletFundThreshold from the EvmAutoWalletFundTreasuryAddress key
+func (c *chainScopedConfig) EvmAutoWalletFundEnabled() bool {
+	val, ok := c.GeneralConfig.GlobalEvmAutoWalletFundEnabled()
+	if ok {
+		c.logEnvOverrideOnce("EvmAutoWalletFundEnabled", val)
+		return val
+	}
+	return c.defaultSet.autoWalletFundEnabled
+}
+
+// EvmAutoWalletFundThreshold is the balance below which a key is topped up
+func (c *chainScopedConfig) EvmAutoWalletFundThreshold() *assets.Eth {
+	val, ok := c.GeneralConfig.GlobalEvmAutoWalletFundThreshold()
+	if ok {
+		c.logEnvOverrideOnce("EvmAutoWalletFundThreshold", val)
+		return (*assets.Eth)(val.ToInt())
+	}
+	return (*assets.Eth)(c.defaultSet.autoWalletFundThreshold.ToInt())
+}
+
+// EvmAutoWalletFundAmount is the amount sent to a key when it is topped up
+func (c *chainScopedConfig) EvmAutoWalletFundAmount() *assets.Eth {
+	val, ok := c.GeneralConfig.GlobalEvmAutoWalletFundAmount()
+	if ok {
+		c.logEnvOverrideOnce("EvmAutoWalletFundAmount", val)
+		return (*assets.Eth)(val.ToInt())
+	}
+	return (*assets.Eth)(c.defaultSet.autoWalletFundAmount.ToInt())
+}
+
+// EvmAutoWalletFundTreasuryAddress is the key which funds the other keys. The zero
+// address means no treasury is configured.
+func (c *chainScopedConfig) EvmAutoWalletFundTreasuryAddress() gethcommon.Address {
+	val, ok := c.GeneralConfig.GlobalEvmAutoWalletFundTreasuryAddress()
+	if ok {
+		c.logEnvOverrideOnce("EvmAutoWalletFundTreasuryAddress", val)
+		return gethcommon.HexToAddress(val)
+	}
+	return gethcommon.Address{}
+}
+
+// EvmWalletBalancePollInterval is how often key balances are checked for automatic funding
+func (c *chainScopedConfig) EvmWalletBalancePollInterval() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmWalletBalancePollInterval()
+	if ok {
+		c.logEnvOverrideOnce("EvmWalletBalancePollInterval", val)
+		return val
+	}
+	return c.defaultSet.walletBalancePollInterval
+}
+
+// EvmMaxOutstandingBalance caps the balance of each key, to limit the funds at
+// risk if a key is compromised. Automatic wallet funding never tops a key up
+// beyond it. If nil, there is no cap.
+func (c *chainScopedConfig) EvmMaxOutstandingBalance() *assets.Eth {
+	val, ok := c.GeneralConfig.GlobalEvmMaxOutstandingBalance()
+	if ok {
+		c.logEnvOverrideOnce("EvmMaxOutstandingBalance", val)
+		return (*assets.Eth)(val.ToInt())
+	}
+	return nil
+}
+
 // EvmEIP1559DynamicFees will send transactions with the 0x2 dynamic fee EIP-2718
 // type and gas fields when enabled
 func (c *chainScopedConfig) EvmEIP1559DynamicFees() bool {
@@ -1195,6 +2012,18 @@ func (c *chainScopedConfig) EvmEIP1559DynamicFees() bool {
 	return c.defaultSet.eip1559DynamicFees
 }
 
+// EvmMinimumFeeMarket requires transactions to use the EIP-1559 fee market. When
+// set, creating a legacy transaction is an error rather than silently falling
+// back to legacy gas pricing.
+func (c *chainScopedConfig) EvmMinimumFeeMarket() bool {
+	val, ok := c.GeneralConfig.GlobalEvmMinimumFeeMarket()
+	if ok {
+		c.logEnvOverrideOnce("EvmMinimumFeeMarket", val)
+		return val
+	}
+	return c.defaultSet.minimumFeeMarket
+}
+
 // EvmGasFeeCapDefault is the fixed amount to set the fee cap on DynamicFee transactions
 func (c *chainScopedConfig) EvmGasFeeCapDefault() *assets.Wei {
 	val, ok := c.GeneralConfig.GlobalEvmGasFeeCapDefault()
@@ -1212,6 +2041,52 @@ func (c *chainScopedConfig) EvmGasFeeCapDefault() *assets.Wei {
 	return &c.defaultSet.gasFeeCapDefault
 }
 
+// EvmGasFeeCapRounding is the value which the fee cap of EIP-1559 transactions
+// is rounded up to a multiple of. If nil, fee caps are not rounded.
+func (c *chainScopedConfig) EvmGasFeeCapRounding() *assets.Wei {
+	val, ok := c.GeneralConfig.GlobalEvmGasFeeCapRounding()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasFeeCapRounding", val)
+		return val
+	}
+	return nil
+}
+
+// EvmBlobGasPriceDefault is the blob gas price used for EIP-4844 blob
+// transactions when the node does not report a blob base fee.
+func (c *chainScopedConfig) EvmBlobGasPriceDefault() *assets.Wei {
+	val, ok := c.GeneralConfig.GlobalEvmBlobGasPriceDefault()
+	if ok {
+		c.logEnvOverrideOnce("EvmBlobGasPriceDefault", val)
+		return val
+	}
+	n := c.defaultSet.blobGasPriceDefault
+	return &n
+}
+
+// EvmMaxBlobGasPrice is the maximum blob gas price that will be paid for
+// EIP-4844 blob transactions. Higher blob base fees are capped to it.
+func (c *chainScopedConfig) EvmMaxBlobGasPrice() *assets.Wei {
+	val, ok := c.GeneralConfig.GlobalEvmMaxBlobGasPrice()
+	if ok {
+		c.logEnvOverrideOnce("EvmMaxBlobGasPrice", val)
+		return val
+	}
+	n := c.defaultSet.maxBlobGasPrice
+	return &n
+}
+
+// EvmFeeHistoryBlocks is the number of blocks requested from eth_feeHistory
+// when estimating blob gas prices.
+func (c *chainScopedConfig) EvmFeeHistoryBlocks() uint16 {
+	val, ok := c.GeneralConfig.GlobalEvmFeeHistoryBlocks()
+	if ok {
+		c.logEnvOverrideOnce("EvmFeeHistoryBlocks", val)
+		return val
+	}
+	return c.defaultSet.feeHistoryBlocks
+}
+
 // EvmGasTipCapDefault is the default value to use for the gas tip on DynamicFee transactions
 // This is analogous to EthGasPriceDefault except the base fee is excluded
 func (c *chainScopedConfig) EvmGasTipCapDefault() *assets.Wei {
@@ -1293,6 +2168,18 @@ func (c *chainScopedConfig) NodeSelectionMode() string {
 	return c.defaultSet.nodeSelectionMode
 }
 
+// EvmNodeSyncThreshold is how many blocks a node may lag behind the highest
+// head of any node in the pool before the pool stops selecting it. Zero
+// disables the check.
+func (c *chainScopedConfig) EvmNodeSyncThreshold() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmNodeSyncThreshold()
+	if ok {
+		c.logEnvOverrideOnce("EvmNodeSyncThreshold", val)
+		return val
+	}
+	return c.defaultSet.nodeSyncThreshold
+}
+
 // OCR2AutomationGasLimit is the gas limit for automation OCR2 plugin
 func (c *chainScopedConfig) OCR2AutomationGasLimit() uint32 {
 	val, ok := c.GeneralConfig.GlobalOCR2AutomationGasLimit()
@@ -1303,6 +2190,47 @@ func (c *chainScopedConfig) OCR2AutomationGasLimit() uint32 {
 	return c.defaultSet.ocr2AutomationGasLimit
 }
 
+// EvmConfigReadOnly prevents runtime config changes from being written to the
+// database, and stops the transaction manager from sending new transactions.
+// Intended for read-only replica nodes.
+func (c *chainScopedConfig) EvmConfigReadOnly() bool {
+	val, ok := c.GeneralConfig.GlobalEvmConfigReadOnly()
+	if ok {
+		c.logEnvOverrideOnce("EvmConfigReadOnly", val)
+		return val
+	}
+	return c.defaultSet.readOnly
+}
+
+// EvmChainLocked prevents new jobs from being created on this chain, so that it
+// can be drained before being decommissioned. Existing jobs keep running.
+func (c *chainScopedConfig) EvmChainLocked() bool {
+	val, ok := c.GeneralConfig.GlobalEvmChainLocked()
+	if ok {
+		c.logEnvOverrideOnce("EvmChainLocked", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmChainLocked
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmChainLocked", p.Bool)
+		return p.Bool
+	}
+	return c.defaultSet.chainLocked
+}
+
+// EvmAutoCreateSendingKey creates a sending key for this chain when it starts,
+// if it does not have one yet
+func (c *chainScopedConfig) EvmAutoCreateSendingKey() bool {
+	val, ok := c.GeneralConfig.GlobalEvmAutoCreateSendingKey()
+	if ok {
+		c.logEnvOverrideOnce("EvmAutoCreateSendingKey", val)
+		return val
+	}
+	return c.defaultSet.autoCreateSendingKey
+}
+
 // https://app.shortcut.com/chainlinklabs/story/33622/remove-legacy-config
 func lookupEnv[T any](c *chainScopedConfig, k string, parse func(string) (T, error)) (t T, ok bool) {
 	s, ok := os.LookupEnv(k)
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -17,11 +20,13 @@ import (
 	v2 "github.com/smartcontractkit/chainlink/core/chains/evm/config/v2"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/config"
+	cfg2 "github.com/smartcontractkit/chainlink/core/config/v2"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	configtest2 "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -36,6 +41,20 @@ func TestChainScopedConfig(t *testing.T) {
 		EvmMaxGasPriceWei: assets.NewWeiI(100000000000000),
 	}, orm, lggr, gcfg)
 
+	t.Run("EvmChainName", func(t *testing.T) {
+		assert.Equal(t, fmt.Sprintf("EVM chain %s", chainID), cfg.EvmChainName())
+	})
+
+	t.Run("EvmChainAlias", func(t *testing.T) {
+		assert.Equal(t, fmt.Sprintf("EVM chain %s", chainID), cfg.EvmChainAlias())
+
+		aliased := evmconfig.NewChainScopedConfig(chainID, evmtypes.ChainCfg{
+			ChainAlias: null.StringFrom("My Chain"),
+		}, orm, lggr, gcfg)
+		assert.Equal(t, "My Chain", aliased.EvmChainAlias())
+		assert.Equal(t, "Polygon Mainnet", evmconfig.ChainAlias(big.NewInt(137), nil))
+	})
+
 	t.Run("EvmGasPriceDefault", func(t *testing.T) {
 		t.Run("sets the gas price", func(t *testing.T) {
 			assert.Equal(t, assets.NewWeiI(20000000000), cfg.EvmGasPriceDefault())
@@ -66,6 +85,44 @@ func TestChainScopedConfig(t *testing.T) {
 
 			assert.Equal(t, assets.NewWeiI(42000000000), cfg.EvmGasPriceDefault())
 		})
+		t.Run("is not allowed to set gas price when read-only", func(t *testing.T) {
+			gcfg.Overrides.GlobalEvmConfigReadOnly = null.BoolFrom(true)
+			t.Cleanup(func() { gcfg.Overrides.GlobalEvmConfigReadOnly = null.Bool{} })
+
+			err := cfg.SetEvmGasPriceDefault(big.NewInt(43000000000))
+			assert.ErrorIs(t, err, evmconfig.ErrReadOnly)
+
+			assert.Equal(t, assets.NewWeiI(42000000000), cfg.EvmGasPriceDefault())
+			got, ok := orm.LoadString(*utils.NewBig(chainID), "EvmGasPriceDefault")
+			if assert.True(t, ok) {
+				assert.Equal(t, "42000000000", got)
+			}
+		})
+	})
+
+	t.Run("EvmGasPriceAutoAdjust", func(t *testing.T) {
+		assert.False(t, cfg.EvmGasPriceAutoAdjust())
+		assert.Equal(t, 5*time.Minute, cfg.EvmGasPriceAutoAdjustInterval())
+
+		adjusted := evmconfig.NewChainScopedConfig(chainID, evmtypes.ChainCfg{
+			EvmGasPriceAutoAdjust:         null.BoolFrom(true),
+			EvmGasPriceAutoAdjustInterval: models.MustNewDuration(time.Minute),
+		}, orm, lggr, gcfg)
+		assert.True(t, adjusted.EvmGasPriceAutoAdjust())
+		assert.Equal(t, time.Minute, adjusted.EvmGasPriceAutoAdjustInterval())
+
+		t.Run("is disabled by ETH_GAS_PRICE_DEFAULT", func(t *testing.T) {
+			gcfg.Overrides.GlobalEvmGasPriceDefault = assets.GWei(30)
+			t.Cleanup(func() { gcfg.Overrides.GlobalEvmGasPriceDefault = nil })
+
+			observedLggr, observed := logger.TestLoggerObserved(t, zapcore.WarnLevel)
+			disabled := evmconfig.NewChainScopedConfig(chainID, evmtypes.ChainCfg{
+				EvmGasPriceAutoAdjust: null.BoolFrom(true),
+			}, orm, observedLggr, gcfg)
+			assert.False(t, disabled.EvmGasPriceAutoAdjust())
+			assert.False(t, disabled.EvmGasPriceAutoAdjust())
+			assert.Equal(t, 1, observed.FilterMessageSnippet("EvmGasPriceAutoAdjust is disabled").Len())
+		})
 	})
 
 	t.Run("KeySpecificMaxGasPriceWei", func(t *testing.T) {
@@ -142,6 +199,29 @@ func TestChainScopedConfig(t *testing.T) {
 
 			assert.Equal(t, val.String(), cfg.KeySpecificMaxGasPriceWei(unsetAddr).String())
 		})
+		t.Run("uses the maximum legal gas price for exempt addresses", func(t *testing.T) {
+			exemptAddr := testutils.NewAddress()
+			gcfg.Overrides.GlobalEvmMaxGasPriceWei = assets.GWei(1200)
+			evmconfig.UpdatePersistedCfg(cfg, func(cfg *evmtypes.ChainCfg) {
+				cfg.EvmMaxGasPriceExemptAddresses = []common.Address{exemptAddr}
+			})
+
+			assert.True(t, cfg.IsMaxGasPriceExempt(exemptAddr))
+			assert.False(t, cfg.IsMaxGasPriceExempt(addr))
+			assert.Equal(t, evmconfig.MaxLegalGasPrice.String(), cfg.KeySpecificMaxGasPriceWei(exemptAddr).String())
+			assert.Equal(t, gcfg.Overrides.GlobalEvmMaxGasPriceWei.String(), cfg.KeySpecificMaxGasPriceWei(addr).String())
+		})
+		t.Run("uses key-specific value for exempt addresses even when higher than global config", func(t *testing.T) {
+			exemptAddr := testutils.NewAddress()
+			keySpecificPrice := assets.GWei(1400)
+			gcfg.Overrides.GlobalEvmMaxGasPriceWei = assets.GWei(1200)
+			evmconfig.UpdatePersistedCfg(cfg, func(cfg *evmtypes.ChainCfg) {
+				cfg.EvmMaxGasPriceExemptAddresses = []common.Address{exemptAddr}
+				cfg.KeySpecific[exemptAddr.Hex()] = evmtypes.ChainCfg{EvmMaxGasPriceWei: keySpecificPrice}
+			})
+
+			assert.Equal(t, keySpecificPrice.String(), cfg.KeySpecificMaxGasPriceWei(exemptAddr).String())
+		})
 	})
 
 	t.Run("LinkContractAddress", func(t *testing.T) {
@@ -189,6 +269,53 @@ func TestChainScopedConfig(t *testing.T) {
 	})
 }
 
+func TestChainScopedConfig_OnMaxGasPriceChange(t *testing.T) {
+	orm := make(fakeChainConfigORM)
+	chainID := big.NewInt(rand.Int63())
+	gcfg := configtest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	cfg := evmconfig.NewChainScopedConfig(chainID, evmtypes.ChainCfg{
+		EvmMaxGasPriceWei: assets.GWei(100),
+	}, orm, lggr, gcfg)
+
+	type change struct {
+		chainID        *big.Int
+		oldVal, newVal *assets.Wei
+	}
+	var changes1, changes2 []change
+	cfg.OnMaxGasPriceChange(func(chainID *big.Int, oldVal, newVal *assets.Wei) {
+		changes1 = append(changes1, change{chainID, oldVal, newVal})
+	})
+	cfg.OnMaxGasPriceChange(func(chainID *big.Int, oldVal, newVal *assets.Wei) {
+		changes2 = append(changes2, change{chainID, oldVal, newVal})
+	})
+
+	// Unrelated changes do not notify
+	evmconfig.UpdatePersistedCfg(cfg, func(cfg *evmtypes.ChainCfg) {
+		cfg.EvmGasBumpWei = assets.GWei(1)
+	})
+	assert.Empty(t, changes1)
+
+	evmconfig.UpdatePersistedCfg(cfg, func(cfg *evmtypes.ChainCfg) {
+		cfg.EvmMaxGasPriceWei = assets.GWei(200)
+	})
+	cfg.Configure(evmtypes.ChainCfg{EvmMaxGasPriceWei: assets.GWei(300)})
+	// Same value does not notify
+	cfg.Configure(evmtypes.ChainCfg{EvmMaxGasPriceWei: assets.GWei(300)})
+
+	expected := []change{
+		{chainID, assets.GWei(100), assets.GWei(200)},
+		{chainID, assets.GWei(200), assets.GWei(300)},
+	}
+	assert.Equal(t, expected, changes1)
+	assert.Equal(t, expected, changes2)
+
+	// Persisted changes are masked by the global override
+	gcfg.Overrides.GlobalEvmMaxGasPriceWei = assets.GWei(1000)
+	cfg.Configure(evmtypes.ChainCfg{EvmMaxGasPriceWei: assets.GWei(400)})
+	assert.Equal(t, expected, changes1)
+}
+
 func TestChainScopedConfig_BSCDefaults(t *testing.T) {
 	orm := make(fakeChainConfigORM)
 	chainID := big.NewInt(56)
@@ -204,6 +331,9 @@ func TestChainScopedConfig_BSCDefaults(t *testing.T) {
 	require.Equal(t, 2*time.Second, timeout)
 	timeout = cfg.OCRObservationGracePeriod()
 	require.Equal(t, 500*time.Millisecond, timeout)
+	require.Equal(t, 15*time.Minute, cfg.EthTxReaperInterval())
+	require.Equal(t, 24*time.Hour, cfg.EthTxReaperThreshold())
+	require.Equal(t, "BSC Mainnet", cfg.EvmChainName())
 }
 
 func TestChainScopedConfig_Profiles(t *testing.T) {
@@ -258,7 +388,7 @@ func configWithChain(t *testing.T, id int64, chain *v2.Chain) config.GeneralConf
 	return configtest2.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
 		chainID := utils.NewBigI(id)
 		c.EVM[0] = &v2.EVMConfig{ChainID: chainID, Enabled: ptr(true), Chain: v2.DefaultsFrom(chainID, chain),
-			Nodes: v2.EVMNodes{{Name: ptr("fake"), HTTPURL: models.MustParseURL("http://foo.test")}}}
+			Nodes: v2.EVMNodes{{Name: ptr("fake"), WSURL: models.MustParseURL("wss://foo.test"), HTTPURL: models.MustParseURL("http://foo.test")}}}
 	})
 }
 
@@ -334,6 +464,42 @@ func Test_chainScopedConfig_Validate(t *testing.T) {
 	})
 }
 
+func TestChainScopedConfig_TOMLRoundTrip(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	methods := reflect.TypeOf((*evmconfig.ChainScopedOnlyConfig)(nil)).Elem()
+	for id := range evmconfig.ChainSpecificConfigDefaultSets() {
+		id := id
+		t.Run(fmt.Sprintf("chainID-%d", id), func(t *testing.T) {
+			gcfg := configWithChain(t, id, nil)
+			evmCfgs := gcfg.(v2.HasEVMConfigs).EVMConfigs()
+			require.Len(t, evmCfgs, 1)
+
+			s, err := (&chainlink.Config{EVM: evmCfgs}).TOMLString()
+			require.NoError(t, err)
+			var parsed chainlink.Config
+			require.NoError(t, cfg2.DecodeTOML(strings.NewReader(s), &parsed))
+			require.Len(t, parsed.EVM, 1)
+			s2, err := parsed.TOMLString()
+			require.NoError(t, err)
+			assert.Equal(t, s, s2, "marshalling is not idempotent")
+
+			before := reflect.ValueOf(v2.NewTOMLChainScopedConfig(gcfg, evmCfgs[0], lggr))
+			after := reflect.ValueOf(v2.NewTOMLChainScopedConfig(gcfg, parsed.EVM[0], lggr))
+			for i := 0; i < methods.NumMethod(); i++ {
+				m := methods.Method(i)
+				if m.Type.NumIn() > 0 {
+					continue
+				}
+				exp := before.MethodByName(m.Name).Call(nil)
+				act := after.MethodByName(m.Name).Call(nil)
+				for j := range exp {
+					assert.Equal(t, exp[j].Interface(), act[j].Interface(), m.Name)
+				}
+			}
+		})
+	}
+}
+
 type fakeChainConfigORM map[string]map[string]string
 
 func (f fakeChainConfigORM) LoadString(chainID utils.Big, key string) (val string, ok bool) {
@@ -363,4 +529,62 @@ func (f fakeChainConfigORM) Clear(chainID utils.Big, key string) error {
 	return nil
 }
 
+func (f fakeChainConfigORM) GetAuditLog(chainID utils.Big, key string) ([]evmtypes.ConfigAuditLogEntry, error) {
+	return nil, nil
+}
+
 func ptr[T any](t T) *T { return &t }
+
+func TestEffectiveConfig(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(56)
+	key := testutils.NewAddress()
+	lggr := logger.TestLogger(t)
+	byName := func(fields []evmconfig.ConfigField) map[string]evmconfig.ConfigField {
+		m := map[string]evmconfig.ConfigField{}
+		for _, f := range fields {
+			m[f.Name] = f
+		}
+		return m
+	}
+	keyField := fmt.Sprintf("KeySpecificMaxGasPriceWei(%s)", key)
+
+	t.Run("legacy", func(t *testing.T) {
+		gcfg := configtest.NewTestGeneralConfig(t)
+		gcfg.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+		cfg := evmconfig.NewChainScopedConfig(chainID, evmtypes.ChainCfg{
+			EvmFinalityDepth: null.IntFrom(42),
+			KeySpecific: map[string]evmtypes.ChainCfg{
+				key.Hex(): {EvmMaxGasPriceWei: assets.NewWeiI(1000)},
+			},
+		}, nil, lggr, gcfg)
+
+		fields, err := evmconfig.EffectiveConfig(cfg)
+		require.NoError(t, err)
+		m := byName(fields)
+		assert.Equal(t, evmconfig.ConfigField{Name: "EvmNonceAutoSync", Value: "false", Source: evmconfig.ConfigSourceGlobal}, m["EvmNonceAutoSync"])
+		assert.Equal(t, evmconfig.ConfigField{Name: "EvmFinalityDepth", Value: "42", Source: evmconfig.ConfigSourceChainOverride}, m["EvmFinalityDepth"])
+		assert.Equal(t, evmconfig.ConfigField{Name: "EthTxReaperInterval", Value: "15m0s", Source: evmconfig.ConfigSourceDefault}, m["EthTxReaperInterval"])
+		assert.Equal(t, evmconfig.ConfigField{Name: keyField, Value: "1 kwei", Source: evmconfig.ConfigSourceKeySpecific}, m[keyField])
+	})
+
+	t.Run("v2", func(t *testing.T) {
+		id := utils.NewBig(chainID)
+		evmCfg := v2.EVMConfig{ChainID: id, Chain: v2.DefaultsFrom(id, &v2.Chain{
+			FinalityDepth: ptr[uint32](42),
+			KeySpecific: v2.KeySpecificConfig{{
+				Key:          ptr(ethkey.EIP55AddressFromAddress(key)),
+				GasEstimator: v2.KeySpecificGasEstimator{PriceMax: assets.NewWeiI(1000)},
+			}},
+		})}
+		cfg := v2.NewTOMLChainScopedConfig(configtest2.NewGeneralConfig(t, nil), &evmCfg, lggr)
+
+		fields, err := evmconfig.EffectiveConfig(cfg)
+		require.NoError(t, err)
+		m := byName(fields)
+		assert.Equal(t, evmconfig.ConfigField{Name: "EvmFinalityDepth", Value: "42", Source: evmconfig.ConfigSourceChainOverride}, m["EvmFinalityDepth"])
+		assert.Equal(t, evmconfig.ConfigField{Name: "EthTxReaperInterval", Value: "15m0s", Source: evmconfig.ConfigSourceDefault}, m["EthTxReaperInterval"])
+		assert.Equal(t, evmconfig.ConfigField{Name: keyField, Value: "1 kwei", Source: evmconfig.ConfigSourceKeySpecific}, m[keyField])
+	})
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	v2 "github.com/smartcontractkit/chainlink/core/chains/evm/config/v2"
+)
+
+// ConfigSource describes where the effective value of a chain scoped config field comes from.
+type ConfigSource string
+
+const (
+	// ConfigSourceKeySpecific values apply only to a single key.
+	ConfigSourceKeySpecific ConfigSource = "key-specific"
+	// ConfigSourceChainOverride values are configured for the chain.
+	ConfigSourceChainOverride ConfigSource = "chain-override"
+	// ConfigSourceGlobal values are configured for every chain by an env var.
+	ConfigSourceGlobal ConfigSource = "global"
+	// ConfigSourceDefault values are the defaults for the chain.
+	ConfigSourceDefault ConfigSource = "default"
+)
+
+// ConfigField is the effective value of a single ChainScopedOnlyConfig field.
+type ConfigField struct {
+	Name   string
+	Value  string
+	Source ConfigSource
+}
+
+// EffectiveConfig returns the effective value and source of every ChainScopedOnlyConfig field of cfg. Fields which
+// accept arguments are skipped, except for KeySpecificMaxGasPriceWei which is included once for each key with key
+// specific config.
+func EffectiveConfig(cfg ChainScopedConfig) ([]ConfigField, error) {
+	var source func(name string, value reflect.Value) ConfigSource
+	var keys []gethcommon.Address
+	switch c := cfg.(type) {
+	case *v2.ChainScoped:
+		defaults := reflect.ValueOf(c.Defaults())
+		source = func(name string, value reflect.Value) ConfigSource {
+			if formatValue(defaults.MethodByName(name).Call(nil)[0]) == formatValue(value) {
+				return ConfigSourceDefault
+			}
+			return ConfigSourceChainOverride
+		}
+		keys = c.KeySpecificAddresses()
+	case *chainScopedConfig:
+		source = c.fieldSource
+		for k := range c.PersistedConfig().KeySpecific {
+			keys = append(keys, gethcommon.HexToAddress(k))
+		}
+	default:
+		return nil, fmt.Errorf("unsupported chain scoped config type %T", cfg)
+	}
+
+	var fields []ConfigField
+	cv := reflect.ValueOf(cfg)
+	ct := reflect.TypeOf((*ChainScopedOnlyConfig)(nil)).Elem()
+	for i := 0; i < ct.NumMethod(); i++ {
+		m := ct.Method(i)
+		if m.Type.NumIn() > 0 || m.Type.NumOut() != 1 {
+			continue
+		}
+		val := cv.MethodByName(m.Name).Call(nil)[0]
+		fields = append(fields, ConfigField{Name: m.Name, Value: formatValue(val), Source: source(m.Name, val)})
+	}
+
+	chainMax := formatValue(reflect.ValueOf(cfg.EvmMaxGasPriceWei()))
+	for _, k := range keys {
+		f := ConfigField{
+			Name:   fmt.Sprintf("KeySpecificMaxGasPriceWei(%s)", k),
+			Value:  formatValue(reflect.ValueOf(cfg.KeySpecificMaxGasPriceWei(k))),
+			Source: ConfigSourceKeySpecific,
+		}
+		if f.Value == chainMax {
+			// key specific value is not in effect
+			continue
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// fieldSource returns the source of the named field, following the same precedence as the accessors: global env vars,
+// then persisted chain config, then chain specific defaults.
+func (c *chainScopedConfig) fieldSource(name string, _ reflect.Value) ConfigSource {
+	if global := reflect.ValueOf(c.GeneralConfig).MethodByName("Global" + name); global.IsValid() && global.Type().NumIn() == 0 {
+		if out := global.Call(nil); len(out) == 2 && out[1].Kind() == reflect.Bool && out[1].Bool() {
+			return ConfigSourceGlobal
+		}
+	}
+	persisted := reflect.ValueOf(c.PersistedConfig())
+	if f := persisted.FieldByName(name); f.IsValid() && !f.IsZero() {
+		return ConfigSourceChainOverride
+	}
+	return ConfigSourceDefault
+}
+
+func formatValue(v reflect.Value) string {
+	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		if s, ok := v.Interface().(fmt.Stringer); ok {
+			return s.String()
+		}
+		return formatValue(v.Elem())
+	}
+	return fmt.Sprint(v.Interface())
+}
@@ -3,10 +3,7 @@ package config
 import "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 
 func UpdatePersistedCfg(cfg ChainScopedConfig, updateFn func(*types.ChainCfg)) {
-	c := cfg.(*chainScopedConfig)
-	c.persistMu.Lock()
-	defer c.persistMu.Unlock()
-	updateFn(&c.persistedCfg)
+	cfg.(*chainScopedConfig).updatePersistedCfg(updateFn)
 }
 
 func ChainSpecificConfigDefaultSets() map[int64]chainSpecificConfigDefaultSet {
@@ -937,6 +937,252 @@ func (_m *ChainScopedConfig) EthereumURL() string {
 	return r0
 }
 
+// EvmAllowUnprotectedTxs provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmAllowUnprotectedTxs() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmAutoCreateSendingKey provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmAutoCreateSendingKey() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmAutoWalletFundAmount provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmAutoWalletFundAmount() *assets.Eth {
+	ret := _m.Called()
+
+	var r0 *assets.Eth
+	if rf, ok := ret.Get(0).(func() *assets.Eth); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Eth)
+		}
+	}
+
+	return r0
+}
+
+// EvmAutoWalletFundEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmAutoWalletFundEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmAutoWalletFundThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmAutoWalletFundThreshold() *assets.Eth {
+	ret := _m.Called()
+
+	var r0 *assets.Eth
+	if rf, ok := ret.Get(0).(func() *assets.Eth); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Eth)
+		}
+	}
+
+	return r0
+}
+
+// EvmAutoWalletFundTreasuryAddress provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmAutoWalletFundTreasuryAddress() common.Address {
+	ret := _m.Called()
+
+	var r0 common.Address
+	if rf, ok := ret.Get(0).(func() common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	return r0
+}
+
+// EvmBlobGasPriceDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmBlobGasPriceDefault() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// EvmBlockTime provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmBlockTime() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmCallDataSizeLimit provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmCallDataSizeLimit() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmChainAlias provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmChainAlias() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EvmChainLocked provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmChainLocked() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmChainName provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmChainName() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EvmChainReadTimeoutFactor provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmChainReadTimeoutFactor() float64 {
+	ret := _m.Called()
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// EvmConfigReadOnly provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmConfigReadOnly() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmContractCallTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmContractCallTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmContractCreationGasLimit provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmContractCreationGasLimit() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmDatabaseQueryTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmDatabaseQueryTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EvmEIP1559DynamicFees provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmEIP1559DynamicFees() bool {
 	ret := _m.Called()
@@ -951,6 +1197,20 @@ func (_m *ChainScopedConfig) EvmEIP1559DynamicFees() bool {
 	return r0
 }
 
+// EvmFeeHistoryBlocks provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmFeeHistoryBlocks() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // EvmFinalityDepth provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmFinalityDepth() uint32 {
 	ret := _m.Called()
@@ -965,6 +1225,20 @@ func (_m *ChainScopedConfig) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmGasBumpInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasBumpInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EvmGasBumpPercent provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmGasBumpPercent() uint16 {
 	ret := _m.Called()
@@ -973,42 +1247,700 @@ func (_m *ChainScopedConfig) EvmGasBumpPercent() uint16 {
 	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// EvmGasBumpThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasBumpThreshold() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// EvmGasBumpTxDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasBumpTxDepth() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// EvmGasBumpWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasBumpWei() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasCapacityBuffer provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasCapacityBuffer() float64 {
+	ret := _m.Called()
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// EvmGasEstimatorBlockHistoryEWMAAlpha provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasEstimatorBlockHistoryEWMAAlpha() float64 {
+	ret := _m.Called()
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// EvmGasEstimatorCheckRetries provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasEstimatorCheckRetries() uint8 {
+	ret := _m.Called()
+
+	var r0 uint8
+	if rf, ok := ret.Get(0).(func() uint8); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint8)
+	}
+
+	return r0
+}
+
+// EvmGasEstimatorFallbackMode provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasEstimatorFallbackMode() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EvmGasEstimatorMinSampleCount provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasEstimatorMinSampleCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// EvmGasEstimatorTransactionPriceHistory provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasEstimatorTransactionPriceHistory() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// EvmGasFeeCapDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasFeeCapDefault() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasFeeCapRounding provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasFeeCapRounding() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasHistoryExcludeZeroPriceTxs provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasHistoryExcludeZeroPriceTxs() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmGasLimitDRJobType provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitDRJobType() *uint32 {
+	ret := _m.Called()
+
+	var r0 *uint32
+	if rf, ok := ret.Get(0).(func() *uint32); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uint32)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasLimitDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitDefault() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmGasLimitFMJobType provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitFMJobType() *uint32 {
+	ret := _m.Called()
+
+	var r0 *uint32
+	if rf, ok := ret.Get(0).(func() *uint32); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uint32)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasLimitIncrementOnFailure provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitIncrementOnFailure() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmGasLimitKeeperJobType provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitKeeperJobType() *uint32 {
+	ret := _m.Called()
+
+	var r0 *uint32
+	if rf, ok := ret.Get(0).(func() *uint32); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uint32)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasLimitMax provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitMax() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmGasLimitMultiplier provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitMultiplier() float32 {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	return r0
+}
+
+// EvmGasLimitOCRJobType provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitOCRJobType() *uint32 {
+	ret := _m.Called()
+
+	var r0 *uint32
+	if rf, ok := ret.Get(0).(func() *uint32); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uint32)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasLimitTransfer provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitTransfer() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmGasLimitVRFJobType provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitVRFJobType() *uint32 {
+	ret := _m.Called()
+
+	var r0 *uint32
+	if rf, ok := ret.Get(0).(func() *uint32); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uint32)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasNoBumpThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasNoBumpThreshold() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// EvmGasOracleAddress provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasOracleAddress() common.Address {
+	ret := _m.Called()
+
+	var r0 common.Address
+	if rf, ok := ret.Get(0).(func() common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasOraclePollInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasOraclePollInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmGasPriceAutoAdjust provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasPriceAutoAdjust() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmGasPriceAutoAdjustInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasPriceAutoAdjustInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmGasPriceCacheTTL provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasPriceCacheTTL() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmGasPriceDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasPriceDefault() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasPriceFeedAddress provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasPriceFeedAddress() common.Address {
+	ret := _m.Called()
+
+	var r0 common.Address
+	if rf, ok := ret.Get(0).(func() common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasPriceFeedEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasPriceFeedEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmGasPriceFloor provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasPriceFloor() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasTipCapDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasTipCapDefault() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasTipCapMinimum provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasTipCapMinimum() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// EvmHeadTrackerBlockDelay provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmHeadTrackerBlockDelay() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// EvmHeadTrackerCallbackTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmHeadTrackerCallbackTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmHeadTrackerHistoryDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmHeadTrackerHistoryDepth() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmHeadTrackerMaxBufferSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmHeadTrackerMaxBufferSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmHeadTrackerSamplingInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmHeadTrackerSamplingInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmHealthCheckGracePeriod provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmHealthCheckGracePeriod() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmL2SequencerHTTPURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmL2SequencerHTTPURL() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EvmLogBackfillBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmLogBackfillBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmLogFetcherUseBlockHash provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmLogFetcherUseBlockHash() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmLogKeepBlocksDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmLogKeepBlocksDepth() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmLogPollInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmLogPollInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
 }
 
-// EvmGasBumpThreshold provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasBumpThreshold() uint64 {
+// EvmLogPruneInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmLogPruneInterval() time.Duration {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
 }
 
-// EvmGasBumpTxDepth provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasBumpTxDepth() uint16 {
+// EvmLogTTL provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmLogTTL() time.Duration {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
 }
 
-// EvmGasBumpWei provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasBumpWei() *assets.Wei {
+// EvmMaxBlobGasPrice provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxBlobGasPrice() *assets.Wei {
 	ret := _m.Called()
 
 	var r0 *assets.Wei
@@ -1023,40 +1955,52 @@ func (_m *ChainScopedConfig) EvmGasBumpWei() *assets.Wei {
 	return r0
 }
 
-// EvmGasFeeCapDefault provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasFeeCapDefault() *assets.Wei {
+// EvmMaxBlockAge provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxBlockAge() time.Duration {
 	ret := _m.Called()
 
-	var r0 *assets.Wei
-	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*assets.Wei)
-		}
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
 }
 
-// EvmGasLimitDRJobType provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasLimitDRJobType() *uint32 {
+// EvmMaxGasPriceWarningThresholdPercent provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxGasPriceWarningThresholdPercent() uint8 {
 	ret := _m.Called()
 
-	var r0 *uint32
-	if rf, ok := ret.Get(0).(func() *uint32); ok {
+	var r0 uint8
+	if rf, ok := ret.Get(0).(func() uint8); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint8)
+	}
+
+	return r0
+}
+
+// EvmMaxGasPriceWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxGasPriceWei() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*uint32)
+			r0 = ret.Get(0).(*assets.Wei)
 		}
 	}
 
 	return r0
 }
 
-// EvmGasLimitDefault provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasLimitDefault() uint32 {
+// EvmMaxInFlightTransactions provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxInFlightTransactions() uint32 {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -1069,40 +2013,38 @@ func (_m *ChainScopedConfig) EvmGasLimitDefault() uint32 {
 	return r0
 }
 
-// EvmGasLimitFMJobType provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasLimitFMJobType() *uint32 {
+// EvmMaxOutstandingBalance provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxOutstandingBalance() *assets.Eth {
 	ret := _m.Called()
 
-	var r0 *uint32
-	if rf, ok := ret.Get(0).(func() *uint32); ok {
+	var r0 *assets.Eth
+	if rf, ok := ret.Get(0).(func() *assets.Eth); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*uint32)
+			r0 = ret.Get(0).(*assets.Eth)
 		}
 	}
 
 	return r0
 }
 
-// EvmGasLimitKeeperJobType provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasLimitKeeperJobType() *uint32 {
+// EvmMaxQueuedTransactions provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxQueuedTransactions() uint64 {
 	ret := _m.Called()
 
-	var r0 *uint32
-	if rf, ok := ret.Get(0).(func() *uint32); ok {
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*uint32)
-		}
+		r0 = ret.Get(0).(uint64)
 	}
 
 	return r0
 }
 
-// EvmGasLimitMax provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasLimitMax() uint32 {
+// EvmMaxReorgDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxReorgDepth() uint32 {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -1115,144 +2057,122 @@ func (_m *ChainScopedConfig) EvmGasLimitMax() uint32 {
 	return r0
 }
 
-// EvmGasLimitMultiplier provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasLimitMultiplier() float32 {
+// EvmMaxRevertedTransactionsPerBlock provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxRevertedTransactionsPerBlock() uint16 {
 	ret := _m.Called()
 
-	var r0 float32
-	if rf, ok := ret.Get(0).(func() float32); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(float32)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	return r0
 }
 
-// EvmGasLimitOCRJobType provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasLimitOCRJobType() *uint32 {
+// EvmMinGasPriceWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMinGasPriceWei() *assets.Wei {
 	ret := _m.Called()
 
-	var r0 *uint32
-	if rf, ok := ret.Get(0).(func() *uint32); ok {
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*uint32)
+			r0 = ret.Get(0).(*assets.Wei)
 		}
 	}
 
 	return r0
 }
 
-// EvmGasLimitTransfer provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasLimitTransfer() uint32 {
-	ret := _m.Called()
-
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
-		r0 = rf()
-	} else {
-		r0 = ret.Get(0).(uint32)
-	}
-
-	return r0
-}
-
-// EvmGasLimitVRFJobType provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasLimitVRFJobType() *uint32 {
+// EvmMinimumFeeMarket provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMinimumFeeMarket() bool {
 	ret := _m.Called()
 
-	var r0 *uint32
-	if rf, ok := ret.Get(0).(func() *uint32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*uint32)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 
 	return r0
 }
 
-// EvmGasPriceDefault provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasPriceDefault() *assets.Wei {
+// EvmNodeSyncThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmNodeSyncThreshold() uint32 {
 	ret := _m.Called()
 
-	var r0 *assets.Wei
-	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*assets.Wei)
-		}
+		r0 = ret.Get(0).(uint32)
 	}
 
 	return r0
 }
 
-// EvmGasTipCapDefault provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasTipCapDefault() *assets.Wei {
+// EvmNonceAutoFillGap provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmNonceAutoFillGap() bool {
 	ret := _m.Called()
 
-	var r0 *assets.Wei
-	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*assets.Wei)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 
 	return r0
 }
 
-// EvmGasTipCapMinimum provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasTipCapMinimum() *assets.Wei {
+// EvmNonceAutoSync provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmNonceAutoSync() bool {
 	ret := _m.Called()
 
-	var r0 *assets.Wei
-	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*assets.Wei)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 
 	return r0
 }
 
-// EvmHeadTrackerHistoryDepth provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmHeadTrackerHistoryDepth() uint32 {
+// EvmOCRContractLookbackBlocks provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmOCRContractLookbackBlocks() uint64 {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(uint64)
 	}
 
 	return r0
 }
 
-// EvmHeadTrackerMaxBufferSize provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmHeadTrackerMaxBufferSize() uint32 {
+// EvmOCRKeyBundleID provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmOCRKeyBundleID() string {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(string)
 	}
 
 	return r0
 }
 
-// EvmHeadTrackerSamplingInterval provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmHeadTrackerSamplingInterval() time.Duration {
+// EvmOCRMinObservationTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmOCRMinObservationTimeout() time.Duration {
 	ret := _m.Called()
 
 	var r0 time.Duration
@@ -1265,8 +2185,8 @@ func (_m *ChainScopedConfig) EvmHeadTrackerSamplingInterval() time.Duration {
 	return r0
 }
 
-// EvmLogBackfillBatchSize provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmLogBackfillBatchSize() uint32 {
+// EvmRPCDefaultBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -1279,8 +2199,8 @@ func (_m *ChainScopedConfig) EvmLogBackfillBatchSize() uint32 {
 	return r0
 }
 
-// EvmLogKeepBlocksDepth provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmLogKeepBlocksDepth() uint32 {
+// EvmRPCMaxInFlight provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmRPCMaxInFlight() uint32 {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -1293,8 +2213,8 @@ func (_m *ChainScopedConfig) EvmLogKeepBlocksDepth() uint32 {
 	return r0
 }
 
-// EvmLogPollInterval provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmLogPollInterval() time.Duration {
+// EvmReceiptPollingInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmReceiptPollingInterval() time.Duration {
 	ret := _m.Called()
 
 	var r0 time.Duration
@@ -1307,68 +2227,50 @@ func (_m *ChainScopedConfig) EvmLogPollInterval() time.Duration {
 	return r0
 }
 
-// EvmMaxGasPriceWei provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmMaxGasPriceWei() *assets.Wei {
-	ret := _m.Called()
-
-	var r0 *assets.Wei
-	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
-		r0 = rf()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*assets.Wei)
-		}
-	}
-
-	return r0
-}
-
-// EvmMaxInFlightTransactions provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmMaxInFlightTransactions() uint32 {
+// EvmSimulateTransactions provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmSimulateTransactions() bool {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	return r0
 }
 
-// EvmMaxQueuedTransactions provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmMaxQueuedTransactions() uint64 {
+// EvmTrackerBlockHistoryFlushInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTrackerBlockHistoryFlushInterval() time.Duration {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
 }
 
-// EvmMinGasPriceWei provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmMinGasPriceWei() *assets.Wei {
+// EvmTxExpiry provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTxExpiry() time.Duration {
 	ret := _m.Called()
 
-	var r0 *assets.Wei
-	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*assets.Wei)
-		}
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
 }
 
-// EvmNonceAutoSync provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmNonceAutoSync() bool {
+// EvmTxPriorityQueue provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTxPriorityQueue() bool {
 	ret := _m.Called()
 
 	var r0 bool
@@ -1381,29 +2283,29 @@ func (_m *ChainScopedConfig) EvmNonceAutoSync() bool {
 	return r0
 }
 
-// EvmRPCDefaultBatchSize provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
+// EvmUseForwarders provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmUseForwarders() bool {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	return r0
 }
 
-// EvmUseForwarders provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmUseForwarders() bool {
+// EvmWalletBalancePollInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmWalletBalancePollInterval() time.Duration {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
@@ -1481,6 +2383,20 @@ func (_m *ChainScopedConfig) FMSimulateTransactions() bool {
 	return r0
 }
 
+// FeatureEnabled provides a mock function with given fields: name, seed
+func (_m *ChainScopedConfig) FeatureEnabled(name string, seed uint64) bool {
+	ret := _m.Called(name, seed)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, uint64) bool); ok {
+		r0 = rf(name, seed)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // FeatureExternalInitiators provides a mock function with given fields:
 func (_m *ChainScopedConfig) FeatureExternalInitiators() bool {
 	ret := _m.Called()
@@ -1649,6 +2565,20 @@ func (_m *ChainScopedConfig) InsecureFastScrypt() bool {
 	return r0
 }
 
+// IsMaxGasPriceExempt provides a mock function with given fields: addr
+func (_m *ChainScopedConfig) IsMaxGasPriceExempt(addr common.Address) bool {
+	ret := _m.Called(addr)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(common.Address) bool); ok {
+		r0 = rf(addr)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // JSONConsole provides a mock function with given fields:
 func (_m *ChainScopedConfig) JSONConsole() bool {
 	ret := _m.Called()
@@ -2547,6 +3477,11 @@ func (_m *ChainScopedConfig) ORMMaxOpenConns() int {
 	return r0
 }
 
+// OnMaxGasPriceChange provides a mock function with given fields: fn
+func (_m *ChainScopedConfig) OnMaxGasPriceChange(fn func(*big.Int, *assets.Wei, *assets.Wei)) {
+	_m.Called(fn)
+}
+
 // OperatorFactoryAddress provides a mock function with given fields:
 func (_m *ChainScopedConfig) OperatorFactoryAddress() string {
 	ret := _m.Called()
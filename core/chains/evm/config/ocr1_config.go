@@ -11,6 +11,17 @@ func (c *chainScopedConfig) OCRContractConfirmations() uint16 {
 	return c.defaultSet.ocrContractConfirmations
 }
 
+// EvmOCRContractLookbackBlocks is the number of blocks searched back from the
+// latest head for the contract's logs when an OCR job is started
+func (c *chainScopedConfig) EvmOCRContractLookbackBlocks() uint64 {
+	val, ok := c.GeneralConfig.GlobalEvmOCRContractLookbackBlocks()
+	if ok {
+		c.logEnvOverrideOnce("EvmOCRContractLookbackBlocks", val)
+		return val
+	}
+	return c.defaultSet.ocrContractLookbackBlocks
+}
+
 func (c *chainScopedConfig) OCRContractTransmitterTransmitTimeout() time.Duration {
 	val, ok := c.GeneralConfig.GlobalOCRContractTransmitterTransmitTimeout()
 	if ok {
@@ -37,3 +48,29 @@ func (c *chainScopedConfig) OCRObservationGracePeriod() time.Duration {
 	}
 	return c.defaultSet.ocrObservationGracePeriod
 }
+
+// EvmOCRMinObservationTimeout is the lowest observation timeout used by OCR
+// jobs on this chain. Jobs with a lower observationTimeout, or none when
+// OCR_OBSERVATION_TIMEOUT is lower, use this instead.
+func (c *chainScopedConfig) EvmOCRMinObservationTimeout() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmOCRMinObservationTimeout()
+	if ok {
+		c.logEnvOverrideOnce("EvmOCRMinObservationTimeout", val)
+		return val
+	}
+	return c.defaultSet.ocrMinObservationTimeout
+}
+
+// EvmOCRKeyBundleID is the OCR key bundle used to sign for OCR jobs on this
+// chain which do not specify one. If empty, the node-wide OCR_KEY_BUNDLE_ID is
+// used.
+func (c *chainScopedConfig) EvmOCRKeyBundleID() string {
+	c.persistMu.RLock()
+	p := c.persistedCfg.OCRKeyBundleID
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmOCRKeyBundleID", p.String)
+		return p.String
+	}
+	return ""
+}
@@ -13,9 +13,14 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 	gencfg "github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+// maxLegalGasPrice is the most a key exempt from PriceMax may pay.
+var maxLegalGasPrice = assets.NewWei(utils.MaxUint256)
+
 func NewTOMLChainScopedConfig(genCfg gencfg.BasicConfig, chain *EVMConfig, lggr logger.Logger) *ChainScoped {
+	lggr = lggr.With("evmChainName", chainName(chain.ChainID))
 	return &ChainScoped{BasicConfig: genCfg, cfg: chain, lggr: lggr}
 }
 
@@ -31,6 +36,10 @@ func (c *ChainScoped) ChainID() *big.Int {
 	return c.cfg.ChainID.ToInt()
 }
 
+func (c *ChainScoped) EvmChainName() string {
+	return chainName(c.cfg.ChainID)
+}
+
 func (c *ChainScoped) ChainType() gencfg.ChainType {
 	if c.cfg.ChainType == nil {
 		return ""
@@ -50,12 +59,18 @@ func (c *ChainScoped) Validate() (err error) {
 		DataSourceTimeout:                      c.OCRObservationTimeout(),
 		DataSourceGracePeriod:                  c.OCRObservationGracePeriod(),
 	}
+	if min := c.EvmOCRMinObservationTimeout(); lc.DataSourceTimeout < min {
+		lc.DataSourceTimeout = min
+	}
 	if ocrerr := ocr.SanityCheckLocalConfig(lc); ocrerr != nil {
 		err = multierr.Append(err, ocrerr)
 	}
 	return
 }
 
+// OnMaxGasPriceChange is a no-op, since TOML config cannot be changed at runtime.
+func (c *ChainScoped) OnMaxGasPriceChange(func(chainID *big.Int, oldVal, newVal *assets.Wei)) {}
+
 func (c *ChainScoped) BlockBackfillDepth() uint64 {
 	return uint64(*c.cfg.BlockBackfillDepth)
 }
@@ -263,15 +278,55 @@ func (c *ChainScoped) KeySpecificMaxGasPriceWei(addr common.Address) *assets.Wei
 			break
 		}
 	}
+	hasKeySpecific := keySpecific != nil && !keySpecific.IsZero()
+
+	if c.IsMaxGasPriceExempt(addr) {
+		// exempt keys are not capped by PriceMax, but their own maximum still applies
+		if hasKeySpecific {
+			return keySpecific
+		}
+		return maxLegalGasPrice
+	}
 
 	chainSpecific := c.EvmMaxGasPriceWei()
-	if keySpecific != nil && !keySpecific.IsZero() && keySpecific.Cmp(chainSpecific) < 0 {
+	if hasKeySpecific && keySpecific.Cmp(chainSpecific) < 0 {
 		return keySpecific
 	}
 
 	return c.EvmMaxGasPriceWei()
 }
 
+func (c *ChainScoped) FeatureEnabled(name string, seed uint64) bool {
+	return c.cfg.FeatureFlags.Enabled(name, seed)
+}
+
+// KeySpecificAddresses returns the addresses of the keys with KeySpecific config.
+func (c *ChainScoped) KeySpecificAddresses() (addrs []common.Address) {
+	for _, ks := range c.cfg.KeySpecific {
+		addrs = append(addrs, ks.Key.Address())
+	}
+	return
+}
+
+// Defaults returns a ChainScoped for the same chain which ignores any configured overrides.
+func (c *ChainScoped) Defaults() *ChainScoped {
+	return &ChainScoped{BasicConfig: c.BasicConfig, lggr: c.lggr, cfg: &EVMConfig{
+		ChainID: c.cfg.ChainID,
+		Enabled: c.cfg.Enabled,
+		Chain:   DefaultsFrom(c.cfg.ChainID, nil),
+	}}
+}
+
+// IsMaxGasPriceExempt returns true if addr is listed in GasEstimator.PriceMaxExemptAddresses.
+func (c *ChainScoped) IsMaxGasPriceExempt(addr common.Address) bool {
+	for _, a := range c.cfg.GasEstimator.PriceMaxExemptAddresses {
+		if a.Address() == addr {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *ChainScoped) LinkContractAddress() string {
 	if c.cfg.LinkContractAddress == nil {
 		return ""
@@ -329,3 +384,273 @@ func (c *ChainScoped) OCRDatabaseTimeout() time.Duration {
 func (c *ChainScoped) OCR2AutomationGasLimit() uint32 {
 	return *c.cfg.OCR2.Automation.GasLimit
 }
+
+func (c *ChainScoped) EvmConfigReadOnly() bool {
+	return *c.cfg.ReadOnly
+}
+
+func (c *ChainScoped) EvmBlockTime() time.Duration {
+	return c.cfg.BlockTime.Duration()
+}
+
+func (c *ChainScoped) EvmGasBumpInterval() time.Duration {
+	return time.Duration(c.EvmGasBumpThreshold()) * c.EvmBlockTime()
+}
+
+func (c *ChainScoped) EvmHealthCheckGracePeriod() time.Duration {
+	return c.cfg.HealthCheckGracePeriod.Duration()
+}
+
+func (c *ChainScoped) EvmGasCapacityBuffer() float64 {
+	f, _ := c.cfg.GasEstimator.LimitCapacityBuffer.BigFloat().Float64()
+	return f
+}
+
+func (c *ChainScoped) EvmLogTTL() time.Duration {
+	return c.cfg.LogTTL.Duration()
+}
+
+func (c *ChainScoped) EvmLogPruneInterval() time.Duration {
+	return c.cfg.LogPruneInterval.Duration()
+}
+
+func (c *ChainScoped) EvmRPCMaxInFlight() uint32 {
+	return *c.cfg.RPCMaxInFlight
+}
+
+func (c *ChainScoped) EvmGasOracleAddress() common.Address {
+	if c.cfg.GasEstimator.OracleAddress == nil {
+		return common.Address{}
+	}
+	return c.cfg.GasEstimator.OracleAddress.Address()
+}
+
+func (c *ChainScoped) EvmOCRContractLookbackBlocks() uint64 {
+	return *c.cfg.OCR.ContractLookbackBlocks
+}
+
+func (c *ChainScoped) EvmHeadTrackerCallbackTimeout() time.Duration {
+	return c.cfg.HeadTracker.CallbackTimeout.Duration()
+}
+
+func (c *ChainScoped) EvmSimulateTransactions() bool {
+	return *c.cfg.Transactions.Simulate
+}
+
+func (c *ChainScoped) EvmNonceAutoFillGap() bool {
+	return *c.cfg.NonceAutoFillGap
+}
+
+func (c *ChainScoped) EvmWalletBalancePollInterval() time.Duration {
+	return c.cfg.BalanceMonitor.PollInterval.Duration()
+}
+
+func (c *ChainScoped) EvmAutoWalletFundEnabled() bool {
+	return *c.cfg.BalanceMonitor.AutoFund
+}
+
+func (c *ChainScoped) EvmAutoWalletFundThreshold() *assets.Eth {
+	return (*assets.Eth)(c.cfg.BalanceMonitor.AutoFundThreshold.ToInt())
+}
+
+func (c *ChainScoped) EvmAutoWalletFundAmount() *assets.Eth {
+	return (*assets.Eth)(c.cfg.BalanceMonitor.AutoFundAmount.ToInt())
+}
+
+func (c *ChainScoped) EvmAutoWalletFundTreasuryAddress() common.Address {
+	if c.cfg.BalanceMonitor.AutoFundTreasuryAddress == nil {
+		return common.Address{}
+	}
+	return c.cfg.BalanceMonitor.AutoFundTreasuryAddress.Address()
+}
+
+func (c *ChainScoped) EvmLogFetcherUseBlockHash() bool {
+	return *c.cfg.LogBackfillUseBlockHash
+}
+
+func (c *ChainScoped) EvmGasEstimatorFallbackMode() string {
+	return *c.cfg.GasEstimator.FallbackMode
+}
+
+func (c *ChainScoped) EvmGasPriceFeedEnabled() bool {
+	return *c.cfg.GasEstimator.PriceFeedEnabled
+}
+
+func (c *ChainScoped) EvmGasPriceFeedAddress() common.Address {
+	if c.cfg.GasEstimator.PriceFeedAddress == nil {
+		return common.Address{}
+	}
+	return c.cfg.GasEstimator.PriceFeedAddress.Address()
+}
+
+func (c *ChainScoped) EvmGasNoBumpThreshold() uint64 {
+	return uint64(*c.cfg.GasEstimator.NoBumpThreshold)
+}
+
+func (c *ChainScoped) EvmMinimumFeeMarket() bool {
+	return *c.cfg.GasEstimator.MinimumFeeMarket
+}
+
+func (c *ChainScoped) EvmDatabaseQueryTimeout() time.Duration {
+	return c.cfg.DatabaseQueryTimeout.Duration()
+}
+
+func (c *ChainScoped) EvmChainLocked() bool {
+	return *c.cfg.Locked
+}
+
+func (c *ChainScoped) EvmHeadTrackerBlockDelay() uint16 {
+	return *c.cfg.HeadTracker.BlockDelay
+}
+
+func (c *ChainScoped) EvmMaxGasPriceWarningThresholdPercent() uint8 {
+	return *c.cfg.GasEstimator.PriceMaxWarningThresholdPercent
+}
+
+func (c *ChainScoped) EvmGasLimitIncrementOnFailure() uint32 {
+	return *c.cfg.GasEstimator.LimitIncrementOnFailure
+}
+
+func (c *ChainScoped) EvmGasEstimatorTransactionPriceHistory() uint16 {
+	return *c.cfg.GasEstimator.BlockHistory.TransactionPriceHistory
+}
+
+func (c *ChainScoped) EvmContractCallTimeout() time.Duration {
+	return c.cfg.ContractCallTimeout.Duration()
+}
+
+func (c *ChainScoped) EvmTxExpiry() time.Duration {
+	return c.cfg.Transactions.Expiry.Duration()
+}
+
+func (c *ChainScoped) EvmReceiptPollingInterval() time.Duration {
+	return c.cfg.Transactions.ReceiptPollingInterval.Duration()
+}
+
+func (c *ChainScoped) EvmMaxRevertedTransactionsPerBlock() uint16 {
+	return *c.cfg.Transactions.MaxRevertedPerBlock
+}
+
+func (c *ChainScoped) EvmAutoCreateSendingKey() bool {
+	return *c.cfg.AutoCreateSendingKey
+}
+
+func (c *ChainScoped) EvmGasPriceCacheTTL() time.Duration {
+	return c.cfg.GasEstimator.PriceCacheTTL.Duration()
+}
+
+func (c *ChainScoped) EvmMaxReorgDepth() uint32 {
+	if d := *c.cfg.HeadTracker.MaxReorgDepth; d > 0 {
+		return d
+	}
+	return *c.cfg.FinalityDepth
+}
+
+func (c *ChainScoped) EvmNodeSyncThreshold() uint32 {
+	return *c.cfg.NodePool.SyncThreshold
+}
+
+func (c *ChainScoped) EvmMaxBlockAge() time.Duration {
+	return c.cfg.HeadTracker.MaxBlockAge.Duration()
+}
+
+func (c *ChainScoped) EvmGasEstimatorCheckRetries() uint8 {
+	return *c.cfg.GasEstimator.CheckRetries
+}
+
+func (c *ChainScoped) EvmTrackerBlockHistoryFlushInterval() time.Duration {
+	return c.cfg.HeadTracker.FlushInterval.Duration()
+}
+
+func (c *ChainScoped) EvmGasEstimatorBlockHistoryEWMAAlpha() float64 {
+	f, _ := c.cfg.GasEstimator.BlockHistory.EWMAAlpha.BigFloat().Float64()
+	return f
+}
+
+func (c *ChainScoped) EvmGasPriceFloor() *assets.Wei {
+	return c.cfg.GasEstimator.PriceFloor
+}
+
+func (c *ChainScoped) EvmCallDataSizeLimit() uint32 {
+	return *c.cfg.Transactions.CallDataSizeLimit
+}
+
+func (c *ChainScoped) EvmOCRKeyBundleID() string {
+	if c.cfg.OCR.KeyBundleID == nil {
+		return ""
+	}
+	return c.cfg.OCR.KeyBundleID.String()
+}
+
+func (c *ChainScoped) EvmMaxOutstandingBalance() *assets.Eth {
+	if c.cfg.BalanceMonitor.MaxOutstandingBalance == nil {
+		return nil
+	}
+	return (*assets.Eth)(c.cfg.BalanceMonitor.MaxOutstandingBalance.ToInt())
+}
+
+func (c *ChainScoped) EvmGasFeeCapRounding() *assets.Wei {
+	return c.cfg.GasEstimator.FeeCapRounding
+}
+
+func (c *ChainScoped) EvmGasOraclePollInterval() time.Duration {
+	return c.cfg.GasEstimator.OraclePollInterval.Duration()
+}
+
+func (c *ChainScoped) EvmChainReadTimeoutFactor() float64 {
+	if c.cfg.RPCReadTimeoutFactor == nil {
+		return 0
+	}
+	f, _ := c.cfg.RPCReadTimeoutFactor.BigFloat().Float64()
+	return f
+}
+
+func (c *ChainScoped) EvmTxPriorityQueue() bool {
+	return *c.cfg.Transactions.PriorityQueue
+}
+
+func (c *ChainScoped) EvmGasEstimatorMinSampleCount() uint16 {
+	return *c.cfg.GasEstimator.BlockHistory.MinSampleCount
+}
+
+func (c *ChainScoped) EvmBlobGasPriceDefault() *assets.Wei {
+	return c.cfg.GasEstimator.BlobPriceDefault
+}
+
+func (c *ChainScoped) EvmMaxBlobGasPrice() *assets.Wei {
+	return c.cfg.GasEstimator.BlobPriceMax
+}
+
+func (c *ChainScoped) EvmChainAlias() string {
+	if c.cfg.Alias != nil {
+		return *c.cfg.Alias
+	}
+	return chainName(c.cfg.ChainID)
+}
+
+func (c *ChainScoped) EvmAllowUnprotectedTxs() bool {
+	return *c.cfg.Transactions.AllowUnprotected
+}
+
+func (c *ChainScoped) EvmContractCreationGasLimit() uint32 {
+	return *c.cfg.GasEstimator.LimitContractCreation
+}
+
+func (c *ChainScoped) EvmL2SequencerHTTPURL() string {
+	if c.cfg.Transactions.L2SequencerHTTPURL == nil {
+		return ""
+	}
+	return c.cfg.Transactions.L2SequencerHTTPURL.String()
+}
+
+func (c *ChainScoped) EvmOCRMinObservationTimeout() time.Duration {
+	return c.cfg.OCR.MinObservationTimeout.Duration()
+}
+
+func (c *ChainScoped) EvmGasHistoryExcludeZeroPriceTxs() bool {
+	return *c.cfg.GasEstimator.BlockHistory.ExcludeZeroPriceTxs
+}
+
+func (c *ChainScoped) EvmFeeHistoryBlocks() uint16 {
+	return *c.cfg.GasEstimator.FeeHistoryBlocks
+}
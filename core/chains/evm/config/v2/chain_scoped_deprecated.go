@@ -3,6 +3,7 @@ package v2
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	config "github.com/smartcontractkit/chainlink/core/config/v2"
@@ -12,6 +13,18 @@ func (c *ChainScoped) SetEvmGasPriceDefault(_ *big.Int) error {
 	panic(fmt.Errorf("cannot reconfigure gas price: %v", config.ErrUnsupported))
 }
 
+// EvmGasPriceAutoAdjust is always false, since auto adjustment relies on
+// SetEvmGasPriceDefault.
+func (c *ChainScoped) EvmGasPriceAutoAdjust() bool {
+	return false
+}
+
+// EvmGasPriceAutoAdjustInterval is unused, since EvmGasPriceAutoAdjust is
+// always false, but matches the legacy default.
+func (c *ChainScoped) EvmGasPriceAutoAdjustInterval() time.Duration {
+	return 5 * time.Minute
+}
+
 func (c *ChainScoped) Configure(_ evmtypes.ChainCfg) {
 	panic(fmt.Errorf("cannot reconfigure chain: %v", config.ErrUnsupported))
 }
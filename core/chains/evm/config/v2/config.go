@@ -11,6 +11,7 @@ import (
 	"github.com/shopspring/decimal"
 	"go.uber.org/multierr"
 	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/maps"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -229,32 +230,50 @@ func (c *EVMConfig) ValidateConfig() (err error) {
 type Chain struct {
 	BlockBackfillDepth       *uint32
 	BlockBackfillSkip        *bool
+	BlockTime                *models.Duration
 	ChainType                *string
+	Alias                    *string
+	DatabaseQueryTimeout     *models.Duration
+	ContractCallTimeout      *models.Duration
 	FinalityDepth            *uint32
 	FlagsContractAddress     *ethkey.EIP55Address
+	HealthCheckGracePeriod   *models.Duration
 	LinkContractAddress      *ethkey.EIP55Address
 	LogBackfillBatchSize     *uint32
 	LogPollInterval          *models.Duration
 	LogKeepBlocksDepth       *uint32
+	LogTTL                   *models.Duration
+	LogPruneInterval         *models.Duration
+	LogBackfillUseBlockHash  *bool
 	MinIncomingConfirmations *uint32
 	MinContractPayment       *assets.Link
 	NonceAutoSync            *bool
+	NonceAutoFillGap         *bool
 	NoNewHeadsThreshold      *models.Duration
 	OperatorFactoryAddress   *ethkey.EIP55Address
 	RPCDefaultBatchSize      *uint32
+	RPCMaxInFlight           *uint32
+	RPCReadTimeoutFactor     *decimal.Decimal
 	RPCBlockQueryDelay       *uint16
-
-	Transactions   Transactions      `toml:",omitempty"`
-	BalanceMonitor BalanceMonitor    `toml:",omitempty"`
-	GasEstimator   GasEstimator      `toml:",omitempty"`
-	HeadTracker    HeadTracker       `toml:",omitempty"`
-	KeySpecific    KeySpecificConfig `toml:",omitempty"`
-	NodePool       NodePool          `toml:",omitempty"`
-	OCR            OCR               `toml:",omitempty"`
-	OCR2           OCR2              `toml:",omitempty"`
+	ReadOnly                 *bool
+	Locked                   *bool
+	AutoCreateSendingKey     *bool
+
+	Transactions   Transactions       `toml:",omitempty"`
+	BalanceMonitor BalanceMonitor     `toml:",omitempty"`
+	GasEstimator   GasEstimator       `toml:",omitempty"`
+	HeadTracker    HeadTracker        `toml:",omitempty"`
+	KeySpecific    KeySpecificConfig  `toml:",omitempty"`
+	FeatureFlags   types.FeatureFlags `toml:",omitempty"`
+	NodePool       NodePool           `toml:",omitempty"`
+	OCR            OCR                `toml:",omitempty"`
+	OCR2           OCR2               `toml:",omitempty"`
 }
 
 func (c *Chain) ValidateConfig() (err error) {
+	if c.Alias != nil && *c.Alias == "" {
+		err = multierr.Append(err, v2.ErrEmpty{Name: "Alias", Msg: "must not be empty if set"})
+	}
 	var chainType config.ChainType
 	if c.ChainType != nil {
 		chainType = config.ChainType(*c.ChainType)
@@ -285,10 +304,45 @@ func (c *Chain) ValidateConfig() (err error) {
 		err = multierr.Append(err, v2.ErrInvalid{Name: "GasEstimator.BumpTxDepth", Value: *c.GasEstimator.BumpTxDepth,
 			Msg: "must be less than or equal to Transactions.MaxInFlight"})
 	}
+	if *c.Transactions.AllowUnprotected && *c.GasEstimator.EIP1559DynamicFees {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "Transactions.AllowUnprotected", Value: *c.Transactions.AllowUnprotected,
+			Msg: "cannot be used with GasEstimator.EIP1559DynamicFees"})
+	}
+	if u := c.Transactions.L2SequencerHTTPURL; u != nil {
+		switch u.Scheme {
+		case "http", "https":
+		default:
+			err = multierr.Append(err, v2.ErrInvalid{Name: "Transactions.L2SequencerHTTPURL", Value: u.Scheme, Msg: "must be http or https"})
+		}
+	}
+	if *c.GasEstimator.PriceMaxWarningThresholdPercent > 100 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "GasEstimator.PriceMaxWarningThresholdPercent", Value: *c.GasEstimator.PriceMaxWarningThresholdPercent,
+			Msg: "must be less than or equal to 100"})
+	}
+	if f := c.RPCReadTimeoutFactor; f != nil && !f.IsPositive() {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "RPCReadTimeoutFactor", Value: f,
+			Msg: "must be greater than zero"})
+	}
+	if c.LogTTL.Duration() > 0 && c.LogPruneInterval.Duration() <= 0 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "LogPruneInterval", Value: *c.LogPruneInterval,
+			Msg: "must be greater than zero if LogTTL is set"})
+	}
 	if *c.HeadTracker.HistoryDepth < *c.FinalityDepth {
 		err = multierr.Append(err, v2.ErrInvalid{Name: "HeadTracker.HistoryDepth", Value: *c.HeadTracker.HistoryDepth,
 			Msg: "must be equal to or reater than FinalityDepth"})
 	}
+	if uint32(*c.HeadTracker.BlockDelay) >= *c.HeadTracker.HistoryDepth {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "HeadTracker.BlockDelay", Value: *c.HeadTracker.BlockDelay,
+			Msg: "must be less than HistoryDepth"})
+	}
+	if c.HeadTracker.CallbackTimeout.Duration() <= 0 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "HeadTracker.CallbackTimeout", Value: *c.HeadTracker.CallbackTimeout,
+			Msg: "must be greater than zero"})
+	}
+	if c.ContractCallTimeout.Duration() <= 0 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "ContractCallTimeout", Value: *c.ContractCallTimeout,
+			Msg: "must be greater than zero"})
+	}
 	if *c.FinalityDepth < 1 {
 		err = multierr.Append(err, v2.ErrInvalid{Name: "FinalityDepth", Value: *c.FinalityDepth,
 			Msg: "must be greater than or equal to 1"})
@@ -297,6 +351,18 @@ func (c *Chain) ValidateConfig() (err error) {
 		err = multierr.Append(err, v2.ErrInvalid{Name: "MinIncomingConfirmations", Value: *c.MinIncomingConfirmations,
 			Msg: "must be greater than or equal to 1"})
 	}
+	if *c.BalanceMonitor.AutoFund {
+		if c.BalanceMonitor.AutoFundTreasuryAddress == nil {
+			err = multierr.Append(err, v2.ErrMissing{Name: "BalanceMonitor.AutoFundTreasuryAddress", Msg: "required when AutoFund is enabled"})
+		}
+		if c.BalanceMonitor.PollInterval.Duration() <= 0 {
+			err = multierr.Append(err, v2.ErrInvalid{Name: "BalanceMonitor.PollInterval", Value: *c.BalanceMonitor.PollInterval,
+				Msg: "must be greater than zero when AutoFund is enabled"})
+		}
+	}
+	if ferr := c.FeatureFlags.Validate(); ferr != nil {
+		err = multierr.Append(err, ferr)
+	}
 	return
 }
 
@@ -306,6 +372,7 @@ func (c *Chain) asV1() *types.ChainCfg {
 		BlockHistoryEstimatorBlockHistorySize:          nullIntFromPtr(c.GasEstimator.BlockHistory.BlockHistorySize),
 		BlockHistoryEstimatorEIP1559FeeCapBufferBlocks: nullIntFromPtr(c.GasEstimator.BlockHistory.EIP1559FeeCapBufferBlocks),
 		ChainType:                      null.StringFromPtr(c.ChainType),
+		ChainAlias:                     null.StringFromPtr(c.Alias),
 		EthTxReaperThreshold:           c.Transactions.ReaperThreshold,
 		EthTxResendAfterThreshold:      c.Transactions.ResendAfterThreshold,
 		EvmEIP1559DynamicFees:          null.BoolFromPtr(c.GasEstimator.EIP1559DynamicFees),
@@ -334,6 +401,8 @@ func (c *Chain) asV1() *types.ChainCfg {
 		EvmMaxGasPriceWei:              c.GasEstimator.PriceMax,
 		EvmNonceAutoSync:               null.BoolFromPtr(c.NonceAutoSync),
 		EvmUseForwarders:               null.BoolFromPtr(c.Transactions.ForwardersEnabled),
+		EvmL2SequencerHTTPURL:          nullURL(c.Transactions.L2SequencerHTTPURL),
+		EvmChainLocked:                 null.BoolFromPtr(c.Locked),
 		EvmRPCDefaultBatchSize:         nullInt(c.RPCDefaultBatchSize),
 		FlagsContractAddress:           nullString(c.FlagsContractAddress),
 		GasEstimatorMode:               null.StringFromPtr(c.GasEstimator.Mode),
@@ -341,8 +410,12 @@ func (c *Chain) asV1() *types.ChainCfg {
 		OperatorFactoryAddress:         nullString(c.OperatorFactoryAddress),
 		MinIncomingConfirmations:       nullInt(c.MinIncomingConfirmations),
 		MinimumContractPayment:         c.MinContractPayment,
+		OCRKeyBundleID:                 nullString(c.OCR.KeyBundleID),
 		NodeNoNewHeadsThreshold:        c.NoNewHeadsThreshold,
 	}
+	if len(c.FeatureFlags) > 0 {
+		cfg.EvmFeatureFlags = maps.Clone(c.FeatureFlags)
+	}
 	for _, ks := range c.KeySpecific {
 		if cfg.KeySpecific == nil {
 			cfg.KeySpecific = map[string]types.ChainCfg{}
@@ -375,13 +448,28 @@ func nullString[S fmt.Stringer](s *S) null.String {
 	return null.StringFrom((*s).String())
 }
 
+func nullURL(u *models.URL) null.String {
+	if u == nil {
+		return null.String{}
+	}
+	return null.StringFrom(u.String())
+}
+
 type Transactions struct {
-	ForwardersEnabled    *bool
-	MaxInFlight          *uint32
-	MaxQueued            *uint32
-	ReaperInterval       *models.Duration
-	ReaperThreshold      *models.Duration
-	ResendAfterThreshold *models.Duration
+	ForwardersEnabled      *bool
+	MaxInFlight            *uint32
+	MaxQueued              *uint32
+	ReaperInterval         *models.Duration
+	ReaperThreshold        *models.Duration
+	ResendAfterThreshold   *models.Duration
+	Expiry                 *models.Duration
+	ReceiptPollingInterval *models.Duration
+	MaxRevertedPerBlock    *uint16
+	CallDataSizeLimit      *uint32
+	Simulate               *bool
+	PriorityQueue          *bool
+	AllowUnprotected       *bool
+	L2SequencerHTTPURL     *models.URL
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -403,6 +491,30 @@ func (t *Transactions) setFrom(f *Transactions) {
 	if v := f.ResendAfterThreshold; v != nil {
 		t.ResendAfterThreshold = v
 	}
+	if v := f.Expiry; v != nil {
+		t.Expiry = v
+	}
+	if v := f.ReceiptPollingInterval; v != nil {
+		t.ReceiptPollingInterval = v
+	}
+	if v := f.MaxRevertedPerBlock; v != nil {
+		t.MaxRevertedPerBlock = v
+	}
+	if v := f.CallDataSizeLimit; v != nil {
+		t.CallDataSizeLimit = v
+	}
+	if v := f.Simulate; v != nil {
+		t.Simulate = v
+	}
+	if v := f.PriorityQueue; v != nil {
+		t.PriorityQueue = v
+	}
+	if v := f.AllowUnprotected; v != nil {
+		t.AllowUnprotected = v
+	}
+	if v := f.L2SequencerHTTPURL; v != nil {
+		t.L2SequencerHTTPURL = v
+	}
 }
 
 type OCR2 struct {
@@ -424,38 +536,81 @@ func (a *Automation) setFrom(f *Automation) {
 }
 
 type BalanceMonitor struct {
-	Enabled *bool
+	Enabled                 *bool
+	PollInterval            *models.Duration
+	AutoFund                *bool
+	AutoFundThreshold       *assets.Wei
+	AutoFundAmount          *assets.Wei
+	AutoFundTreasuryAddress *ethkey.EIP55Address
+	MaxOutstandingBalance   *assets.Wei
 }
 
 func (m *BalanceMonitor) setFrom(f *BalanceMonitor) {
 	if v := f.Enabled; v != nil {
 		m.Enabled = v
 	}
+	if v := f.PollInterval; v != nil {
+		m.PollInterval = v
+	}
+	if v := f.AutoFund; v != nil {
+		m.AutoFund = v
+	}
+	if v := f.AutoFundThreshold; v != nil {
+		m.AutoFundThreshold = v
+	}
+	if v := f.AutoFundAmount; v != nil {
+		m.AutoFundAmount = v
+	}
+	if v := f.AutoFundTreasuryAddress; v != nil {
+		m.AutoFundTreasuryAddress = v
+	}
+	if v := f.MaxOutstandingBalance; v != nil {
+		m.MaxOutstandingBalance = v
+	}
 }
 
 type GasEstimator struct {
-	Mode *string
-
-	PriceDefault *assets.Wei
-	PriceMax     *assets.Wei
-	PriceMin     *assets.Wei
-
-	LimitDefault    *uint32
-	LimitMax        *uint32
-	LimitMultiplier *decimal.Decimal
-	LimitTransfer   *uint32
-	LimitJobType    GasLimitJobType `toml:",omitempty"`
-
-	BumpMin       *assets.Wei
-	BumpPercent   *uint16
-	BumpThreshold *uint32
-	BumpTxDepth   *uint16
+	Mode               *string
+	FallbackMode       *string
+	CheckRetries       *uint8
+	OraclePollInterval *models.Duration
+	OracleAddress      *ethkey.EIP55Address
+	PriceFeedEnabled   *bool
+	PriceFeedAddress   *ethkey.EIP55Address
+
+	PriceDefault                    *assets.Wei
+	PriceMax                        *assets.Wei
+	PriceMaxExemptAddresses         []ethkey.EIP55Address `toml:",omitempty"`
+	PriceMaxWarningThresholdPercent *uint8
+	PriceMin                        *assets.Wei
+	PriceFloor                      *assets.Wei
+	PriceCacheTTL                   *models.Duration
+	BlobPriceDefault                *assets.Wei
+	BlobPriceMax                    *assets.Wei
+	FeeHistoryBlocks                *uint16
+
+	LimitDefault            *uint32
+	LimitMax                *uint32
+	LimitIncrementOnFailure *uint32
+	LimitMultiplier         *decimal.Decimal
+	LimitCapacityBuffer     *decimal.Decimal
+	LimitTransfer           *uint32
+	LimitContractCreation   *uint32
+	LimitJobType            GasLimitJobType `toml:",omitempty"`
+
+	BumpMin         *assets.Wei
+	BumpPercent     *uint16
+	BumpThreshold   *uint32
+	NoBumpThreshold *uint32
+	BumpTxDepth     *uint16
 
 	EIP1559DynamicFees *bool
+	MinimumFeeMarket   *bool
 
-	FeeCapDefault *assets.Wei
-	TipCapDefault *assets.Wei
-	TipCapMin     *assets.Wei
+	FeeCapDefault  *assets.Wei
+	FeeCapRounding *assets.Wei
+	TipCapDefault  *assets.Wei
+	TipCapMin      *assets.Wei
 
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
 }
@@ -482,6 +637,10 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 			Msg: fmt.Sprintf("must be less than or equal to PriceMax (%s)", e.PriceMax)})
 	}
 
+	if e.LimitCapacityBuffer.LessThan(decimal.NewFromInt(1)) {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "LimitCapacityBuffer", Value: e.LimitCapacityBuffer,
+			Msg: "must be greater than or equal to 1"})
+	}
 	if e.PriceMin.Cmp(e.PriceDefault) > 0 {
 		err = multierr.Append(err, v2.ErrInvalid{Name: "PriceMin", Value: e.PriceMin,
 			Msg: "must be less than or equal to PriceDefault"})
@@ -490,9 +649,39 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, v2.ErrInvalid{Name: "PriceMax", Value: e.PriceMin,
 			Msg: "must be greater than or equal to PriceDefault"})
 	}
-	if *e.Mode == "BlockHistory" && *e.BlockHistory.BlockHistorySize <= 0 {
+	if e.FeeCapRounding != nil && e.FeeCapRounding.Cmp(assets.NewWeiI(0)) <= 0 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "FeeCapRounding", Value: e.FeeCapRounding,
+			Msg: "must be greater than zero"})
+	}
+	if e.BlobPriceMax.Cmp(e.BlobPriceDefault) < 0 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "BlobPriceMax", Value: e.BlobPriceMax,
+			Msg: "must be greater than or equal to BlobPriceDefault"})
+	}
+	if *e.FeeHistoryBlocks < 1 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "FeeHistoryBlocks", Value: *e.FeeHistoryBlocks,
+			Msg: "must be greater than or equal to 1"})
+	}
+	if e.PriceFloor != nil && e.PriceFloor.Cmp(e.PriceMax) > 0 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "PriceFloor", Value: e.PriceFloor,
+			Msg: "must be less than or equal to PriceMax"})
+	}
+	if (*e.Mode == "OnChainOracle" || *e.FallbackMode == "OnChainOracle") && (e.OracleAddress == nil || e.OracleAddress.Address() == (common.Address{})) {
+		err = multierr.Append(err, v2.ErrMissing{Name: "OracleAddress", Msg: "required with OnChainOracle Mode or FallbackMode"})
+	}
+	if *e.MinimumFeeMarket && !*e.EIP1559DynamicFees {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "MinimumFeeMarket", Value: *e.MinimumFeeMarket,
+			Msg: "requires EIP1559DynamicFees"})
+	}
+	if *e.PriceFeedEnabled && (e.PriceFeedAddress == nil || e.PriceFeedAddress.Address() == (common.Address{})) {
+		err = multierr.Append(err, v2.ErrMissing{Name: "PriceFeedAddress", Msg: "required with PriceFeedEnabled"})
+	}
+	if (*e.Mode == "BlockHistory" || *e.Mode == "ARIMA") && *e.BlockHistory.BlockHistorySize <= 0 {
 		err = multierr.Append(err, v2.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
-			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
+			Msg: fmt.Sprintf("must be greater than or equal to 1 with %s Mode", *e.Mode)})
+	}
+	if a := e.BlockHistory.EWMAAlpha; a != nil && (a.IsNegative() || a.GreaterThan(decimal.NewFromInt(1))) {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "BlockHistory.EWMAAlpha", Value: a,
+			Msg: "must be between 0 and 1"})
 	}
 
 	return
@@ -502,15 +691,39 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	if v := f.Mode; v != nil {
 		e.Mode = v
 	}
+	if v := f.FallbackMode; v != nil {
+		e.FallbackMode = v
+	}
+	if v := f.CheckRetries; v != nil {
+		e.CheckRetries = v
+	}
+	if v := f.OraclePollInterval; v != nil {
+		e.OraclePollInterval = v
+	}
+	if v := f.OracleAddress; v != nil {
+		e.OracleAddress = v
+	}
+	if v := f.PriceFeedEnabled; v != nil {
+		e.PriceFeedEnabled = v
+	}
+	if v := f.PriceFeedAddress; v != nil {
+		e.PriceFeedAddress = v
+	}
 	if v := f.EIP1559DynamicFees; v != nil {
 		e.EIP1559DynamicFees = v
 	}
+	if v := f.MinimumFeeMarket; v != nil {
+		e.MinimumFeeMarket = v
+	}
 	if v := f.BumpPercent; v != nil {
 		e.BumpPercent = v
 	}
 	if v := f.BumpThreshold; v != nil {
 		e.BumpThreshold = v
 	}
+	if v := f.NoBumpThreshold; v != nil {
+		e.NoBumpThreshold = v
+	}
 	if v := f.BumpTxDepth; v != nil {
 		e.BumpTxDepth = v
 	}
@@ -520,18 +733,30 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	if v := f.FeeCapDefault; v != nil {
 		e.FeeCapDefault = v
 	}
+	if v := f.FeeCapRounding; v != nil {
+		e.FeeCapRounding = v
+	}
 	if v := f.LimitDefault; v != nil {
 		e.LimitDefault = v
 	}
 	if v := f.LimitMax; v != nil {
 		e.LimitMax = v
 	}
+	if v := f.LimitIncrementOnFailure; v != nil {
+		e.LimitIncrementOnFailure = v
+	}
 	if v := f.LimitMultiplier; v != nil {
 		e.LimitMultiplier = v
 	}
+	if v := f.LimitCapacityBuffer; v != nil {
+		e.LimitCapacityBuffer = v
+	}
 	if v := f.LimitTransfer; v != nil {
 		e.LimitTransfer = v
 	}
+	if v := f.LimitContractCreation; v != nil {
+		e.LimitContractCreation = v
+	}
 	if v := f.PriceDefault; v != nil {
 		e.PriceDefault = v
 	}
@@ -544,9 +769,30 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	if v := f.PriceMax; v != nil {
 		e.PriceMax = v
 	}
+	if v := f.PriceMaxExemptAddresses; v != nil {
+		e.PriceMaxExemptAddresses = v
+	}
+	if v := f.PriceMaxWarningThresholdPercent; v != nil {
+		e.PriceMaxWarningThresholdPercent = v
+	}
 	if v := f.PriceMin; v != nil {
 		e.PriceMin = v
 	}
+	if v := f.PriceFloor; v != nil {
+		e.PriceFloor = v
+	}
+	if v := f.PriceCacheTTL; v != nil {
+		e.PriceCacheTTL = v
+	}
+	if v := f.BlobPriceDefault; v != nil {
+		e.BlobPriceDefault = v
+	}
+	if v := f.BlobPriceMax; v != nil {
+		e.BlobPriceMax = v
+	}
+	if v := f.FeeHistoryBlocks; v != nil {
+		e.FeeHistoryBlocks = v
+	}
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
 }
@@ -584,6 +830,10 @@ type BlockHistoryEstimator struct {
 	CheckInclusionPercentile  *uint16
 	EIP1559FeeCapBufferBlocks *uint16
 	TransactionPercentile     *uint16
+	TransactionPriceHistory   *uint16
+	EWMAAlpha                 *decimal.Decimal
+	MinSampleCount            *uint16
+	ExcludeZeroPriceTxs       *bool
 }
 
 func (e *BlockHistoryEstimator) setFrom(f *BlockHistoryEstimator) {
@@ -605,6 +855,18 @@ func (e *BlockHistoryEstimator) setFrom(f *BlockHistoryEstimator) {
 	if v := f.TransactionPercentile; v != nil {
 		e.TransactionPercentile = v
 	}
+	if v := f.TransactionPriceHistory; v != nil {
+		e.TransactionPriceHistory = v
+	}
+	if v := f.EWMAAlpha; v != nil {
+		e.EWMAAlpha = v
+	}
+	if v := f.MinSampleCount; v != nil {
+		e.MinSampleCount = v
+	}
+	if v := f.ExcludeZeroPriceTxs; v != nil {
+		e.ExcludeZeroPriceTxs = v
+	}
 }
 
 type KeySpecificConfig []KeySpecific
@@ -641,6 +903,11 @@ type HeadTracker struct {
 	HistoryDepth     *uint32
 	MaxBufferSize    *uint32
 	SamplingInterval *models.Duration
+	CallbackTimeout  *models.Duration
+	BlockDelay       *uint16
+	MaxReorgDepth    *uint32
+	MaxBlockAge      *models.Duration
+	FlushInterval    *models.Duration
 }
 
 func (t *HeadTracker) setFrom(f *HeadTracker) {
@@ -653,12 +920,28 @@ func (t *HeadTracker) setFrom(f *HeadTracker) {
 	if v := f.SamplingInterval; v != nil {
 		t.SamplingInterval = v
 	}
+	if v := f.CallbackTimeout; v != nil {
+		t.CallbackTimeout = v
+	}
+	if v := f.BlockDelay; v != nil {
+		t.BlockDelay = v
+	}
+	if v := f.MaxReorgDepth; v != nil {
+		t.MaxReorgDepth = v
+	}
+	if v := f.MaxBlockAge; v != nil {
+		t.MaxBlockAge = v
+	}
+	if v := f.FlushInterval; v != nil {
+		t.FlushInterval = v
+	}
 }
 
 type NodePool struct {
 	PollFailureThreshold *uint32
 	PollInterval         *models.Duration
 	SelectionMode        *string
+	SyncThreshold        *uint32
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -671,19 +954,28 @@ func (p *NodePool) setFrom(f *NodePool) {
 	if v := f.SelectionMode; v != nil {
 		p.SelectionMode = v
 	}
+	if v := f.SyncThreshold; v != nil {
+		p.SyncThreshold = v
+	}
 }
 
 type OCR struct {
 	ContractConfirmations              *uint16
+	ContractLookbackBlocks             *uint64
 	ContractTransmitterTransmitTimeout *models.Duration
 	DatabaseTimeout                    *models.Duration
 	ObservationGracePeriod             *models.Duration
+	MinObservationTimeout              *models.Duration
+	KeyBundleID                        *models.Sha256Hash
 }
 
 func (o *OCR) setFrom(f *OCR) {
 	if v := f.ContractConfirmations; v != nil {
 		o.ContractConfirmations = v
 	}
+	if v := f.ContractLookbackBlocks; v != nil {
+		o.ContractLookbackBlocks = v
+	}
 	if v := f.ContractTransmitterTransmitTimeout; v != nil {
 		o.ContractTransmitterTransmitTimeout = v
 	}
@@ -693,6 +985,12 @@ func (o *OCR) setFrom(f *OCR) {
 	if v := f.ObservationGracePeriod; v != nil {
 		o.ObservationGracePeriod = v
 	}
+	if v := f.MinObservationTimeout; v != nil {
+		o.MinObservationTimeout = v
+	}
+	if v := f.KeyBundleID; v != nil {
+		o.KeyBundleID = v
+	}
 }
 
 func (c *Chain) SetFromDB(cfg *types.ChainCfg) error {
@@ -702,6 +1000,9 @@ func (c *Chain) SetFromDB(cfg *types.ChainCfg) error {
 	if cfg.ChainType.Valid {
 		c.ChainType = &cfg.ChainType.String
 	}
+	if cfg.ChainAlias.Valid {
+		c.Alias = &cfg.ChainAlias.String
+	}
 	if cfg.EthTxReaperThreshold != nil {
 		c.Transactions.ReaperThreshold = cfg.EthTxReaperThreshold
 	}
@@ -734,6 +1035,16 @@ func (c *Chain) SetFromDB(cfg *types.ChainCfg) error {
 	if cfg.EvmUseForwarders.Valid {
 		c.Transactions.ForwardersEnabled = &cfg.EvmUseForwarders.Bool
 	}
+	if cfg.EvmL2SequencerHTTPURL.Valid {
+		u, err := url.Parse(cfg.EvmL2SequencerHTTPURL.String)
+		if err != nil {
+			return errors.Wrapf(err, "invalid EvmL2SequencerHTTPURL: %s", cfg.EvmL2SequencerHTTPURL.String)
+		}
+		c.Transactions.L2SequencerHTTPURL = (*models.URL)(u)
+	}
+	if cfg.EvmChainLocked.Valid {
+		c.Locked = &cfg.EvmChainLocked.Bool
+	}
 	if cfg.EvmRPCDefaultBatchSize.Valid {
 		v := uint32(cfg.EvmRPCDefaultBatchSize.Int64)
 		c.RPCDefaultBatchSize = &v
@@ -757,6 +1068,9 @@ func (c *Chain) SetFromDB(cfg *types.ChainCfg) error {
 	if cfg.EvmMaxGasPriceWei != nil {
 		c.GasEstimator.PriceMax = cfg.EvmMaxGasPriceWei
 	}
+	for _, a := range cfg.EvmMaxGasPriceExemptAddresses {
+		c.GasEstimator.PriceMaxExemptAddresses = append(c.GasEstimator.PriceMaxExemptAddresses, ethkey.EIP55AddressFromAddress(a))
+	}
 	if cfg.EvmEIP1559DynamicFees.Valid {
 		c.GasEstimator.EIP1559DynamicFees = &cfg.EvmEIP1559DynamicFees.Bool
 	}
@@ -826,6 +1140,9 @@ func (c *Chain) SetFromDB(cfg *types.ChainCfg) error {
 			c.GasEstimator.BlockHistory.EIP1559FeeCapBufferBlocks = &v
 		}
 	}
+	if len(cfg.EvmFeatureFlags) > 0 {
+		c.FeatureFlags = maps.Clone(cfg.EvmFeatureFlags)
+	}
 	for s, kcfg := range cfg.KeySpecific {
 		if !common.IsHexAddress(s) {
 			return errors.Errorf("invalid address KeySpecific: %s", s)
@@ -862,6 +1179,13 @@ func (c *Chain) SetFromDB(cfg *types.ChainCfg) error {
 		c.MinIncomingConfirmations = &v
 	}
 	c.MinContractPayment = cfg.MinimumContractPayment
+	if cfg.OCRKeyBundleID.Valid {
+		v, err := models.Sha256HashFromHex(cfg.OCRKeyBundleID.String)
+		if err != nil {
+			return errors.Wrapf(err, "invalid OCRKeyBundleID: %s", cfg.OCRKeyBundleID.String)
+		}
+		c.OCR.KeyBundleID = &v
+	}
 	if cfg.NodeNoNewHeadsThreshold != nil {
 		c.NoNewHeadsThreshold = cfg.NodeNoNewHeadsThreshold
 	}
@@ -873,6 +1197,7 @@ type Node struct {
 	WSURL    *models.URL
 	HTTPURL  *models.URL
 	SendOnly *bool
+	Priority *uint8
 }
 
 func (n *Node) ValidateConfig() (err error) {
@@ -887,11 +1212,11 @@ func (n *Node) ValidateConfig() (err error) {
 		sendOnly = *n.SendOnly
 	}
 	if n.WSURL == nil {
-		if sendOnly {
+		if !sendOnly {
 			err = multierr.Append(err, v2.ErrMissing{Name: "WSURL", Msg: "required for primary nodes"})
 		}
 	} else if n.WSURL.IsZero() {
-		if sendOnly {
+		if !sendOnly {
 			err = multierr.Append(err, v2.ErrEmpty{Name: "WSURL", Msg: "required for primary nodes"})
 		}
 	} else {
@@ -3,12 +3,14 @@ package v2
 import (
 	"bytes"
 	"embed"
+	"fmt"
 	"log"
 	"path/filepath"
 	"strings"
 
 	"golang.org/x/exp/slices"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/config"
 	cfgv2 "github.com/smartcontractkit/chainlink/core/config/v2"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -90,6 +92,14 @@ func DefaultsFrom(chainID *utils.Big, with *Chain) Chain {
 	return c
 }
 
+// chainName returns the name of the defaults for chainID, or a generic one including the ID.
+func chainName(chainID *utils.Big) string {
+	if name, ok := defaultNames[chainID.String()]; ok {
+		return name
+	}
+	return fmt.Sprintf("EVM chain %s", chainID)
+}
+
 func ChainTypeForID(chainID *utils.Big) (config.ChainType, bool) {
 	s := chainID.String()
 	if d, ok := defaults[s]; ok {
@@ -109,15 +119,30 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.BlockBackfillSkip; v != nil {
 		c.BlockBackfillSkip = v
 	}
+	if v := f.BlockTime; v != nil {
+		c.BlockTime = v
+	}
 	if v := f.ChainType; v != nil {
 		c.ChainType = v
 	}
+	if v := f.Alias; v != nil {
+		c.Alias = v
+	}
+	if v := f.DatabaseQueryTimeout; v != nil {
+		c.DatabaseQueryTimeout = v
+	}
+	if v := f.ContractCallTimeout; v != nil {
+		c.ContractCallTimeout = v
+	}
 	if v := f.FinalityDepth; v != nil {
 		c.FinalityDepth = v
 	}
 	if v := f.FlagsContractAddress; v != nil {
 		c.FlagsContractAddress = v
 	}
+	if v := f.HealthCheckGracePeriod; v != nil {
+		c.HealthCheckGracePeriod = v
+	}
 	if v := f.LinkContractAddress; v != nil {
 		c.LinkContractAddress = v
 	}
@@ -130,6 +155,15 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.LogKeepBlocksDepth; v != nil {
 		c.LogKeepBlocksDepth = v
 	}
+	if v := f.LogTTL; v != nil {
+		c.LogTTL = v
+	}
+	if v := f.LogPruneInterval; v != nil {
+		c.LogPruneInterval = v
+	}
+	if v := f.LogBackfillUseBlockHash; v != nil {
+		c.LogBackfillUseBlockHash = v
+	}
 	if v := f.MinIncomingConfirmations; v != nil {
 		c.MinIncomingConfirmations = v
 	}
@@ -139,6 +173,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.NonceAutoSync; v != nil {
 		c.NonceAutoSync = v
 	}
+	if v := f.NonceAutoFillGap; v != nil {
+		c.NonceAutoFillGap = v
+	}
 	if v := f.NoNewHeadsThreshold; v != nil {
 		c.NoNewHeadsThreshold = v
 	}
@@ -148,9 +185,24 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.RPCDefaultBatchSize; v != nil {
 		c.RPCDefaultBatchSize = v
 	}
+	if v := f.RPCMaxInFlight; v != nil {
+		c.RPCMaxInFlight = v
+	}
+	if v := f.RPCReadTimeoutFactor; v != nil {
+		c.RPCReadTimeoutFactor = v
+	}
 	if v := f.RPCBlockQueryDelay; v != nil {
 		c.RPCBlockQueryDelay = v
 	}
+	if v := f.ReadOnly; v != nil {
+		c.ReadOnly = v
+	}
+	if v := f.Locked; v != nil {
+		c.Locked = v
+	}
+	if v := f.AutoCreateSendingKey; v != nil {
+		c.AutoCreateSendingKey = v
+	}
 
 	c.Transactions.setFrom(&f.Transactions)
 	c.BalanceMonitor.setFrom(&f.BalanceMonitor)
@@ -166,6 +218,13 @@ func (c *Chain) SetFrom(f *Chain) {
 		}
 	}
 
+	for name, p := range f.FeatureFlags {
+		if c.FeatureFlags == nil {
+			c.FeatureFlags = types.FeatureFlags{}
+		}
+		c.FeatureFlags[name] = p
+	}
+
 	c.HeadTracker.setFrom(&f.HeadTracker)
 	c.NodePool.setFrom(&f.NodePool)
 	c.OCR.setFrom(&f.OCR)
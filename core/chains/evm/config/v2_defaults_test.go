@@ -32,6 +32,7 @@ func Test_v2Config_SetDefaults(t *testing.T) {
 		got, name := v2.Defaults(utils.NewBigI(id))
 		t.Run(fmt.Sprintf("%d:%s", id, name), func(t *testing.T) {
 			assertChainsEqual(t, exp, got)
+			assert.Equal(t, config.ChainNames()[id], name)
 		})
 	}
 	t.Run("fallback-unchanged", func(t *testing.T) {
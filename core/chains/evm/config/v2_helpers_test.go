@@ -17,6 +17,10 @@ func ChainSpecificConfigDefaultsAsV2() map[int64]v2.Chain {
 	return m
 }
 
+func ChainNames() map[int64]string {
+	return chainNames
+}
+
 func FallbackDefaultsAsV2() v2.Chain {
 	return fallbackDefaultSet.asV2()
 }
@@ -28,47 +32,85 @@ func (set chainSpecificConfigDefaultSet) asV2() v2.Chain {
 		BlockBackfillSkip:  ptr(false),
 
 		ChainType:                ptr(string(set.chainType)),
+		DatabaseQueryTimeout:     models.MustNewDuration(set.databaseQueryTimeout),
+		ContractCallTimeout:      models.MustNewDuration(set.contractCallTimeout),
+		BlockTime:                models.MustNewDuration(set.blockTime),
 		FinalityDepth:            ptr(set.finalityDepth),
 		FlagsContractAddress:     asEIP155Address(set.flagsContractAddress),
+		HealthCheckGracePeriod:   models.MustNewDuration(set.healthCheckGracePeriod),
 		LinkContractAddress:      asEIP155Address(set.linkContractAddress),
 		LogBackfillBatchSize:     ptr(set.logBackfillBatchSize),
 		LogPollInterval:          models.MustNewDuration(set.logPollInterval),
 		LogKeepBlocksDepth:       ptr(set.logKeepBlocksDepth),
+		LogTTL:                   models.MustNewDuration(set.logTTL),
+		LogPruneInterval:         models.MustNewDuration(set.logPruneInterval),
+		LogBackfillUseBlockHash:  ptr(set.logFetcherUseBlockHash),
 		MinIncomingConfirmations: ptr(set.minIncomingConfirmations),
 		MinContractPayment:       set.minimumContractPayment,
 		NonceAutoSync:            ptr(set.nonceAutoSync),
+		NonceAutoFillGap:         ptr(set.nonceAutoFillGap),
 		NoNewHeadsThreshold:      models.MustNewDuration(set.nodeDeadAfterNoNewHeadersThreshold),
 		OperatorFactoryAddress:   asEIP155Address(set.operatorFactoryAddress),
 		RPCDefaultBatchSize:      ptr(set.rpcDefaultBatchSize),
+		RPCMaxInFlight:           ptr(set.rpcMaxInFlight),
+		RPCReadTimeoutFactor:     asReadTimeoutFactor(set.rpcReadTimeoutFactor),
 		RPCBlockQueryDelay:       ptr(set.blockHistoryEstimatorBlockDelay),
+		ReadOnly:                 ptr(set.readOnly),
+		Locked:                   ptr(set.chainLocked),
+		AutoCreateSendingKey:     ptr(set.autoCreateSendingKey),
 		Transactions: v2.Transactions{
-			ForwardersEnabled:    ptr(set.useForwarders),
-			MaxInFlight:          ptr(set.maxInFlightTransactions),
-			MaxQueued:            ptr(uint32(set.maxQueuedTransactions)),
-			ReaperInterval:       models.MustNewDuration(set.ethTxReaperInterval),
-			ReaperThreshold:      models.MustNewDuration(set.ethTxReaperThreshold),
-			ResendAfterThreshold: models.MustNewDuration(set.ethTxResendAfterThreshold),
+			ForwardersEnabled:      ptr(set.useForwarders),
+			MaxInFlight:            ptr(set.maxInFlightTransactions),
+			MaxQueued:              ptr(uint32(set.maxQueuedTransactions)),
+			ReaperInterval:         models.MustNewDuration(set.ethTxReaperInterval),
+			ReaperThreshold:        models.MustNewDuration(set.ethTxReaperThreshold),
+			ResendAfterThreshold:   models.MustNewDuration(set.ethTxResendAfterThreshold),
+			Expiry:                 models.MustNewDuration(set.ethTxExpiry),
+			ReceiptPollingInterval: models.MustNewDuration(set.receiptPollingInterval),
+			MaxRevertedPerBlock:    ptr(set.maxRevertedTransactionsPerBlock),
+			CallDataSizeLimit:      ptr(set.callDataSizeLimit),
+			Simulate:               ptr(set.simulateTransactions),
+			PriorityQueue:          ptr(set.txPriorityQueue),
+			AllowUnprotected:       ptr(set.allowUnprotectedTxs),
 		},
 		BalanceMonitor: v2.BalanceMonitor{
-			Enabled: ptr(set.balanceMonitorEnabled),
+			Enabled:           ptr(set.balanceMonitorEnabled),
+			PollInterval:      models.MustNewDuration(set.walletBalancePollInterval),
+			AutoFund:          ptr(set.autoWalletFundEnabled),
+			AutoFundThreshold: &set.autoWalletFundThreshold,
+			AutoFundAmount:    &set.autoWalletFundAmount,
 		},
 		GasEstimator: v2.GasEstimator{
-			Mode:               ptr(set.gasEstimatorMode),
-			EIP1559DynamicFees: ptr(set.eip1559DynamicFees),
-			BumpMin:            &set.gasBumpWei,
-			BumpPercent:        ptr(set.gasBumpPercent),
-			BumpThreshold:      ptr(uint32(set.gasBumpThreshold)),
-			BumpTxDepth:        ptr(set.gasBumpTxDepth),
-			FeeCapDefault:      &set.gasFeeCapDefault,
-			LimitDefault:       ptr(uint32(set.gasLimitDefault)),
-			LimitMax:           ptr(uint32(set.gasLimitMax)),
-			LimitMultiplier:    ptr(decimal.NewFromFloat32(set.gasLimitMultiplier)),
-			LimitTransfer:      ptr(uint32(set.gasLimitTransfer)),
-			TipCapDefault:      &set.gasTipCapDefault,
-			TipCapMin:          &set.gasTipCapMinimum,
-			PriceDefault:       &set.gasPriceDefault,
-			PriceMax:           &set.maxGasPriceWei,
-			PriceMin:           &set.minGasPriceWei,
+			Mode:                            ptr(set.gasEstimatorMode),
+			FallbackMode:                    ptr(set.gasEstimatorFallbackMode),
+			CheckRetries:                    &set.gasEstimatorCheckRetries,
+			OraclePollInterval:              models.MustNewDuration(set.gasOraclePollInterval),
+			PriceFeedEnabled:                ptr(false),
+			EIP1559DynamicFees:              ptr(set.eip1559DynamicFees),
+			MinimumFeeMarket:                ptr(set.minimumFeeMarket),
+			BumpMin:                         &set.gasBumpWei,
+			BumpPercent:                     ptr(set.gasBumpPercent),
+			BumpThreshold:                   ptr(uint32(set.gasBumpThreshold)),
+			NoBumpThreshold:                 ptr(uint32(set.gasNoBumpThreshold)),
+			BumpTxDepth:                     ptr(set.gasBumpTxDepth),
+			FeeCapDefault:                   &set.gasFeeCapDefault,
+			LimitDefault:                    ptr(uint32(set.gasLimitDefault)),
+			LimitMax:                        ptr(uint32(set.gasLimitMax)),
+			LimitIncrementOnFailure:         &set.gasLimitIncrementOnFailure,
+			LimitMultiplier:                 ptr(decimal.NewFromFloat32(set.gasLimitMultiplier)),
+			LimitCapacityBuffer:             ptr(decimal.NewFromFloat(set.gasCapacityBuffer)),
+			LimitTransfer:                   ptr(uint32(set.gasLimitTransfer)),
+			LimitContractCreation:           ptr(set.contractCreationGasLimit),
+			TipCapDefault:                   &set.gasTipCapDefault,
+			TipCapMin:                       &set.gasTipCapMinimum,
+			PriceDefault:                    &set.gasPriceDefault,
+			PriceMax:                        &set.maxGasPriceWei,
+			PriceMaxWarningThresholdPercent: &set.maxGasPriceWarningThresholdPercent,
+			PriceMin:                        &set.minGasPriceWei,
+			PriceCacheTTL:                   models.MustNewDuration(set.gasPriceCacheTTL),
+			BlobPriceDefault:                &set.blobGasPriceDefault,
+			BlobPriceMax:                    &set.maxBlobGasPrice,
+			FeeHistoryBlocks:                ptr(set.feeHistoryBlocks),
 			LimitJobType: v2.GasLimitJobType{
 				OCR:    set.gasLimitOCRJobType,
 				DR:     set.gasLimitDRJobType,
@@ -82,24 +124,36 @@ func (set chainSpecificConfigDefaultSet) asV2() v2.Chain {
 				CheckInclusionBlocks:     ptr(set.blockHistoryEstimatorCheckInclusionBlocks),
 				CheckInclusionPercentile: ptr(set.blockHistoryEstimatorCheckInclusionPercentile),
 				TransactionPercentile:    ptr(set.blockHistoryEstimatorTransactionPercentile),
+				TransactionPriceHistory:  ptr(set.gasEstimatorTransactionPriceHistory),
+				EWMAAlpha:                ptr(decimal.NewFromFloat(set.blockHistoryEstimatorEWMAAlpha)),
+				MinSampleCount:           ptr(set.gasEstimatorMinSampleCount),
+				ExcludeZeroPriceTxs:      ptr(set.gasHistoryExcludeZeroPriceTxs),
 			},
 		},
 		HeadTracker: v2.HeadTracker{
 			HistoryDepth:     ptr(set.headTrackerHistoryDepth),
 			MaxBufferSize:    ptr(set.headTrackerMaxBufferSize),
 			SamplingInterval: models.MustNewDuration(set.headTrackerSamplingInterval),
+			CallbackTimeout:  models.MustNewDuration(set.headTrackerCallbackTimeout),
+			BlockDelay:       &set.headTrackerBlockDelay,
+			MaxReorgDepth:    &set.maxReorgDepth,
+			MaxBlockAge:      models.MustNewDuration(set.maxBlockAge),
+			FlushInterval:    models.MustNewDuration(set.headTrackerFlushInterval),
 		},
 		KeySpecific: nil,
 		NodePool: v2.NodePool{
 			PollFailureThreshold: ptr(set.nodePollFailureThreshold),
 			PollInterval:         models.MustNewDuration(set.nodePollInterval),
 			SelectionMode:        ptr(set.nodeSelectionMode),
+			SyncThreshold:        &set.nodeSyncThreshold,
 		},
 		OCR: v2.OCR{
 			ContractConfirmations:              ptr(set.ocrContractConfirmations),
+			ContractLookbackBlocks:             ptr(set.ocrContractLookbackBlocks),
 			ContractTransmitterTransmitTimeout: models.MustNewDuration(set.ocrContractTransmitterTransmitTimeout),
 			DatabaseTimeout:                    models.MustNewDuration(set.ocrDatabaseTimeout),
 			ObservationGracePeriod:             models.MustNewDuration(set.ocrObservationGracePeriod),
+			MinObservationTimeout:              models.MustNewDuration(set.ocrMinObservationTimeout),
 		},
 		OCR2: v2.OCR2{
 			Automation: v2.Automation{
@@ -117,6 +171,13 @@ func ptr[T any](v T) *T {
 	return &v
 }
 
+func asReadTimeoutFactor(f float64) *decimal.Decimal {
+	if f == 0 {
+		return nil
+	}
+	return ptr(decimal.NewFromFloat(f))
+}
+
 func asEIP155Address(s string) *ethkey.EIP55Address {
 	if s == "" {
 		return nil
@@ -0,0 +1,170 @@
+package gas
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/utils/mathutil"
+)
+
+var errARIMAInsufficientData = errors.New("insufficient data to fit ARIMA model")
+
+// forecastARIMA fits an ARIMA(p,d,q) model to series and returns the one step
+// ahead forecast.
+//
+// The series is differenced d times, then the ARMA(p,q) coefficients are
+// estimated with the Hannan-Rissanen procedure: a long autoregression is fit
+// first to approximate the innovations, and those residuals are then used as
+// regressors for the moving average terms in a second least squares fit.
+func forecastARIMA(series []float64, p, d, q int) (float64, error) {
+	if p < 0 || d < 0 || q < 0 {
+		return 0, errors.Errorf("invalid ARIMA order (%d,%d,%d)", p, d, q)
+	}
+
+	// levels[k] is the series differenced k times
+	levels := make([][]float64, d+1)
+	levels[0] = series
+	for k := 1; k <= d; k++ {
+		prev := levels[k-1]
+		if len(prev) < 2 {
+			return 0, errARIMAInsufficientData
+		}
+		diffed := make([]float64, len(prev)-1)
+		for i := range diffed {
+			diffed[i] = prev[i+1] - prev[i]
+		}
+		levels[k] = diffed
+	}
+	y := levels[d]
+	n := len(y)
+
+	// Step 1: long autoregression to estimate the innovations
+	m := 2 * (p + q)
+	if m < 1 {
+		m = 1
+	}
+	var resid []float64
+	if q > 0 {
+		X, target := arimaDesign(y, m, m, 0, nil)
+		if len(target) <= m+1 {
+			return 0, errARIMAInsufficientData
+		}
+		beta, err := leastSquares(X, target)
+		if err != nil {
+			return 0, err
+		}
+		resid = make([]float64, n)
+		for t := m; t < n; t++ {
+			resid[t] = y[t] - dot(beta, arimaRow(y, resid, t, m, 0))
+		}
+	}
+
+	// Step 2: regress on lagged values and lagged innovations
+	start := m + mathutil.Max(p, q)
+	if q == 0 {
+		start = p
+	}
+	X, target := arimaDesign(y, start, p, q, resid)
+	if len(target) <= 1+p+q {
+		return 0, errARIMAInsufficientData
+	}
+	beta, err := leastSquares(X, target)
+	if err != nil {
+		return 0, err
+	}
+	// NOTE: The innovations from the long autoregression are deliberately
+	// reused for the forecast rather than recomputed recursively from the
+	// fitted model, since that recursion diverges if the estimated MA part is
+	// not invertible
+	next := dot(beta, arimaRow(y, resid, n, p, q))
+
+	// Undo the differencing
+	for k := d; k > 0; k-- {
+		prev := levels[k-1]
+		next += prev[len(prev)-1]
+	}
+	if math.IsNaN(next) || math.IsInf(next, 0) {
+		return 0, errors.New("ARIMA forecast is not a finite number")
+	}
+	return next, nil
+}
+
+// arimaDesign builds the least squares design matrix and target vector for
+// observations from index start onwards.
+func arimaDesign(y []float64, start, p, q int, resid []float64) (X [][]float64, target []float64) {
+	for t := start; t < len(y); t++ {
+		X = append(X, arimaRow(y, resid, t, p, q))
+		target = append(target, y[t])
+	}
+	return
+}
+
+// arimaRow returns the regressors for predicting y[t]: an intercept, the p
+// previous values and the q previous innovations.
+func arimaRow(y, resid []float64, t, p, q int) []float64 {
+	row := make([]float64, 1+p+q)
+	row[0] = 1
+	for i := 1; i <= p; i++ {
+		row[i] = y[t-i]
+	}
+	for j := 1; j <= q; j++ {
+		row[p+j] = resid[t-j]
+	}
+	return row
+}
+
+func dot(a, b []float64) (sum float64) {
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return
+}
+
+// leastSquares solves the normal equations X'X b = X'y by Gaussian
+// elimination with partial pivoting.
+func leastSquares(X [][]float64, y []float64) ([]float64, error) {
+	k := len(X[0])
+	// augmented matrix [X'X | X'y]
+	a := make([][]float64, k)
+	for i := range a {
+		a[i] = make([]float64, k+1)
+	}
+	for r, row := range X {
+		for i := 0; i < k; i++ {
+			for j := 0; j < k; j++ {
+				a[i][j] += row[i] * row[j]
+			}
+			a[i][k] += row[i] * y[r]
+		}
+	}
+
+	for col := 0; col < k; col++ {
+		pivot := col
+		for r := col + 1; r < k; r++ {
+			if math.Abs(a[r][col]) > math.Abs(a[pivot][col]) {
+				pivot = r
+			}
+		}
+		if math.Abs(a[pivot][col]) < 1e-9 {
+			return nil, errors.Wrap(errARIMAInsufficientData, "series has too little variation")
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		for r := col + 1; r < k; r++ {
+			f := a[r][col] / a[col][col]
+			for c := col; c <= k; c++ {
+				a[r][c] -= f * a[col][c]
+			}
+		}
+	}
+
+	beta := make([]float64, k)
+	for i := k - 1; i >= 0; i-- {
+		sum := a[i][k]
+		for j := i + 1; j < k; j++ {
+			sum -= a[i][j] * beta[j]
+		}
+		beta[i] = sum / a[i][i]
+	}
+	return beta, nil
+}
@@ -0,0 +1,153 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+const (
+	// ARIMAHistorySize is the number of most recent base fees the model is trained on
+	ARIMAHistorySize = 100
+	// ARIMAMinHistorySize is the number of base fees required before a forecast is attempted
+	ARIMAMinHistorySize = 30
+	// ARIMAFeeCapMultiplier is applied to the forecast base fee to derive the FeeCap floor
+	ARIMAFeeCapMultiplier = 1.1
+
+	arimaP = 2
+	arimaD = 1
+	arimaQ = 1
+)
+
+var _ Estimator = &arimaEstimator{}
+
+type baseFeeObservation struct {
+	blockNum int64
+	baseFee  float64
+}
+
+// arimaEstimator is an Estimator which extends BlockHistoryEstimator by
+// forecasting the next block's base fee from recent heads. The forecast is
+// used as a floor for the EIP-1559 FeeCap so that transactions submitted while
+// fees are rising are not priced below the base fee by the time they land.
+type arimaEstimator struct {
+	Estimator // *BlockHistoryEstimator
+
+	config Config
+	lggr   logger.SugaredLogger
+
+	historyMu sync.RWMutex
+	history   []baseFeeObservation
+}
+
+// NewARIMAEstimator returns a new "ARIMA" estimator wrapping the given block
+// history estimator
+func NewARIMAEstimator(lggr logger.Logger, bhe Estimator, cfg Config) Estimator {
+	return &arimaEstimator{
+		Estimator: bhe,
+		config:    cfg,
+		lggr:      logger.Sugared(lggr.Named("ARIMAEstimator")),
+		history:   make([]baseFeeObservation, 0, ARIMAHistorySize),
+	}
+}
+
+func (a *arimaEstimator) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	a.recordBaseFee(head)
+	a.Estimator.OnNewLongestChain(ctx, head)
+}
+
+func (a *arimaEstimator) recordBaseFee(head *evmtypes.Head) {
+	// Non-eip1559 blocks don't include base fee
+	if head == nil || head.BaseFeePerGas == nil {
+		return
+	}
+	baseFee, _ := new(big.Float).SetInt(head.BaseFeePerGas.ToInt()).Float64()
+
+	a.historyMu.Lock()
+	defer a.historyMu.Unlock()
+	// Drop any observations that were re-org'd out
+	i := len(a.history)
+	for i > 0 && a.history[i-1].blockNum >= head.Number {
+		i--
+	}
+	a.history = append(a.history[:i], baseFeeObservation{head.Number, baseFee})
+	if l := len(a.history); l > ARIMAHistorySize {
+		a.history = append(a.history[:0], a.history[l-ARIMAHistorySize:]...)
+	}
+}
+
+func (a *arimaEstimator) getBaseFees() []float64 {
+	a.historyMu.RLock()
+	defer a.historyMu.RUnlock()
+	fees := make([]float64, len(a.history))
+	for i, o := range a.history {
+		fees[i] = o.baseFee
+	}
+	return fees
+}
+
+// feeCapFloor returns the forecast base fee multiplied by
+// ARIMAFeeCapMultiplier, or the mean base fee if there is not enough history to
+// fit the model. Returns nil if no base fees have been observed.
+func (a *arimaEstimator) feeCapFloor() *assets.Wei {
+	fees := a.getBaseFees()
+	if len(fees) == 0 {
+		return nil
+	}
+
+	var floor float64
+	forecast, err := a.forecast(fees)
+	if err != nil {
+		var sum float64
+		for _, f := range fees {
+			sum += f
+		}
+		floor = sum / float64(len(fees))
+		a.lggr.Debugw("Unable to forecast base fee, falling back to block history mean", "err", err, "mean", floor, "observations", len(fees))
+	} else {
+		floor = forecast * ARIMAFeeCapMultiplier
+		a.lggr.Debugw("Forecast next base fee", "forecast", forecast, "observations", len(fees))
+	}
+	if floor <= 0 {
+		return nil
+	}
+	floorInt, _ := big.NewFloat(floor).Int(nil)
+	return assets.NewWei(floorInt)
+}
+
+func (a *arimaEstimator) forecast(fees []float64) (float64, error) {
+	if len(fees) < ARIMAMinHistorySize {
+		return 0, errARIMAInsufficientData
+	}
+	// Fit in gwei to keep the normal equations well conditioned
+	scaled := make([]float64, len(fees))
+	for i, f := range fees {
+		scaled[i] = f / 1e9
+	}
+	forecast, err := forecastARIMA(scaled, arimaP, arimaD, arimaQ)
+	if err != nil {
+		return 0, err
+	}
+	return forecast * 1e9, nil
+}
+
+func (a *arimaEstimator) GetDynamicFee(ctx context.Context, gasLimit uint32, maxGasPriceWei *assets.Wei) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	fee, chainSpecificGasLimit, err = a.Estimator.GetDynamicFee(ctx, gasLimit, maxGasPriceWei)
+	if err != nil {
+		return
+	}
+	floor := a.feeCapFloor()
+	if floor == nil {
+		return
+	}
+	floor = assets.WeiMin(floor, maxGasPriceWei)
+	if fee.FeeCap.Cmp(floor) < 0 {
+		a.lggr.Debugw("Raising FeeCap to forecast floor", "feeCap", fee.FeeCap, "floor", floor)
+		fee.FeeCap = floor
+	}
+	return
+}
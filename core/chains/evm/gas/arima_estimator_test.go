@@ -0,0 +1,161 @@
+package gas_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestARIMA_Forecast(t *testing.T) {
+	t.Parallel()
+
+	f := func(i int) float64 { return 20 + 0.05*float64(i) + math.Sin(0.3*float64(i)) }
+
+	t.Run("forecasts an exact autoregressive series", func(t *testing.T) {
+		series := make([]float64, 100)
+		for i := range series {
+			series[i] = f(i)
+		}
+		forecast, err := gas.ForecastARIMA(series, 2, 1, 0)
+		require.NoError(t, err)
+		assert.InDelta(t, f(100), forecast, 1e-6)
+	})
+
+	t.Run("forecasts a noisy series", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		series := make([]float64, 100)
+		for i := range series {
+			series[i] = f(i) + (r.Float64()-0.5)*0.02
+		}
+		forecast, err := gas.ForecastARIMA(series, 2, 1, 1)
+		require.NoError(t, err)
+		assert.InDelta(t, f(100), forecast, 0.1)
+	})
+
+	t.Run("returns error with too few observations", func(t *testing.T) {
+		_, err := gas.ForecastARIMA([]float64{1, 2, 3, 4}, 2, 1, 1)
+		assert.EqualError(t, err, "insufficient data to fit ARIMA model")
+	})
+
+	t.Run("returns error for a constant series", func(t *testing.T) {
+		series := make([]float64, 100)
+		for i := range series {
+			series[i] = 42
+		}
+		_, err := gas.ForecastARIMA(series, 2, 1, 1)
+		assert.EqualError(t, err, "series has too little variation: insufficient data to fit ARIMA model")
+	})
+}
+
+func TestARIMAEstimator_GetDynamicFee(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.GWei(1000)
+	const gasLimit uint32 = 100000
+
+	newHead := func(n int64, baseFee *assets.Wei) *evmtypes.Head {
+		return &evmtypes.Head{Hash: utils.NewHash(), Number: n, BaseFeePerGas: baseFee}
+	}
+
+	t.Run("returns the block history fee if no base fees have been observed", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		bhe := mocks.NewEstimator(t)
+		fee := gas.DynamicFee{FeeCap: assets.NewWeiI(50), TipCap: assets.NewWeiI(1)}
+		bhe.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(fee, gasLimit, nil)
+
+		a := gas.NewARIMAEstimator(logger.TestLogger(t), bhe, config)
+		got, limit, err := a.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, gasLimit, limit)
+		assert.Equal(t, fee, got)
+	})
+
+	t.Run("returns error from block history estimator", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		bhe := mocks.NewEstimator(t)
+		bhe.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(gas.DynamicFee{}, uint32(0), errors.New("not started"))
+
+		a := gas.NewARIMAEstimator(logger.TestLogger(t), bhe, config)
+		_, _, err := a.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "not started")
+	})
+
+	t.Run("falls back to mean base fee with insufficient history, dropping re-org'd heads", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		bhe := mocks.NewEstimator(t)
+		bhe.On("OnNewLongestChain", mock.Anything, mock.Anything)
+		bhe.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(gas.DynamicFee{FeeCap: assets.NewWeiI(50), TipCap: assets.NewWeiI(1)}, gasLimit, nil).Once()
+		bhe.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(gas.DynamicFee{FeeCap: assets.NewWeiI(1000), TipCap: assets.NewWeiI(1)}, gasLimit, nil).Once()
+
+		a := gas.NewARIMAEstimator(logger.TestLogger(t), bhe, config)
+		ctx := testutils.Context(t)
+		a.OnNewLongestChain(ctx, newHead(1, assets.NewWeiI(100)))
+		a.OnNewLongestChain(ctx, newHead(2, assets.NewWeiI(200)))
+		a.OnNewLongestChain(ctx, newHead(3, assets.NewWeiI(300)))
+		// re-org replaces blocks 2 and 3
+		a.OnNewLongestChain(ctx, newHead(2, assets.NewWeiI(600)))
+		// non-EIP1559 heads are ignored
+		a.OnNewLongestChain(ctx, newHead(3, nil))
+
+		fee, _, err := a.GetDynamicFee(ctx, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(350), fee.FeeCap)
+		assert.Equal(t, assets.NewWeiI(1), fee.TipCap)
+
+		// FeeCap above the floor is left alone
+		fee, _, err = a.GetDynamicFee(ctx, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(1000), fee.FeeCap)
+	})
+
+	t.Run("caps the floor at the maximum gas price", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		bhe := mocks.NewEstimator(t)
+		bhe.On("OnNewLongestChain", mock.Anything, mock.Anything)
+		bhe.On("GetDynamicFee", mock.Anything, gasLimit, assets.NewWeiI(150)).Return(gas.DynamicFee{FeeCap: assets.NewWeiI(50), TipCap: assets.NewWeiI(1)}, gasLimit, nil)
+
+		a := gas.NewARIMAEstimator(logger.TestLogger(t), bhe, config)
+		a.OnNewLongestChain(testutils.Context(t), newHead(1, assets.NewWeiI(200)))
+
+		fee, _, err := a.GetDynamicFee(testutils.Context(t), gasLimit, assets.NewWeiI(150))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(150), fee.FeeCap)
+	})
+
+	t.Run("uses the forecast base fee with a buffer once enough heads have been observed", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		bhe := mocks.NewEstimator(t)
+		bhe.On("OnNewLongestChain", mock.Anything, mock.Anything)
+		bhe.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(gas.DynamicFee{FeeCap: assets.GWei(1), TipCap: assets.GWei(1)}, gasLimit, nil)
+
+		a := gas.NewARIMAEstimator(logger.TestLogger(t), bhe, config)
+		ctx := testutils.Context(t)
+		// base fee rising steadily with some oscillation and noise, in gwei
+		f := func(n int64) float64 { return 20 + 0.5*float64(n) + math.Sin(0.3*float64(n)) }
+		r := rand.New(rand.NewSource(1))
+		var n int64
+		for ; n < gas.ARIMAHistorySize+20; n++ {
+			baseFee := f(n) + (r.Float64()-0.5)*0.02
+			a.OnNewLongestChain(ctx, newHead(n, assets.NewWeiI(int64(baseFee*1e9))))
+		}
+
+		fee, _, err := a.GetDynamicFee(ctx, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		expected := f(n) * 1e9 * gas.ARIMAFeeCapMultiplier
+		actual, _ := fee.FeeCap.ToInt().Float64()
+		assert.InEpsilon(t, expected, actual, 0.005)
+	})
+}
@@ -0,0 +1,88 @@
+package gas
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// BlobConfig is the configuration used by the BlobGasEstimator
+type BlobConfig interface {
+	EvmBlobGasPriceDefault() *assets.Wei
+	EvmMaxBlobGasPrice() *assets.Wei
+	EvmFeeHistoryBlocks() uint16
+}
+
+// BlobGasPricer is implemented by estimators returned from NewEstimator when
+// they are given a BlobConfig
+type BlobGasPricer interface {
+	// GetBlobGasPrice returns the blob gas price to use for a blob
+	// transaction included in the next block
+	GetBlobGasPrice(ctx context.Context) (*assets.Wei, error)
+}
+
+var _ BlobGasPricer = &BlobGasEstimator{}
+
+// blobGasPricingEstimator adds blob gas pricing to an Estimator
+type blobGasPricingEstimator struct {
+	Estimator
+	*BlobGasEstimator
+}
+
+// BlobGasEstimator estimates the blob gas price of EIP-4844 blob transactions
+// from eth_feeHistory, which is queried for the last EvmFeeHistoryBlocks
+// blocks. Only the blob base fee of the next block is used, since paying the
+// highest fee of the window would overpay whenever fees are falling.
+// Nodes which predate EIP-4844, or chains which have not activated it, report
+// no blob base fee, in which case EvmBlobGasPriceDefault is used. The price is
+// never higher than EvmMaxBlobGasPrice.
+type BlobGasEstimator struct {
+	config BlobConfig
+	client rpcClient
+	logger logger.Logger
+}
+
+// NewBlobGasEstimator returns a new BlobGasEstimator
+func NewBlobGasEstimator(lggr logger.Logger, cfg BlobConfig, client rpcClient) *BlobGasEstimator {
+	return &BlobGasEstimator{
+		config: cfg,
+		client: client,
+		logger: lggr.Named("BlobGasEstimator"),
+	}
+}
+
+// feeHistory is the subset of the eth_feeHistory response which is needed to
+// estimate blob gas prices
+type feeHistory struct {
+	BaseFeePerBlobGas []*hexutil.Big `json:"baseFeePerBlobGas"`
+}
+
+// GetBlobGasPrice returns the blob gas price to use for a blob transaction
+// included in the next block. EvmFeeHistoryBlocks is read on every call.
+func (b *BlobGasEstimator) GetBlobGasPrice(ctx context.Context) (*assets.Wei, error) {
+	var res feeHistory
+	// The result includes the blob base fee of the block after the newest
+	// requested block, which is the next block to be mined.
+	blocks := b.config.EvmFeeHistoryBlocks()
+	if err := b.client.CallContext(ctx, &res, "eth_feeHistory", hexutil.Uint64(blocks), "latest", []float64{}); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch blob base fee from eth_feeHistory")
+	}
+	if len(res.BaseFeePerBlobGas) == 0 || res.BaseFeePerBlobGas[len(res.BaseFeePerBlobGas)-1] == nil {
+		b.logger.Debugw("No blob base fee reported by eth_feeHistory, using default blob gas price", "blobGasPriceDefault", b.config.EvmBlobGasPriceDefault(), "feeHistoryBlocks", blocks)
+		return b.capBlobGasPrice(b.config.EvmBlobGasPriceDefault()), nil
+	}
+	price := assets.NewWei(res.BaseFeePerBlobGas[len(res.BaseFeePerBlobGas)-1].ToInt())
+	return b.capBlobGasPrice(price), nil
+}
+
+func (b *BlobGasEstimator) capBlobGasPrice(price *assets.Wei) *assets.Wei {
+	if max := b.config.EvmMaxBlobGasPrice(); price.Cmp(max) > 0 {
+		b.logger.Warnw("Blob base fee exceeds the maximum blob gas price, capping it", "blobBaseFee", price, "maxBlobGasPrice", max)
+		return max
+	}
+	return price
+}
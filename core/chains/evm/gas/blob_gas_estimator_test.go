@@ -0,0 +1,116 @@
+package gas_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestBlobGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	cfg := gas.NewMockConfig()
+	cfg.EvmBlobGasPriceDefaultF = assets.GWei(1)
+	cfg.EvmMaxBlobGasPriceF = assets.GWei(100)
+	cfg.EvmFeeHistoryBlocksF = 1
+
+	expectFeeHistory := func(t *testing.T, client *mocks.RPCClient, blocks uint16, blobBaseFees ...int64) {
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", hexutil.Uint64(blocks), "latest", []float64{}).Return(nil).Run(func(args mock.Arguments) {
+			fees := make([]*hexutil.Big, len(blobBaseFees))
+			for i, fee := range blobBaseFees {
+				fees[i] = (*hexutil.Big)(big.NewInt(fee))
+			}
+			b, err := json.Marshal(map[string]interface{}{"baseFeePerBlobGas": fees})
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(b, args.Get(1)))
+		})
+	}
+
+	t.Run("returns the blob base fee of the next block", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		expectFeeHistory(t, client, 1, 5e9, 6e9)
+
+		price, err := gas.NewBlobGasEstimator(logger.TestLogger(t), cfg, client).GetBlobGasPrice(testutils.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(6), price)
+	})
+
+	t.Run("requests EvmFeeHistoryBlocks blocks and returns the blob base fee of the next block", func(t *testing.T) {
+		cfg := gas.NewMockConfig()
+		cfg.EvmBlobGasPriceDefaultF = assets.GWei(1)
+		cfg.EvmMaxBlobGasPriceF = assets.GWei(100)
+		cfg.EvmFeeHistoryBlocksF = 3
+
+		client := mocks.NewRPCClient(t)
+		expectFeeHistory(t, client, 3, 5e9, 8e9, 7e9, 6e9)
+
+		price, err := gas.NewBlobGasEstimator(logger.TestLogger(t), cfg, client).GetBlobGasPrice(testutils.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(6), price)
+	})
+
+	t.Run("caps the blob base fee at the maximum", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		expectFeeHistory(t, client, 1, 5e9, 200e9)
+
+		price, err := gas.NewBlobGasEstimator(logger.TestLogger(t), cfg, client).GetBlobGasPrice(testutils.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(100), price)
+	})
+
+	t.Run("uses the default if no blob base fee is reported", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		expectFeeHistory(t, client, 1)
+
+		price, err := gas.NewBlobGasEstimator(logger.TestLogger(t), cfg, client).GetBlobGasPrice(testutils.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(1), price)
+	})
+
+	t.Run("returns an error if eth_feeHistory fails", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", hexutil.Uint64(1), "latest", []float64{}).Return(errors.New("kaboom"))
+
+		_, err := gas.NewBlobGasEstimator(logger.TestLogger(t), cfg, client).GetBlobGasPrice(testutils.Context(t))
+		assert.EqualError(t, err, "failed to fetch blob base fee from eth_feeHistory: kaboom")
+	})
+}
+
+func TestNewEstimator_BlobGasPricer(t *testing.T) {
+	t.Parallel()
+
+	cfg := evmtest.NewChainScopedConfig(t, configtest.NewGeneralConfig(t, nil))
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	t.Run("prices blob gas when given a BlobConfig", func(t *testing.T) {
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", hexutil.Uint64(cfg.EvmFeeHistoryBlocks()), "latest", []float64{}).Return(nil).Once()
+
+		estimator := gas.NewEstimator(logger.TestLogger(t), ethClient, cfg, cfg)
+		pricer, ok := estimator.(gas.BlobGasPricer)
+		require.True(t, ok)
+
+		price, err := pricer.GetBlobGasPrice(testutils.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, cfg.EvmBlobGasPriceDefault(), price)
+	})
+
+	t.Run("does not price blob gas without a BlobConfig", func(t *testing.T) {
+		estimator := gas.NewEstimator(logger.TestLogger(t), ethClient, cfg, nil)
+		_, ok := estimator.(gas.BlobGasPricer)
+		assert.False(t, ok)
+	})
+}
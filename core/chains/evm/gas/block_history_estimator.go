@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"math/rand"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -72,6 +73,10 @@ var (
 
 var ErrConnectivity = errors.New("connectivity issue: transactions are not being mined")
 
+// ErrInsufficientSamples is returned by the BlockHistoryEstimator when its
+// history contains fewer than EvmGasEstimatorMinSampleCount transactions
+var ErrInsufficientSamples = errors.New("insufficient transaction samples")
+
 var _ Estimator = &BlockHistoryEstimator{}
 
 //go:generate mockery --name Config --output ./mocks/ --case=underscore
@@ -93,6 +98,7 @@ type (
 
 		gasPrice     *assets.Wei
 		tipCap       *assets.Wei
+		sampleCount  int
 		priceMu      sync.RWMutex
 		latest       *evmtypes.Head
 		latestMu     sync.RWMutex
@@ -224,6 +230,9 @@ func (b *BlockHistoryEstimator) GetLegacyGas(_ context.Context, _ []byte, gasLim
 	if !ok {
 		return nil, 0, errors.New("BlockHistoryEstimator is not started; cannot estimate gas")
 	}
+	if err = b.checkSampleCount(); err != nil {
+		return nil, 0, err
+	}
 	if gasPrice == nil {
 		if !b.initialFetch.Load() {
 			return nil, 0, errors.New("BlockHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
@@ -232,7 +241,7 @@ func (b *BlockHistoryEstimator) GetLegacyGas(_ context.Context, _ []byte, gasLim
 			"Using EvmGasPriceDefault as fallback.", "blocks", b.getBlockHistoryNumbers())
 		gasPrice = b.config.EvmGasPriceDefault()
 	}
-	gasPrice = capGasPrice(gasPrice, maxGasPriceWei, b.config)
+	gasPrice = assets.WeiMin(gasPrice, maxGasPriceWei)
 	return
 }
 
@@ -242,6 +251,22 @@ func (b *BlockHistoryEstimator) getGasPrice() *assets.Wei {
 	return b.gasPrice
 }
 
+// checkSampleCount returns ErrInsufficientSamples if the last recalculation
+// found fewer transactions than EvmGasEstimatorMinSampleCount
+func (b *BlockHistoryEstimator) checkSampleCount() error {
+	min := int(b.config.EvmGasEstimatorMinSampleCount())
+	if min == 0 {
+		return nil
+	}
+	b.priceMu.RLock()
+	n := b.sampleCount
+	b.priceMu.RUnlock()
+	if n < min {
+		return errors.Wrapf(ErrInsufficientSamples, "BlockHistoryEstimator: found %d transactions in the block history, need at least %d", n, min)
+	}
+	return nil
+}
+
 func (b *BlockHistoryEstimator) getBlockHistoryNumbers() (numsInHistory []int64) {
 	for _, b := range b.blocks {
 		numsInHistory = append(numsInHistory, b.Number)
@@ -366,6 +391,9 @@ func (b *BlockHistoryEstimator) GetDynamicFee(_ context.Context, gasLimit uint32
 	var tipCap *assets.Wei
 	ok := b.IfStarted(func() {
 		chainSpecificGasLimit = applyMultiplier(gasLimit, b.config.EvmGasLimitMultiplier())
+		if err = b.checkSampleCount(); err != nil {
+			return
+		}
 		b.priceMu.RLock()
 		defer b.priceMu.RUnlock()
 		tipCap = b.tipCap
@@ -378,16 +406,15 @@ func (b *BlockHistoryEstimator) GetDynamicFee(_ context.Context, gasLimit uint32
 				"Using EvmGasTipCapDefault as fallback.", "blocks", b.getBlockHistoryNumbers())
 			tipCap = b.config.EvmGasTipCapDefault()
 		}
-		maxGasPrice := getMaxGasPrice(maxGasPriceWei, b.config)
 		if b.config.EvmGasBumpThreshold() == 0 {
 			// just use the max gas price if gas bumping is disabled
-			feeCap = maxGasPrice
+			feeCap = maxGasPriceWei
 		} else if b.getCurrentBaseFee() != nil {
 			// HACK: due to a flaw of how EIP-1559 is implemented we have to
 			// set a much lower FeeCap than the actual maximum we are willing
 			// to pay in order to give ourselves headroom for bumping
 			// See: https://github.com/ethereum/go-ethereum/issues/24284
-			feeCap = calcFeeCap(b.getCurrentBaseFee(), b.config, tipCap, maxGasPrice)
+			feeCap = calcFeeCap(b.getCurrentBaseFee(), b.config, tipCap, maxGasPriceWei)
 		} else {
 			// This shouldn't happen on EIP-1559 blocks, since if the tip cap
 			// is set, Start must have succeeded and we would expect an initial
@@ -407,6 +434,65 @@ func (b *BlockHistoryEstimator) GetDynamicFee(_ context.Context, gasLimit uint32
 	return
 }
 
+// GetDynamicFeeWithConfidence is like GetDynamicFee, but with the tip cap taken
+// at the given confidence (0.0-1.0) percentile of the tip caps observed in the
+// block history, instead of BlockHistoryEstimatorTransactionPercentile. Higher
+// confidence gives a more aggressive estimate. The fee is bumped once for each
+// of the attempts already made to send the transaction.
+func (b *BlockHistoryEstimator) GetDynamicFeeWithConfidence(_ context.Context, gasLimit uint32, attempts int, confidence float64) (fee DynamicFee, err error) {
+	if !b.config.EvmEIP1559DynamicFees() {
+		return fee, errors.New("Can't get dynamic fee, EIP1559 is disabled")
+	}
+	if confidence < 0 || confidence > 1 {
+		return fee, errors.Errorf("confidence must be between 0 and 1, got: %v", confidence)
+	}
+	if attempts < 0 {
+		return fee, errors.Errorf("attempts must not be negative, got: %d", attempts)
+	}
+
+	ok := b.IfStarted(func() {
+		blockHistory := b.getBlocks()
+		l := mathutil.Min(len(blockHistory), int(b.config.BlockHistoryEstimatorBlockHistorySize()))
+		_, tipCaps := b.getPercentilePricesFromBlocks(blockHistory[:l], 0, true)
+		if len(tipCaps) == 0 {
+			err = errors.Wrap(ErrNoSuitableTransactions, "BlockHistoryEstimator: cannot estimate tip cap with confidence")
+			return
+		}
+		sort.Slice(tipCaps, func(i, j int) bool { return tipCaps[i].Cmp(tipCaps[j]) < 0 })
+		tipCap := tipCaps[int(float64(len(tipCaps)-1)*confidence)]
+		if min := b.config.EvmGasTipCapMinimum(); tipCap.Cmp(min) < 0 {
+			tipCap = min
+		}
+
+		maxGasPrice := b.config.EvmMaxGasPriceWei()
+		if tipCap.Cmp(maxGasPrice) > 0 {
+			tipCap = maxGasPrice
+		}
+		baseFee := b.getCurrentBaseFee()
+		fee.TipCap = tipCap
+		if b.config.EvmGasBumpThreshold() == 0 {
+			fee.FeeCap = maxGasPrice
+		} else if baseFee != nil {
+			fee.FeeCap = calcFeeCap(baseFee, b.config, tipCap, maxGasPrice)
+		} else {
+			err = errors.New("BlockHistoryEstimator: no value for latest block base fee; cannot estimate EIP-1559 base fee. Are you trying to run with EIP1559 enabled on a non-EIP1559 chain?")
+			return
+		}
+
+		for i := 0; i < attempts; i++ {
+			if fee, _, err = BumpDynamicFeeOnly(b.config, b.logger, tipCap, baseFee, fee, gasLimit, maxGasPrice); err != nil {
+				return
+			}
+		}
+		b.logger.Debugw("Estimated dynamic fee with confidence", "feeCapWei", fee.FeeCap, "tipCapWei", fee.TipCap,
+			"confidenceLevel", confidence, "attempts", attempts, "blocks", b.getBlockHistoryNumbers())
+	})
+	if !ok {
+		return fee, errors.New("BlockHistoryEstimator is not started; cannot estimate gas")
+	}
+	return
+}
+
 func calcFeeCap(latestAvailableBaseFeePerGas *assets.Wei, cfg Config, tipCap *assets.Wei, maxGasPriceWei *assets.Wei) (feeCap *assets.Wei) {
 	const maxBaseFeeIncreasePerBlock float64 = 1.125
 
@@ -485,8 +571,10 @@ func (b *BlockHistoryEstimator) Recalculate(head *evmtypes.Head) {
 	blocks := blockHistory[:l]
 
 	eip1559 := b.config.EvmEIP1559DynamicFees()
+	var samples int
 	percentileGasPrice, percentileTipCap, err := b.calculatePercentilePrices(blocks, percentile, eip1559,
 		func(gasPrices []*assets.Wei) {
+			samples = len(gasPrices)
 			for i := 0; i <= 100; i += 5 {
 				jdx := ((len(gasPrices) - 1) * i) / 100
 				promBlockHistoryEstimatorAllGasPricePercentiles.WithLabelValues(fmt.Sprintf("%v%%", i), b.chainID.String()).Set(float64(gasPrices[jdx].Int64()))
@@ -497,6 +585,7 @@ func (b *BlockHistoryEstimator) Recalculate(head *evmtypes.Head) {
 				promBlockHistoryEstimatorAllTipCapPercentiles.WithLabelValues(fmt.Sprintf("%v%%", i), b.chainID.String()).Set(float64(tipCaps[jdx].Int64()))
 			}
 		})
+	b.setSampleCount(samples)
 	if err != nil {
 		if errors.Is(err, ErrNoSuitableTransactions) {
 			lggr.Debug("No suitable transactions, skipping")
@@ -505,6 +594,17 @@ func (b *BlockHistoryEstimator) Recalculate(head *evmtypes.Head) {
 		}
 		return
 	}
+	if min := int(b.config.EvmGasEstimatorMinSampleCount()); samples < min {
+		lggr.Debugw("Too few transactions in block history, skipping", "samples", samples, "minSampleCount", min)
+		return
+	}
+	if alpha := b.config.EvmGasEstimatorBlockHistoryEWMAAlpha(); alpha > 0 {
+		percentileGasPrice, percentileTipCap, err = b.calculateEWMAPrices(blocks, percentile, eip1559, alpha)
+		if err != nil {
+			lggr.Warnw("Cannot calculate EWMA prices", "err", err)
+			return
+		}
+	}
 
 	var numsInHistory []int64
 	for _, b := range blockHistory {
@@ -541,6 +641,23 @@ func (b *BlockHistoryEstimator) Recalculate(head *evmtypes.Head) {
 	}
 }
 
+// sampleTransactions returns at most n of txs, chosen uniformly at random
+// using reservoir sampling. If n is 0 or there are no more than n
+// transactions, txs is returned unchanged.
+func sampleTransactions(txs []Transaction, n int) []Transaction {
+	if n <= 0 || len(txs) <= n {
+		return txs
+	}
+	sample := make([]Transaction, n)
+	copy(sample, txs[:n])
+	for i := n; i < len(txs); i++ {
+		if j := rand.Intn(i + 1); j < n {
+			sample[j] = txs[i]
+		}
+	}
+	return sample
+}
+
 // FetchBlocks fetches block history leading up to the given head.
 func (b *BlockHistoryEstimator) FetchBlocks(ctx context.Context, head *evmtypes.Head) error {
 	// HACK: blockDelay is the number of blocks that the block history estimator trails behind head.
@@ -632,6 +749,7 @@ func (b *BlockHistoryEstimator) FetchBlocks(ctx context.Context, head *evmtypes.
 			continue
 		}
 
+		block.Transactions = sampleTransactions(block.Transactions, int(b.config.EvmGasEstimatorTransactionPriceHistory()))
 		blocks[block.Number] = *block
 	}
 
@@ -729,6 +847,48 @@ func (b *BlockHistoryEstimator) calculatePercentilePrices(blocks []Block, percen
 	return
 }
 
+// calculateEWMAPrices returns an exponentially weighted moving average, with
+// smoothing factor alpha, of the percentile prices of each block, oldest
+// first. Blocks without any suitable transactions are skipped.
+func (b *BlockHistoryEstimator) calculateEWMAPrices(blocks []Block, percentile int, eip1559 bool, alpha float64) (gasPrice, tipCap *assets.Wei, err error) {
+	var gasPriceAvg, tipCapAvg *big.Float
+	for _, block := range blocks {
+		blockGasPrice, blockTipCap, err := b.calculatePercentilePrices([]Block{block}, percentile, eip1559, nil, nil)
+		if errors.Is(err, ErrNoSuitableTransactions) {
+			continue
+		} else if err != nil {
+			return nil, nil, err
+		}
+		gasPriceAvg = ewma(gasPriceAvg, blockGasPrice, alpha)
+		if eip1559 {
+			tipCapAvg = ewma(tipCapAvg, blockTipCap, alpha)
+		}
+	}
+	if gasPriceAvg == nil {
+		return nil, nil, ErrNoSuitableTransactions
+	}
+	gasPrice = weiFromFloat(gasPriceAvg)
+	if eip1559 {
+		tipCap = weiFromFloat(tipCapAvg)
+	}
+	return
+}
+
+// ewma adds price to the moving average avg, which is nil if empty
+func ewma(avg *big.Float, price *assets.Wei, alpha float64) *big.Float {
+	next := new(big.Float).SetInt(price.ToInt())
+	if avg == nil {
+		return next
+	}
+	next.Sub(next, avg).Mul(next, big.NewFloat(alpha))
+	return next.Add(next, avg)
+}
+
+func weiFromFloat(f *big.Float) *assets.Wei {
+	i, _ := f.Int(nil)
+	return assets.NewWei(i)
+}
+
 func (b *BlockHistoryEstimator) getPercentilePricesFromBlocks(blocks []Block, percentile int, eip1559 bool) (gasPrices, tipCaps []*assets.Wei) {
 	gasPrices = make([]*assets.Wei, 0)
 	tipCaps = make([]*assets.Wei, 0)
@@ -767,6 +927,12 @@ func verifyBlock(block Block, eip1559 bool) error {
 	return nil
 }
 
+func (b *BlockHistoryEstimator) setSampleCount(n int) {
+	b.priceMu.Lock()
+	defer b.priceMu.Unlock()
+	b.sampleCount = n
+}
+
 func (b *BlockHistoryEstimator) setPercentileTipCap(tipCap *assets.Wei) {
 	max := b.config.EvmMaxGasPriceWei()
 	min := b.config.EvmGasTipCapMinimum()
@@ -816,6 +982,11 @@ func (tx *Transaction) isUsable(cfg Config, lggr logger.Logger) bool {
 		lggr.Debugw("Ignoring transaction that was unexpectedly missing gas price", "tx", tx)
 		return false
 	}
+	// Free transactions are allowed on private chains and some testnets, but
+	// they would drag the estimate towards zero
+	if cfg.EvmGasHistoryExcludeZeroPriceTxs() && tx.GasPrice != nil && tx.GasPrice.IsZero() {
+		return false
+	}
 	return tx.chainSpecificIsUsable(cfg)
 }
 
@@ -416,6 +416,53 @@ func TestBlockHistoryEstimator_FetchBlocks(t *testing.T) {
 		assert.Len(t, gas.GetRollingBlockHistory(bhe)[2].Transactions, 1)
 	})
 
+	t.Run("samples at most EvmGasEstimatorTransactionPriceHistory transactions per block", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		cfg := newConfigWithEIP1559DynamicFeesEnabled(t)
+		cfg.BlockHistoryEstimatorBlockHistorySizeF = 2
+		cfg.BlockHistoryEstimatorBatchSizeF = 2
+		cfg.EvmGasEstimatorTransactionPriceHistoryF = 3
+
+		bhe := newBlockHistoryEstimator(t, ethClient, cfg)
+
+		prices := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+		b42 := gas.Block{
+			Number:       42,
+			Hash:         utils.NewHash(),
+			Transactions: cltest.LegacyTransactionsFromGasPrices(prices...),
+		}
+		b43 := gas.Block{
+			Number:       43,
+			Hash:         utils.NewHash(),
+			Transactions: cltest.LegacyTransactionsFromGasPrices(1, 2),
+		}
+
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 2 &&
+				b[0].Args[0] == gas.Int64ToHex(43) &&
+				b[1].Args[0] == gas.Int64ToHex(42)
+		})).Once().Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			elems[0].Result = &b43
+			elems[1].Result = &b42
+		})
+
+		err := bhe.FetchBlocks(testutils.Context(t), cltest.Head(43))
+		require.NoError(t, err)
+
+		blocks := gas.GetRollingBlockHistory(bhe)
+		require.Len(t, blocks, 2)
+		require.Len(t, blocks[0].Transactions, 3)
+		seen := map[string]bool{}
+		for _, tx := range blocks[0].Transactions {
+			assert.Contains(t, b42.Transactions, tx)
+			assert.False(t, seen[tx.GasPrice.String()], "transaction sampled more than once")
+			seen[tx.GasPrice.String()] = true
+		}
+		// Blocks with fewer transactions than the limit are kept whole
+		assert.Equal(t, b43.Transactions, blocks[1].Transactions)
+	})
+
 	t.Run("does not refetch blocks below ETH_FINALITY_DEPTH", func(t *testing.T) {
 		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
 		cfg := newConfigWithEIP1559DynamicFeesEnabled(t)
@@ -824,6 +871,34 @@ func TestBlockHistoryEstimator_Recalculate_NoEIP1559(t *testing.T) {
 		require.Equal(t, assets.NewWeiI(70), price)
 	})
 
+	t.Run("uses an EWMA of the block percentiles if EvmGasEstimatorBlockHistoryEWMAAlpha is set", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		cfg := newConfigWithEIP1559DynamicFeesDisabled(t)
+
+		cfg.EvmMaxGasPriceWeiF = maxGasPrice
+		cfg.EvmMinGasPriceWeiF = minGasPrice
+		cfg.BlockHistoryEstimatorTransactionPercentileF = uint16(50)
+
+		bhe := newBlockHistoryEstimator(t, ethClient, cfg)
+
+		blocks := []gas.Block{
+			{Number: 0, Hash: utils.NewHash(), Transactions: cltest.LegacyTransactionsFromGasPrices(20, 20)},
+			{Number: 1, Hash: utils.NewHash(), Transactions: []gas.Transaction{}},
+			{Number: 2, Hash: utils.NewHash(), Transactions: cltest.LegacyTransactionsFromGasPrices(20)},
+			{Number: 3, Hash: utils.NewHash(), Transactions: cltest.LegacyTransactionsFromGasPrices(80)},
+		}
+		gas.SetRollingBlockHistory(bhe, blocks)
+
+		// The percentile over all transactions ignores the latest block
+		bhe.Recalculate(cltest.Head(3))
+		require.Equal(t, assets.NewWeiI(20), gas.GetGasPrice(bhe))
+
+		// The EWMA moves halfway towards it, skipping the empty block
+		cfg.EvmGasEstimatorBlockHistoryEWMAAlphaF = 0.5
+		bhe.Recalculate(cltest.Head(3))
+		require.Equal(t, assets.NewWeiI(50), gas.GetGasPrice(bhe))
+	})
+
 	t.Run("takes into account zero priced transactions if chain is not xDai", func(t *testing.T) {
 		// Because everyone loves free gas!
 		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
@@ -854,6 +929,34 @@ func TestBlockHistoryEstimator_Recalculate_NoEIP1559(t *testing.T) {
 		require.Equal(t, assets.NewWeiI(0), price)
 	})
 
+	t.Run("ignores zero priced transactions if EvmGasHistoryExcludeZeroPriceTxs is set", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		cfg := newConfigWithEIP1559DynamicFeesDisabled(t)
+
+		cfg.EvmMaxGasPriceWeiF = maxGasPrice
+		cfg.EvmMinGasPriceWeiF = assets.NewWeiI(0)
+		cfg.BlockHistoryEstimatorTransactionPercentileF = uint16(50)
+		cfg.EvmGasHistoryExcludeZeroPriceTxsF = true
+
+		bhe := newBlockHistoryEstimator(t, ethClient, cfg)
+
+		blocks := []gas.Block{
+			gas.Block{
+				Number:       0,
+				Hash:         utils.NewHash(),
+				ParentHash:   common.Hash{},
+				Transactions: cltest.LegacyTransactionsFromGasPrices(0, 0, 0, 0, 60, 100),
+			},
+		}
+
+		gas.SetRollingBlockHistory(bhe, blocks)
+
+		bhe.Recalculate(cltest.Head(0))
+
+		price := gas.GetGasPrice(bhe)
+		require.Equal(t, assets.NewWeiI(60), price)
+	})
+
 	t.Run("ignores zero priced transactions on xDai", func(t *testing.T) {
 		chainID := big.NewInt(100)
 
@@ -1589,6 +1692,50 @@ func TestBlockHistoryEstimator_GetLegacyGas(t *testing.T) {
 	})
 }
 
+func TestBlockHistoryEstimator_MinSampleCount(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(1000000)
+
+	newEstimator := func(t *testing.T, prices ...int64) *gas.BlockHistoryEstimator {
+		cfg := newConfigWithEIP1559DynamicFeesDisabled(t)
+		cfg.BlockHistoryEstimatorTransactionPercentileF = uint16(35)
+		cfg.EvmGasLimitMultiplierF = float32(1)
+		cfg.EvmMaxGasPriceWeiF = maxGasPrice
+		cfg.EvmMinGasPriceWeiF = assets.NewWeiI(0)
+		cfg.EvmGasEstimatorMinSampleCountF = 3
+
+		bhe := newBlockHistoryEstimator(t, nil, cfg)
+		gas.SetRollingBlockHistory(bhe, []gas.Block{
+			{
+				Number:       1,
+				Hash:         utils.NewHash(),
+				Transactions: cltest.LegacyTransactionsFromGasPrices(prices...),
+			},
+		})
+		bhe.Recalculate(cltest.Head(1))
+		gas.SimulateStart(t, bhe)
+		return bhe
+	}
+
+	t.Run("errors if there are too few transactions", func(t *testing.T) {
+		bhe := newEstimator(t, 1000, 1200)
+
+		_, _, err := bhe.GetLegacyGas(testutils.Context(t), make([]byte, 0), 10000, maxGasPrice)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, gas.ErrInsufficientSamples))
+		assert.Contains(t, err.Error(), "found 2 transactions in the block history, need at least 3")
+	})
+
+	t.Run("returns the gas price if there are enough transactions", func(t *testing.T) {
+		bhe := newEstimator(t, 1000, 1200, 1300)
+
+		fee, _, err := bhe.GetLegacyGas(testutils.Context(t), make([]byte, 0), 10000, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(1000), fee)
+	})
+}
+
 func TestBlockHistoryEstimator_UseDefaultPriceAsFallback(t *testing.T) {
 	t.Parallel()
 
@@ -1795,10 +1942,10 @@ func TestBlockHistoryEstimator_GetDynamicFee(t *testing.T) {
 	h.BaseFeePerGas = assets.NewWeiI(900000)
 	bhe.OnNewLongestChain(testutils.Context(t), h)
 
-	t.Run("if gas bumping is enabled and global max gas price lower than local max gas price", func(t *testing.T) {
+	t.Run("if gas bumping is enabled and local max gas price set", func(t *testing.T) {
 		cfg.EvmGasBumpThresholdF = uint64(1)
 
-		fee, limit, err := bhe.GetDynamicFee(testutils.Context(t), 100000, assets.NewWeiI(1200000))
+		fee, limit, err := bhe.GetDynamicFee(testutils.Context(t), 100000, assets.NewWeiI(1000000))
 		require.NoError(t, err)
 
 		assert.Equal(t, gas.DynamicFee{FeeCap: assets.NewWeiI(1000000), TipCap: assets.NewWeiI(6000)}, fee)
@@ -1806,6 +1953,95 @@ func TestBlockHistoryEstimator_GetDynamicFee(t *testing.T) {
 	})
 }
 
+func TestBlockHistoryEstimator_GetDynamicFeeWithConfidence(t *testing.T) {
+	t.Parallel()
+
+	cfg := newConfigWithEIP1559DynamicFeesEnabled(t)
+	maxGasPrice := assets.NewWeiI(1000000)
+	cfg.BlockHistoryEstimatorEIP1559FeeCapBufferBlocksF = uint16(0)
+	cfg.BlockHistoryEstimatorTransactionPercentileF = uint16(60)
+	cfg.EvmGasBumpPercentF = uint16(20)
+	cfg.EvmGasBumpWeiF = assets.NewWeiI(100)
+	cfg.EvmGasBumpThresholdF = uint64(1)
+	cfg.EvmGasLimitMultiplierF = float32(1)
+	cfg.EvmGasTipCapDefaultF = assets.NewWeiI(0)
+	cfg.EvmGasTipCapMinimumF = assets.NewWeiI(0)
+	cfg.EvmMaxGasPriceWeiF = maxGasPrice
+	cfg.EvmMinGasPriceWeiF = assets.NewWeiI(0)
+
+	bhe := newBlockHistoryEstimator(t, nil, cfg)
+
+	blocks := []gas.Block{
+		gas.Block{
+			BaseFeePerGas: assets.NewWeiI(100),
+			Number:        0,
+			Hash:          utils.NewHash(),
+			Transactions:  cltest.DynamicFeeTransactionsFromTipCaps(10000, 2000, 8000, 4000, 6000),
+		},
+		gas.Block{
+			BaseFeePerGas: assets.NewWeiI(100),
+			Number:        1,
+			Hash:          utils.NewHash(),
+			Transactions:  cltest.DynamicFeeTransactionsFromTipCaps(1000, 3000, 5000, 7000, 9000),
+		},
+	}
+	gas.SetRollingBlockHistory(bhe, blocks)
+
+	h := cltest.Head(1)
+	h.BaseFeePerGas = assets.NewWeiI(100)
+	bhe.OnNewLongestChain(testutils.Context(t), h)
+
+	t.Run("returns an error if not started", func(t *testing.T) {
+		_, err := bhe.GetDynamicFeeWithConfidence(testutils.Context(t), 100000, 0, 0.5)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "BlockHistoryEstimator is not started; cannot estimate gas")
+	})
+
+	gas.SimulateStart(t, bhe)
+
+	t.Run("rejects invalid arguments", func(t *testing.T) {
+		_, err := bhe.GetDynamicFeeWithConfidence(testutils.Context(t), 100000, 0, 1.1)
+		assert.EqualError(t, err, "confidence must be between 0 and 1, got: 1.1")
+		_, err = bhe.GetDynamicFeeWithConfidence(testutils.Context(t), 100000, -1, 0.5)
+		assert.EqualError(t, err, "attempts must not be negative, got: -1")
+	})
+
+	t.Run("uses the tip cap at the given confidence", func(t *testing.T) {
+		for _, tt := range []struct {
+			confidence float64
+			tipCap     int64
+		}{
+			{0, 1000},
+			{0.5, 5000},
+			{0.9, 9000},
+			{1, 10000},
+		} {
+			fee, err := bhe.GetDynamicFeeWithConfidence(testutils.Context(t), 100000, 0, tt.confidence)
+			require.NoError(t, err)
+			assert.Equal(t, gas.DynamicFee{FeeCap: assets.NewWeiI(tt.tipCap + 100), TipCap: assets.NewWeiI(tt.tipCap)}, fee, "confidence %v", tt.confidence)
+		}
+	})
+
+	t.Run("bumps once for each attempt", func(t *testing.T) {
+		fee, err := bhe.GetDynamicFeeWithConfidence(testutils.Context(t), 100000, 1, 0.5)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: assets.NewWeiI(6120), TipCap: assets.NewWeiI(6000)}, fee)
+
+		fee, err = bhe.GetDynamicFeeWithConfidence(testutils.Context(t), 100000, 2, 0.5)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: assets.NewWeiI(7344), TipCap: assets.NewWeiI(7200)}, fee)
+	})
+
+	t.Run("returns an error if EIP1559 is disabled", func(t *testing.T) {
+		cfg := newConfigWithEIP1559DynamicFeesDisabled(t)
+		bhe := newBlockHistoryEstimator(t, nil, cfg)
+		gas.SimulateStart(t, bhe)
+
+		_, err := bhe.GetDynamicFeeWithConfidence(testutils.Context(t), 100000, 0, 0.5)
+		assert.EqualError(t, err, "Can't get dynamic fee, EIP1559 is disabled")
+	})
+}
+
 var _ gas.PriorAttempt = &MockAttempt{}
 
 type MockAttempt struct {
@@ -0,0 +1,109 @@
+package gas
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+)
+
+var _ Estimator = &CachedGasEstimator{}
+
+// CachedGasEstimator wraps an Estimator and reuses the last gas price it
+// returned for up to EvmGasPriceCacheTTL, so that nodes which send many
+// transactions do not hit the underlying estimator (and its RPC calls) for
+// every one of them. Only GetLegacyGas and GetDynamicFee are cached; bumping
+// always goes to the underlying estimator.
+type CachedGasEstimator struct {
+	Estimator
+	ttl time.Duration
+
+	mu      sync.Mutex
+	legacy  *cachedLegacyGas
+	dynamic *cachedDynamicFee
+}
+
+// cacheKey holds the arguments which an estimate depends on. The calldata
+// itself is ignored by every estimator, but its length is used by Arbitrum
+// to compute the gas limit.
+type cacheKey struct {
+	calldataLen    int
+	gasLimit       uint32
+	maxGasPriceWei string
+}
+
+func newCacheKey(calldataLen int, gasLimit uint32, maxGasPriceWei *assets.Wei) cacheKey {
+	k := cacheKey{calldataLen: calldataLen, gasLimit: gasLimit}
+	if maxGasPriceWei != nil {
+		k.maxGasPriceWei = maxGasPriceWei.String()
+	}
+	return k
+}
+
+type cachedLegacyGas struct {
+	key                   cacheKey
+	fetchedAt             time.Time
+	gasPrice              *assets.Wei
+	chainSpecificGasLimit uint32
+}
+
+type cachedDynamicFee struct {
+	key                   cacheKey
+	fetchedAt             time.Time
+	fee                   DynamicFee
+	chainSpecificGasLimit uint32
+}
+
+// NewCachedGasEstimator returns an Estimator which caches the estimates of
+// estimator for ttl
+func NewCachedGasEstimator(estimator Estimator, ttl time.Duration) *CachedGasEstimator {
+	return &CachedGasEstimator{Estimator: estimator, ttl: ttl}
+}
+
+func (c *CachedGasEstimator) GetLegacyGas(ctx context.Context, calldata []byte, gasLimit uint32, maxGasPriceWei *assets.Wei, opts ...Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	key := newCacheKey(len(calldata), gasLimit, maxGasPriceWei)
+	if !slices.Contains(opts, OptForceRefetch) {
+		c.mu.Lock()
+		cached := c.legacy
+		c.mu.Unlock()
+		if cached != nil && cached.key == key && c.fresh(cached.fetchedAt) {
+			return cached.gasPrice, cached.chainSpecificGasLimit, nil
+		}
+	}
+	fetchedAt := time.Now()
+	gasPrice, chainSpecificGasLimit, err = c.Estimator.GetLegacyGas(ctx, calldata, gasLimit, maxGasPriceWei, opts...)
+	if err != nil {
+		return
+	}
+	c.mu.Lock()
+	c.legacy = &cachedLegacyGas{key, fetchedAt, gasPrice, chainSpecificGasLimit}
+	c.mu.Unlock()
+	return
+}
+
+func (c *CachedGasEstimator) GetDynamicFee(ctx context.Context, gasLimit uint32, maxGasPriceWei *assets.Wei) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	key := newCacheKey(0, gasLimit, maxGasPriceWei)
+	c.mu.Lock()
+	cached := c.dynamic
+	c.mu.Unlock()
+	if cached != nil && cached.key == key && c.fresh(cached.fetchedAt) {
+		return cached.fee, cached.chainSpecificGasLimit, nil
+	}
+	fetchedAt := time.Now()
+	fee, chainSpecificGasLimit, err = c.Estimator.GetDynamicFee(ctx, gasLimit, maxGasPriceWei)
+	if err != nil {
+		return
+	}
+	c.mu.Lock()
+	c.dynamic = &cachedDynamicFee{key, fetchedAt, fee, chainSpecificGasLimit}
+	c.mu.Unlock()
+	return
+}
+
+// fresh returns true if an estimate fetched at fetchedAt may still be used
+func (c *CachedGasEstimator) fresh(fetchedAt time.Time) bool {
+	return time.Since(fetchedAt) < c.ttl
+}
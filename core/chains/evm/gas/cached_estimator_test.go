@@ -0,0 +1,112 @@
+package gas_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+)
+
+func TestCachedGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(1000)
+	const gasLimit uint32 = 21000
+
+	t.Run("GetLegacyGas reuses the estimate until it expires", func(t *testing.T) {
+		estimator := mocks.NewEstimator(t)
+		c := gas.NewCachedGasEstimator(estimator, time.Hour)
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(42), gasLimit, nil).Once()
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(43), gasLimit, nil).Once()
+
+		for i := 0; i < 3; i++ {
+			gasPrice, chainSpecificGasLimit, err := c.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(42), gasPrice)
+			assert.Equal(t, gasLimit, chainSpecificGasLimit)
+		}
+
+		gas.ExpireCache(c)
+		gasPrice, _, err := c.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(43), gasPrice)
+	})
+
+	t.Run("GetLegacyGas refetches for different arguments or with OptForceRefetch", func(t *testing.T) {
+		estimator := mocks.NewEstimator(t)
+		c := gas.NewCachedGasEstimator(estimator, time.Hour)
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(42), gasLimit, nil).Once()
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, 2*gasLimit, maxGasPrice).Return(assets.NewWeiI(43), 2*gasLimit, nil).Once()
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, 2*gasLimit, maxGasPrice, gas.OptForceRefetch).Return(assets.NewWeiI(44), 2*gasLimit, nil).Once()
+
+		gasPrice, _, err := c.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+
+		gasPrice, chainSpecificGasLimit, err := c.GetLegacyGas(testutils.Context(t), nil, 2*gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(43), gasPrice)
+		assert.Equal(t, 2*gasLimit, chainSpecificGasLimit)
+
+		gasPrice, _, err = c.GetLegacyGas(testutils.Context(t), nil, 2*gasLimit, maxGasPrice, gas.OptForceRefetch)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(44), gasPrice)
+
+		gasPrice, _, err = c.GetLegacyGas(testutils.Context(t), nil, 2*gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(44), gasPrice)
+	})
+
+	t.Run("GetLegacyGas does not cache errors", func(t *testing.T) {
+		estimator := mocks.NewEstimator(t)
+		c := gas.NewCachedGasEstimator(estimator, time.Hour)
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(nil, uint32(0), errors.New("kaboom")).Once()
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(42), gasLimit, nil).Once()
+
+		_, _, err := c.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "kaboom")
+
+		gasPrice, _, err := c.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+	})
+
+	t.Run("GetDynamicFee reuses the estimate until it expires", func(t *testing.T) {
+		estimator := mocks.NewEstimator(t)
+		c := gas.NewCachedGasEstimator(estimator, time.Hour)
+		fee := gas.DynamicFee{FeeCap: assets.NewWeiI(500), TipCap: assets.NewWeiI(10)}
+		estimator.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(fee, gasLimit, nil).Once()
+		estimator.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(gas.DynamicFee{FeeCap: assets.NewWeiI(600), TipCap: assets.NewWeiI(20)}, gasLimit, nil).Once()
+
+		for i := 0; i < 3; i++ {
+			got, _, err := c.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+			require.NoError(t, err)
+			assert.Equal(t, fee, got)
+		}
+
+		gas.ExpireCache(c)
+		got, _, err := c.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(600), got.FeeCap)
+	})
+
+	t.Run("BumpLegacyGas is not cached", func(t *testing.T) {
+		estimator := mocks.NewEstimator(t)
+		c := gas.NewCachedGasEstimator(estimator, time.Hour)
+		estimator.On("BumpLegacyGas", mock.Anything, assets.NewWeiI(42), gasLimit, maxGasPrice, mock.Anything).Return(assets.NewWeiI(50), gasLimit, nil).Twice()
+
+		for i := 0; i < 2; i++ {
+			bumped, _, err := c.BumpLegacyGas(testutils.Context(t), assets.NewWeiI(42), gasLimit, maxGasPrice, nil)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(50), bumped)
+		}
+	})
+}
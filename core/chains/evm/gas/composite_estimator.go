@@ -0,0 +1,137 @@
+package gas
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+const (
+	// CompositeEstimatorFailureThreshold is the number of consecutive errors
+	// from the primary estimator after which the fallback is used instead
+	CompositeEstimatorFailureThreshold = 3
+	// CompositeEstimatorRecoveryThreshold is the number of consecutive
+	// successes from the primary estimator after which it is used again
+	CompositeEstimatorRecoveryThreshold = 3
+)
+
+var _ Estimator = &CompositeGasEstimator{}
+
+// CompositeGasEstimator wraps a primary and a fallback Estimator. Gas is
+// estimated by the primary until it fails CompositeEstimatorFailureThreshold
+// times in a row, at which point the fallback takes over. The primary is still
+// queried while the fallback is in use, and is switched back to once it has
+// succeeded CompositeEstimatorRecoveryThreshold times in a row.
+//
+// Only the Get methods count towards switching, since bumping can fail for
+// reasons unrelated to the health of the estimator (e.g. exceeding the maximum
+// gas price). Bumps are delegated to whichever estimator is currently in use.
+type CompositeGasEstimator struct {
+	primary  Estimator
+	fallback Estimator
+	lggr     logger.Logger
+
+	mu            sync.Mutex
+	failures      int
+	successes     int
+	usingFallback bool
+}
+
+// NewCompositeGasEstimator returns an Estimator which uses fallback while
+// primary is failing
+func NewCompositeGasEstimator(lggr logger.Logger, primary, fallback Estimator) *CompositeGasEstimator {
+	return &CompositeGasEstimator{
+		primary:  primary,
+		fallback: fallback,
+		lggr:     lggr.Named("CompositeGasEstimator"),
+	}
+}
+
+func (c *CompositeGasEstimator) Start(ctx context.Context) error {
+	if err := c.primary.Start(ctx); err != nil {
+		return err
+	}
+	if err := c.fallback.Start(ctx); err != nil {
+		return multierr.Combine(err, c.primary.Close())
+	}
+	return nil
+}
+
+func (c *CompositeGasEstimator) Close() error {
+	return multierr.Combine(c.primary.Close(), c.fallback.Close())
+}
+
+func (c *CompositeGasEstimator) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	c.primary.OnNewLongestChain(ctx, head)
+	c.fallback.OnNewLongestChain(ctx, head)
+}
+
+// UsingFallback returns true if the fallback estimator is currently in use
+func (c *CompositeGasEstimator) UsingFallback() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.usingFallback
+}
+
+func (c *CompositeGasEstimator) GetLegacyGas(ctx context.Context, calldata []byte, gasLimit uint32, maxGasPriceWei *assets.Wei, opts ...Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	gasPrice, chainSpecificGasLimit, err = c.primary.GetLegacyGas(ctx, calldata, gasLimit, maxGasPriceWei, opts...)
+	if c.usePrimary(err) {
+		return
+	}
+	return c.fallback.GetLegacyGas(ctx, calldata, gasLimit, maxGasPriceWei, opts...)
+}
+
+func (c *CompositeGasEstimator) BumpLegacyGas(ctx context.Context, originalGasPrice *assets.Wei, gasLimit uint32, maxGasPriceWei *assets.Wei, attempts []PriorAttempt) (bumpedGasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	return c.current().BumpLegacyGas(ctx, originalGasPrice, gasLimit, maxGasPriceWei, attempts)
+}
+
+func (c *CompositeGasEstimator) GetDynamicFee(ctx context.Context, gasLimit uint32, maxGasPriceWei *assets.Wei) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	fee, chainSpecificGasLimit, err = c.primary.GetDynamicFee(ctx, gasLimit, maxGasPriceWei)
+	if c.usePrimary(err) {
+		return
+	}
+	return c.fallback.GetDynamicFee(ctx, gasLimit, maxGasPriceWei)
+}
+
+func (c *CompositeGasEstimator) BumpDynamicFee(ctx context.Context, original DynamicFee, gasLimit uint32, maxGasPriceWei *assets.Wei, attempts []PriorAttempt) (bumped DynamicFee, chainSpecificGasLimit uint32, err error) {
+	return c.current().BumpDynamicFee(ctx, original, gasLimit, maxGasPriceWei, attempts)
+}
+
+func (c *CompositeGasEstimator) current() Estimator {
+	if c.UsingFallback() {
+		return c.fallback
+	}
+	return c.primary
+}
+
+// usePrimary records the outcome of a call to the primary estimator, and
+// returns true if its result should be used. Errors from the primary are
+// returned as-is until the failure threshold is reached.
+func (c *CompositeGasEstimator) usePrimary(err error) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		c.successes = 0
+		c.failures++
+		if !c.usingFallback && c.failures >= CompositeEstimatorFailureThreshold {
+			c.lggr.Errorw("Primary gas estimator is failing, switching to fallback", "failures", c.failures, "err", err)
+			c.usingFallback = true
+		}
+		return !c.usingFallback
+	}
+	c.failures = 0
+	if c.usingFallback {
+		c.successes++
+		if c.successes < CompositeEstimatorRecoveryThreshold {
+			return false
+		}
+		c.lggr.Infow("Primary gas estimator has recovered, switching back from fallback", "successes", c.successes)
+		c.usingFallback = false
+	}
+	return true
+}
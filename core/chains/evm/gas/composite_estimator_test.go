@@ -0,0 +1,95 @@
+package gas_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestCompositeGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(1000)
+	const gasLimit uint32 = 21000
+
+	t.Run("Start starts both estimators, and closes the primary if the fallback fails to start", func(t *testing.T) {
+		primary := mocks.NewEstimator(t)
+		fallback := mocks.NewEstimator(t)
+		primary.On("Start", mock.Anything).Return(nil)
+		fallback.On("Start", mock.Anything).Return(errors.New("kaboom"))
+		primary.On("Close").Return(nil)
+
+		c := gas.NewCompositeGasEstimator(logger.TestLogger(t), primary, fallback)
+		assert.EqualError(t, c.Start(testutils.Context(t)), "kaboom")
+	})
+
+	t.Run("switches to the fallback after consecutive failures, and back after consecutive successes", func(t *testing.T) {
+		primary := mocks.NewEstimator(t)
+		fallback := mocks.NewEstimator(t)
+		c := gas.NewCompositeGasEstimator(logger.TestLogger(t), primary, fallback)
+		ctx := testutils.Context(t)
+
+		fallback.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(20), gasLimit, nil)
+
+		// errors from the primary are returned until the threshold is reached
+		primary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(nil, uint32(0), errors.New("rpc down")).Times(gas.CompositeEstimatorFailureThreshold)
+		for i := 1; i < gas.CompositeEstimatorFailureThreshold; i++ {
+			_, _, err := c.GetLegacyGas(ctx, nil, gasLimit, maxGasPrice)
+			assert.EqualError(t, err, "rpc down")
+			assert.False(t, c.UsingFallback())
+		}
+		gasPrice, _, err := c.GetLegacyGas(ctx, nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(20), gasPrice)
+		assert.True(t, c.UsingFallback())
+
+		// bumps go to the estimator in use
+		fallback.On("BumpLegacyGas", mock.Anything, assets.NewWeiI(20), gasLimit, maxGasPrice, mock.Anything).Return(assets.NewWeiI(30), gasLimit, nil).Once()
+		bumped, _, err := c.BumpLegacyGas(ctx, assets.NewWeiI(20), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(30), bumped)
+
+		// the fallback is used until the primary has recovered
+		primary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(10), gasLimit, nil)
+		for i := 1; i < gas.CompositeEstimatorRecoveryThreshold; i++ {
+			gasPrice, _, err = c.GetLegacyGas(ctx, nil, gasLimit, maxGasPrice)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(20), gasPrice)
+			assert.True(t, c.UsingFallback())
+		}
+		gasPrice, _, err = c.GetLegacyGas(ctx, nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(10), gasPrice)
+		assert.False(t, c.UsingFallback())
+	})
+
+	t.Run("a success resets the failure count", func(t *testing.T) {
+		primary := mocks.NewEstimator(t)
+		fallback := mocks.NewEstimator(t)
+		c := gas.NewCompositeGasEstimator(logger.TestLogger(t), primary, fallback)
+		ctx := testutils.Context(t)
+		fee := gas.DynamicFee{FeeCap: assets.NewWeiI(100), TipCap: assets.NewWeiI(1)}
+
+		for i := 0; i < 2; i++ {
+			primary.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(gas.DynamicFee{}, uint32(0), errors.New("rpc down")).Times(gas.CompositeEstimatorFailureThreshold - 1)
+			primary.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(fee, gasLimit, nil).Once()
+			for j := 1; j < gas.CompositeEstimatorFailureThreshold; j++ {
+				_, _, err := c.GetDynamicFee(ctx, gasLimit, maxGasPrice)
+				assert.EqualError(t, err, "rpc down")
+			}
+			got, _, err := c.GetDynamicFee(ctx, gasLimit, maxGasPrice)
+			require.NoError(t, err)
+			assert.Equal(t, fee, got)
+		}
+		assert.False(t, c.UsingFallback())
+	})
+}
@@ -0,0 +1,156 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var _ Estimator = &contractOracleEstimator{}
+
+// contractOracle describes a contract deployed on chain which reports the gas
+// price, and how to read it
+type contractOracle struct {
+	// mode is the GasEstimatorMode which uses this oracle
+	mode string
+	// kind describes the contract in logs and errors, e.g. "data feed"
+	kind string
+	// method is the contract function called to read the gas price
+	method string
+	// addressName describes the address setting, for when it is not configured
+	addressName string
+	// readGasPrice reads the gas price from the contract at address
+	readGasPrice func(ctx context.Context, client ethClient, address common.Address) (*assets.Wei, error)
+}
+
+// contractOracleEstimator is an Estimator which reads the gas price from a
+// contract deployed on chain. The price is cached until a new head is
+// received.
+type contractOracleEstimator struct {
+	utils.StartStopOnce
+
+	oracle  contractOracle
+	config  Config
+	client  ethClient
+	address common.Address
+	logger  logger.SugaredLogger
+
+	mu           sync.RWMutex
+	latestBlock  int64
+	gasPrice     *assets.Wei
+	gasPriceAtBN int64
+}
+
+func newContractOracleEstimator(lggr logger.Logger, cfg Config, client ethClient, address common.Address, oracle contractOracle) *contractOracleEstimator {
+	return &contractOracleEstimator{
+		oracle:      oracle,
+		config:      cfg,
+		client:      client,
+		address:     address,
+		logger:      logger.Sugared(lggr.Named(oracle.mode + "Estimator")),
+		latestBlock: -1,
+	}
+}
+
+func (o *contractOracleEstimator) Start(context.Context) error {
+	return o.StartOnce(o.oracle.mode+"Estimator", func() error {
+		if o.address == (common.Address{}) {
+			return errors.Errorf("%s estimator requires %s to be configured", o.oracle.mode, o.oracle.addressName)
+		}
+		o.logger.Infow(fmt.Sprintf("Using %s as gas price oracle", o.oracle.kind), "address", o.address)
+		return nil
+	})
+}
+
+func (o *contractOracleEstimator) Close() error {
+	return o.StopOnce(o.oracle.mode+"Estimator", func() error { return nil })
+}
+
+func (o *contractOracleEstimator) OnNewLongestChain(_ context.Context, head *evmtypes.Head) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.latestBlock = head.Number
+}
+
+func (o *contractOracleEstimator) GetLegacyGas(ctx context.Context, _ []byte, gasLimit uint32, maxGasPriceWei *assets.Wei, opts ...Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	ok := o.IfStarted(func() {
+		gasPrice, err = o.getGasPrice(ctx, slices.Contains(opts, OptForceRefetch))
+	})
+	if !ok {
+		return nil, 0, errors.New("estimator is not started")
+	} else if err != nil {
+		return nil, 0, err
+	}
+	chainSpecificGasLimit = applyMultiplier(gasLimit, o.config.EvmGasLimitMultiplier())
+	gasPrice = assets.WeiMin(gasPrice, maxGasPriceWei)
+	return
+}
+
+func (o *contractOracleEstimator) BumpLegacyGas(ctx context.Context, originalGasPrice *assets.Wei, originalGasLimit uint32, maxGasPriceWei *assets.Wei, _ []PriorAttempt) (bumpedGasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	currentGasPrice, err := o.getGasPrice(ctx, false)
+	if err != nil {
+		// Bumping does not depend on the current price, so carry on without it
+		o.logger.Warnw(fmt.Sprintf("Failed to get current gas price from %s, bumping from original price", o.oracle.kind), "err", err)
+	}
+	return BumpLegacyGasPriceOnly(o.config, o.logger, currentGasPrice, originalGasPrice, originalGasLimit, maxGasPriceWei)
+}
+
+func (o *contractOracleEstimator) GetDynamicFee(_ context.Context, _ uint32, _ *assets.Wei) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	err = errors.Errorf("dynamic fees are not supported by the %s estimator", o.oracle.mode)
+	return
+}
+
+func (o *contractOracleEstimator) BumpDynamicFee(_ context.Context, _ DynamicFee, _ uint32, _ *assets.Wei, _ []PriorAttempt) (bumped DynamicFee, chainSpecificGasLimit uint32, err error) {
+	err = errors.Errorf("dynamic fees are not supported by the %s estimator", o.oracle.mode)
+	return
+}
+
+// getGasPrice returns the cached gas price if it was fetched at the latest
+// head, otherwise it reads it from the contract.
+func (o *contractOracleEstimator) getGasPrice(ctx context.Context, forceRefetch bool) (*assets.Wei, error) {
+	o.mu.RLock()
+	latest, cached, cachedAt := o.latestBlock, o.gasPrice, o.gasPriceAtBN
+	o.mu.RUnlock()
+	if !forceRefetch && cached != nil && latest >= 0 && cachedAt == latest {
+		return cached, nil
+	}
+
+	gasPrice, err := o.oracle.readGasPrice(ctx, o.client, o.address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to call %s on %s %s", o.oracle.method, o.oracle.kind, o.address)
+	}
+	o.logger.Debugw(fmt.Sprintf("Fetched gas price from %s", o.oracle.kind), "gasPrice", gasPrice, "blockNum", latest)
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.gasPrice = gasPrice
+	o.gasPriceAtBN = latest
+	return gasPrice, nil
+}
+
+// callUint256 calls a contract function which takes no arguments and returns
+// a single 256 bit word.
+func callUint256(ctx context.Context, client ethClient, address common.Address, data string) (*big.Int, error) {
+	b, err := client.CallContract(ctx, ethereum.CallMsg{
+		To:   &address,
+		Data: common.Hex2Bytes(data),
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != 32 {
+		return nil, fmt.Errorf("return data length (%d) different than expected (%d)", len(b), 32)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,53 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// DataFeed_latestAnswer is the hex encoded call to:
+// `function latestAnswer() external view returns (int256);`
+const DataFeed_latestAnswer = "50d25bcd"
+
+// NewDataFeedEstimator returns a new "DataFeed" estimator which uses the
+// latest answer of the Chainlink data feed at EvmGasPriceFeedAddress, which
+// reports gas prices in wei (e.g. Fast Gas), as the gas price.
+// EvmGasPriceDefault is used as a floor.
+func NewDataFeedEstimator(lggr logger.Logger, cfg Config, client ethClient) Estimator {
+	return newContractOracleEstimator(lggr, cfg, client, cfg.EvmGasPriceFeedAddress(), contractOracle{
+		mode:        "DataFeed",
+		kind:        "data feed",
+		method:      "latestAnswer()",
+		addressName: "a gas price feed address",
+		readGasPrice: func(ctx context.Context, client ethClient, address common.Address) (*assets.Wei, error) {
+			answer, err := callLatestAnswer(ctx, client, address)
+			if err != nil {
+				return nil, err
+			}
+			if floor := cfg.EvmGasPriceDefault(); answer.Cmp(floor) < 0 {
+				return floor, nil
+			}
+			return answer, nil
+		},
+	})
+}
+
+func callLatestAnswer(ctx context.Context, client ethClient, address common.Address) (*assets.Wei, error) {
+	answer, err := callUint256(ctx, client, address, DataFeed_latestAnswer) // returns (int256);
+	if err != nil {
+		return nil, err
+	}
+	if answer.Bit(255) == 1 { // two's complement negative
+		answer.Sub(answer, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+	if answer.Sign() <= 0 {
+		return nil, errors.Errorf("invalid answer %s: must be positive", answer)
+	}
+	return assets.NewWei(answer), nil
+}
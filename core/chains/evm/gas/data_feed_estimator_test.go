@@ -0,0 +1,116 @@
+package gas_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestDataFeedEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(1000)
+	const gasLimit uint32 = 80000
+	feed := testutils.NewAddress()
+
+	expectLatestAnswerCall := func(t *testing.T, ethClient *mocks.ETHClient, answer int64) *mock.Call {
+		return ethClient.On("CallContract", mock.Anything, mock.IsType(ethereum.CallMsg{}), mock.Anything).Run(func(args mock.Arguments) {
+			callMsg := args.Get(1).(ethereum.CallMsg)
+			assert.Equal(t, feed, *callMsg.To)
+			assert.Equal(t, gas.DataFeed_latestAnswer, fmt.Sprintf("%x", callMsg.Data))
+			assert.Nil(t, args.Get(2))
+		}).Return(math.U256Bytes(big.NewInt(answer)), nil)
+	}
+
+	t.Run("Start fails without a feed address", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		config.On("EvmGasPriceFeedAddress").Return(common.Address{})
+		d := gas.NewDataFeedEstimator(logger.TestLogger(t), config, mocks.NewETHClient(t))
+		assert.EqualError(t, d.Start(testutils.Context(t)), "DataFeed estimator requires a gas price feed address to be configured")
+	})
+
+	t.Run("GetLegacyGas returns the feed answer, cached until the next head", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		config.On("EvmGasPriceFeedAddress").Return(feed)
+		config.On("EvmGasPriceDefault").Return(assets.NewWeiI(10))
+		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
+		ethClient := mocks.NewETHClient(t)
+		expectLatestAnswerCall(t, ethClient, 42).Once()
+		expectLatestAnswerCall(t, ethClient, 43).Once()
+
+		d := gas.NewDataFeedEstimator(logger.TestLogger(t), config, ethClient)
+		require.NoError(t, d.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, d.Close()) })
+		ctx := testutils.Context(t)
+		d.OnNewLongestChain(ctx, &evmtypes.Head{Hash: utils.NewHash(), Number: 1})
+
+		gasPrice, chainSpecificGasLimit, err := d.GetLegacyGas(ctx, nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+		assert.Equal(t, uint32(88000), chainSpecificGasLimit)
+
+		// same block, served from cache
+		gasPrice, _, err = d.GetLegacyGas(ctx, nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+
+		d.OnNewLongestChain(ctx, &evmtypes.Head{Hash: utils.NewHash(), Number: 2})
+		gasPrice, _, err = d.GetLegacyGas(ctx, nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(43), gasPrice)
+	})
+
+	t.Run("GetLegacyGas uses EvmGasPriceDefault as a floor", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		config.On("EvmGasPriceFeedAddress").Return(feed)
+		config.On("EvmGasPriceDefault").Return(assets.NewWeiI(100))
+		config.On("EvmGasLimitMultiplier").Return(float32(1))
+		ethClient := mocks.NewETHClient(t)
+		expectLatestAnswerCall(t, ethClient, 42)
+
+		d := gas.NewDataFeedEstimator(logger.TestLogger(t), config, ethClient)
+		require.NoError(t, d.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, d.Close()) })
+
+		gasPrice, _, err := d.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(100), gasPrice)
+	})
+
+	t.Run("GetLegacyGas returns error if the answer is not positive", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		config.On("EvmGasPriceFeedAddress").Return(feed)
+		ethClient := mocks.NewETHClient(t)
+		expectLatestAnswerCall(t, ethClient, -1)
+
+		d := gas.NewDataFeedEstimator(logger.TestLogger(t), config, ethClient)
+		require.NoError(t, d.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, d.Close()) })
+
+		_, _, err := d.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, fmt.Sprintf("failed to call latestAnswer() on data feed %s: invalid answer -1: must be positive", feed))
+	})
+
+	t.Run("dynamic fees are not supported", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		config.On("EvmGasPriceFeedAddress").Return(feed)
+		d := gas.NewDataFeedEstimator(logger.TestLogger(t), config, mocks.NewETHClient(t))
+		_, _, err := d.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "dynamic fees are not supported by the DataFeed estimator")
+	})
+}
@@ -38,7 +38,7 @@ func (f *fixedPriceEstimator) OnNewLongestChain(_ context.Context, _ *evmtypes.H
 func (f *fixedPriceEstimator) GetLegacyGas(_ context.Context, _ []byte, gasLimit uint32, maxGasPriceWei *assets.Wei, _ ...Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
 	gasPrice = f.config.EvmGasPriceDefault()
 	chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
-	gasPrice = capGasPrice(gasPrice, maxGasPriceWei, f.config)
+	gasPrice = assets.WeiMin(gasPrice, maxGasPriceWei)
 	return
 }
 
@@ -56,7 +56,7 @@ func (f *fixedPriceEstimator) GetDynamicFee(_ context.Context, originalGasLimit
 	var feeCap *assets.Wei
 	if f.config.EvmGasBumpThreshold() == 0 {
 		// Gas bumping is disabled, just use the max fee cap
-		feeCap = getMaxGasPrice(maxGasPriceWei, f.config)
+		feeCap = maxGasPriceWei
 	} else {
 		// Need to leave headroom for bumping so we fallback to the default value here
 		feeCap = f.config.EvmGasFeeCapDefault()
@@ -23,7 +23,6 @@ func Test_FixedPriceEstimator(t *testing.T) {
 
 		config.On("EvmGasPriceDefault").Return(assets.NewWeiI(42))
 		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
-		config.On("EvmMaxGasPriceWei").Return(maxGasPrice)
 
 		gasPrice, gasLimit, err := f.GetLegacyGas(testutils.Context(t), nil, 100000, maxGasPrice)
 		require.NoError(t, err)
@@ -37,7 +36,6 @@ func Test_FixedPriceEstimator(t *testing.T) {
 
 		config.On("EvmGasPriceDefault").Return(assets.NewWeiI(42))
 		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
-		config.On("EvmMaxGasPriceWei").Return(assets.NewWeiI(35))
 
 		gasPrice, gasLimit, err := f.GetLegacyGas(testutils.Context(t), nil, 100000, assets.NewWeiI(30))
 		require.NoError(t, err)
@@ -45,15 +43,14 @@ func Test_FixedPriceEstimator(t *testing.T) {
 		assert.Equal(t, assets.NewWeiI(30), gasPrice)
 	})
 
-	t.Run("GetLegacyGas returns global maximum gas price", func(t *testing.T) {
+	t.Run("GetLegacyGas returns the maximum gas price passed by the caller", func(t *testing.T) {
 		config := mocks.NewConfig(t)
 		f := gas.NewFixedPriceEstimator(config, logger.TestLogger(t))
 
 		config.On("EvmGasPriceDefault").Return(assets.NewWeiI(42))
 		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
-		config.On("EvmMaxGasPriceWei").Return(assets.NewWeiI(20))
 
-		gasPrice, gasLimit, err := f.GetLegacyGas(testutils.Context(t), nil, 100000, assets.NewWeiI(30))
+		gasPrice, gasLimit, err := f.GetLegacyGas(testutils.Context(t), nil, 100000, assets.NewWeiI(20))
 		require.NoError(t, err)
 		assert.Equal(t, 110000, int(gasLimit))
 		assert.Equal(t, assets.NewWeiI(20), gasPrice)
@@ -67,7 +64,6 @@ func Test_FixedPriceEstimator(t *testing.T) {
 		config.On("EvmGasPriceDefault").Return(assets.NewWeiI(42))
 		config.On("EvmGasBumpPercent").Return(uint16(10))
 		config.On("EvmGasBumpWei").Return(assets.NewWeiI(150))
-		config.On("EvmMaxGasPriceWei").Return(maxGasPrice)
 		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
 
 		gasPrice, gasLimit, err := f.BumpLegacyGas(testutils.Context(t), assets.NewWeiI(42), 100000, maxGasPrice, nil)
@@ -88,7 +84,6 @@ func Test_FixedPriceEstimator(t *testing.T) {
 		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
 		config.On("EvmGasTipCapDefault").Return(assets.NewWeiI(52))
 		config.On("EvmGasFeeCapDefault").Return(assets.NewWeiI(100))
-		config.On("EvmMaxGasPriceWei").Return(maxGasPrice)
 
 		// Gas bumping enabled
 		config.On("EvmGasBumpThreshold").Return(uint64(3)).Once()
@@ -126,7 +121,6 @@ func Test_FixedPriceEstimator(t *testing.T) {
 
 		config.On("EvmGasBumpPercent").Return(uint16(10))
 		config.On("EvmGasBumpWei").Return(assets.NewWeiI(150))
-		config.On("EvmMaxGasPriceWei").Return(maxGasPrice)
 		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
 		config.On("EvmGasTipCapDefault").Return(assets.NewWeiI(52))
 
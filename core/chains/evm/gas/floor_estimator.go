@@ -0,0 +1,70 @@
+package gas
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+var _ Estimator = &FloorGasEstimator{}
+
+// FloorGasEstimator wraps an Estimator and raises any gas price it returns to
+// at least EvmGasPriceFloor, or EvmMinGasPriceWei if no floor is set. Some
+// chains silently drop transactions priced below a protocol minimum, which
+// estimators such as L2Suggested know nothing about. For EIP-1559
+// transactions the fee cap is raised. Bumped prices are never lower than the
+// original, so only GetLegacyGas and GetDynamicFee are checked.
+type FloorGasEstimator struct {
+	Estimator
+	config Config
+	logger logger.Logger
+}
+
+// NewFloorGasEstimator returns an Estimator which never returns a gas price
+// below the configured floor
+func NewFloorGasEstimator(lggr logger.Logger, cfg Config, estimator Estimator) *FloorGasEstimator {
+	return &FloorGasEstimator{
+		Estimator: estimator,
+		config:    cfg,
+		logger:    lggr.Named("FloorGasEstimator"),
+	}
+}
+
+func (f *FloorGasEstimator) GetLegacyGas(ctx context.Context, calldata []byte, gasLimit uint32, maxGasPriceWei *assets.Wei, opts ...Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	gasPrice, chainSpecificGasLimit, err = f.Estimator.GetLegacyGas(ctx, calldata, gasLimit, maxGasPriceWei, opts...)
+	if err != nil {
+		return
+	}
+	gasPrice, err = f.applyFloor(gasPrice, maxGasPriceWei)
+	return
+}
+
+func (f *FloorGasEstimator) GetDynamicFee(ctx context.Context, gasLimit uint32, maxGasPriceWei *assets.Wei) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	fee, chainSpecificGasLimit, err = f.Estimator.GetDynamicFee(ctx, gasLimit, maxGasPriceWei)
+	if err != nil {
+		return
+	}
+	fee.FeeCap, err = f.applyFloor(fee.FeeCap, maxGasPriceWei)
+	return
+}
+
+// applyFloor returns gasPrice, raised to the floor if it is below it. It is
+// an error for the floor to exceed maxGasPriceWei, since a transaction priced
+// at the maximum would be dropped anyway.
+func (f *FloorGasEstimator) applyFloor(gasPrice *assets.Wei, maxGasPriceWei *assets.Wei) (*assets.Wei, error) {
+	floor := f.config.EvmGasPriceFloor()
+	if floor == nil {
+		floor = f.config.EvmMinGasPriceWei()
+	}
+	if gasPrice == nil || gasPrice.Cmp(floor) >= 0 {
+		return gasPrice, nil
+	}
+	if maxGasPriceWei != nil && floor.Cmp(maxGasPriceWei) > 0 {
+		return nil, errors.Errorf("gas price floor of %s exceeds the maximum gas price of %s", floor, maxGasPriceWei)
+	}
+	f.logger.Warnw("Estimated gas price is below the gas price floor, using the floor instead", "gasPrice", gasPrice, "floor", floor)
+	return floor, nil
+}
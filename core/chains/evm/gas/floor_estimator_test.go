@@ -0,0 +1,78 @@
+package gas_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestFloorGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(1000)
+	const gasLimit uint32 = 21000
+	const warning = "Estimated gas price is below the gas price floor, using the floor instead"
+
+	newEstimator := func(t *testing.T, floor *assets.Wei) (*gas.FloorGasEstimator, *mocks.Estimator, func() int) {
+		config := mocks.NewConfig(t)
+		config.On("EvmGasPriceFloor").Return(floor)
+		config.On("EvmMinGasPriceWei").Return(assets.NewWeiI(10)).Maybe()
+		estimator := mocks.NewEstimator(t)
+		lggr, obs := logger.TestLoggerObserved(t, zapcore.WarnLevel)
+		f := gas.NewFloorGasEstimator(lggr, config, estimator)
+		return f, estimator, func() int { return obs.FilterMessage(warning).Len() }
+	}
+
+	t.Run("GetLegacyGas raises prices below the floor", func(t *testing.T) {
+		f, estimator, warnings := newEstimator(t, assets.NewWeiI(100))
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(100), gasLimit, nil).Once()
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(99), gasLimit, nil).Once()
+
+		gasPrice, _, err := f.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(100), gasPrice)
+		assert.Equal(t, 0, warnings())
+
+		gasPrice, _, err = f.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(100), gasPrice)
+		assert.Equal(t, 1, warnings())
+	})
+
+	t.Run("GetLegacyGas uses EvmMinGasPriceWei if no floor is set", func(t *testing.T) {
+		f, estimator, warnings := newEstimator(t, nil)
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(5), gasLimit, nil)
+
+		gasPrice, _, err := f.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(10), gasPrice)
+		assert.Equal(t, 1, warnings())
+	})
+
+	t.Run("GetLegacyGas errors if the floor exceeds the maximum", func(t *testing.T) {
+		f, estimator, _ := newEstimator(t, assets.NewWeiI(2000))
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(500), gasLimit, nil)
+
+		_, _, err := f.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.EqualError(t, err, "gas price floor of 2 kwei exceeds the maximum gas price of 1 kwei")
+	})
+
+	t.Run("GetDynamicFee raises the fee cap", func(t *testing.T) {
+		f, estimator, warnings := newEstimator(t, assets.NewWeiI(100))
+		estimator.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(gas.DynamicFee{FeeCap: assets.NewWeiI(50), TipCap: assets.NewWeiI(5)}, gasLimit, nil)
+
+		fee, _, err := f.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: assets.NewWeiI(100), TipCap: assets.NewWeiI(5)}, fee)
+		assert.Equal(t, 1, warnings())
+	})
+}
@@ -94,7 +94,6 @@ func Test_BumpLegacyGasPriceOnly(t *testing.T) {
 			cfg := gasmocks.NewConfig(t)
 			cfg.On("EvmGasBumpPercent").Return(test.bumpPercent)
 			cfg.On("EvmGasBumpWei").Return(test.bumpWei)
-			cfg.On("EvmMaxGasPriceWei").Return(test.maxGasPriceWei)
 			cfg.On("EvmGasLimitMultiplier").Return(test.limitMultiplierPercent)
 			actual, limit, err := gas.BumpLegacyGasPriceOnly(cfg, logger.TestLogger(t), test.currentGasPrice, test.originalGasPrice, test.originalLimit, test.maxGasPriceWei)
 			require.NoError(t, err)
@@ -113,7 +112,6 @@ func Test_BumpLegacyGasPriceOnly_HitsMaxError(t *testing.T) {
 	maxGasPriceWei := assets.GWei(40)
 	cfg.On("EvmGasBumpPercent").Return(uint16(50))
 	cfg.On("EvmGasBumpWei").Return(assets.NewWeiI(5000000000))
-	cfg.On("EvmMaxGasPriceWei").Return(maxGasPriceWei)
 
 	originalGasPrice := toWei("3e10") // 30 GWei
 	_, _, err := gas.BumpLegacyGasPriceOnly(cfg, logger.TestLogger(t), nil, originalGasPrice, 42, maxGasPriceWei)
@@ -129,7 +127,6 @@ func Test_BumpLegacyGasPriceOnly_NoBumpError(t *testing.T) {
 	cfg := gasmocks.NewConfig(t)
 	cfg.On("EvmGasBumpPercent").Return(uint16(0))
 	cfg.On("EvmGasBumpWei").Return(assets.NewWeiI(0))
-	cfg.On("EvmMaxGasPriceWei").Return(maxGasPriceWei)
 
 	originalGasPrice := toWei("3e10") // 30 GWei
 	_, _, err := gas.BumpLegacyGasPriceOnly(cfg, lggr, nil, originalGasPrice, 42, maxGasPriceWei)
@@ -294,7 +291,6 @@ func Test_BumpDynamicFeeOnly(t *testing.T) {
 			cfg.On("EvmGasBumpPercent").Return(test.bumpPercent)
 			cfg.On("EvmGasTipCapDefault").Return(test.tipCapDefault)
 			cfg.On("EvmGasBumpWei").Return(test.bumpWei)
-			cfg.On("EvmMaxGasPriceWei").Return(test.maxGasPriceWei)
 			cfg.On("EvmGasLimitMultiplier").Return(test.limitMultiplierPercent)
 			if test.currentBaseFee != nil {
 				cfg.On("BlockHistoryEstimatorEIP1559FeeCapBufferBlocks").Return(uint16(4))
@@ -320,7 +316,6 @@ func Test_BumpDynamicFeeOnly_HitsMaxError(t *testing.T) {
 	cfg.On("EvmGasBumpPercent").Return(uint16(50))
 	cfg.On("EvmGasTipCapDefault").Return(assets.GWei(0))
 	cfg.On("EvmGasBumpWei").Return(assets.NewWeiI(5000000000))
-	cfg.On("EvmMaxGasPriceWei").Return(maxGasPriceWei)
 
 	t.Run("tip cap hits max", func(t *testing.T) {
 		originalFee := gas.DynamicFee{TipCap: assets.GWei(30), FeeCap: assets.GWei(100)}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -88,7 +89,15 @@ type MockConfig struct {
 	EvmGasTipCapMinimumF                            *assets.Wei
 	EvmMaxGasPriceWeiF                              *assets.Wei
 	EvmMinGasPriceWeiF                              *assets.Wei
+	EvmBlobGasPriceDefaultF                         *assets.Wei
+	EvmFeeHistoryBlocksF                            uint16
+	EvmMaxBlobGasPriceF                             *assets.Wei
+	EvmGasPriceFloorF                               *assets.Wei
 	EvmGasPriceDefaultF                             *assets.Wei
+	EvmGasEstimatorTransactionPriceHistoryF         uint16
+	EvmGasEstimatorBlockHistoryEWMAAlphaF           float64
+	EvmGasEstimatorMinSampleCountF                  uint16
+	EvmGasHistoryExcludeZeroPriceTxsF               bool
 }
 
 func NewMockConfig() *MockConfig {
@@ -123,6 +132,22 @@ func (m *MockConfig) BlockHistoryEstimatorTransactionPercentile() uint16 {
 	return m.BlockHistoryEstimatorTransactionPercentileF
 }
 
+func (m *MockConfig) EvmGasEstimatorTransactionPriceHistory() uint16 {
+	return m.EvmGasEstimatorTransactionPriceHistoryF
+}
+
+func (m *MockConfig) EvmGasEstimatorBlockHistoryEWMAAlpha() float64 {
+	return m.EvmGasEstimatorBlockHistoryEWMAAlphaF
+}
+
+func (m *MockConfig) EvmGasEstimatorMinSampleCount() uint16 {
+	return m.EvmGasEstimatorMinSampleCountF
+}
+
+func (m *MockConfig) EvmGasHistoryExcludeZeroPriceTxs() bool {
+	return m.EvmGasHistoryExcludeZeroPriceTxsF
+}
+
 func (m *MockConfig) ChainType() config.ChainType {
 	return config.ChainType(m.ChainTypeF)
 }
@@ -151,6 +176,10 @@ func (m *MockConfig) EvmGasFeeCapDefault() *assets.Wei {
 	panic("not implemented") // TODO: Implement
 }
 
+func (m *MockConfig) EvmGasFeeCapRounding() *assets.Wei {
+	panic("not implemented") // TODO: Implement
+}
+
 func (m *MockConfig) EvmGasLimitMax() uint32 {
 	panic("not implemented") // TODO: Implement
 }
@@ -159,6 +188,18 @@ func (m *MockConfig) EvmGasLimitMultiplier() float32 {
 	return m.EvmGasLimitMultiplierF
 }
 
+func (m *MockConfig) EvmGasOracleAddress() common.Address {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *MockConfig) EvmGasPriceFeedEnabled() bool {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *MockConfig) EvmGasPriceFeedAddress() common.Address {
+	panic("not implemented") // TODO: Implement
+}
+
 func (m *MockConfig) EvmGasPriceDefault() *assets.Wei {
 	return m.EvmGasPriceDefaultF
 }
@@ -175,10 +216,67 @@ func (m *MockConfig) EvmMaxGasPriceWei() *assets.Wei {
 	return m.EvmMaxGasPriceWeiF
 }
 
+func (m *MockConfig) EvmMaxGasPriceWarningThresholdPercent() uint8 {
+	panic("not implemented") // TODO: Implement
+}
+
 func (m *MockConfig) EvmMinGasPriceWei() *assets.Wei {
 	return m.EvmMinGasPriceWeiF
 }
 
+func (m *MockConfig) EvmBlobGasPriceDefault() *assets.Wei {
+	return m.EvmBlobGasPriceDefaultF
+}
+
+func (m *MockConfig) EvmMaxBlobGasPrice() *assets.Wei {
+	return m.EvmMaxBlobGasPriceF
+}
+
+func (m *MockConfig) EvmFeeHistoryBlocks() uint16 {
+	return m.EvmFeeHistoryBlocksF
+}
+
+func (m *MockConfig) EvmGasPriceFloor() *assets.Wei {
+	return m.EvmGasPriceFloorF
+}
+
+func (m *MockConfig) EvmGasPriceCacheTTL() time.Duration {
+	panic("not implemented") // TODO: Implement
+}
+
 func (m *MockConfig) GasEstimatorMode() string {
 	panic("not implemented") // TODO: Implement
 }
+
+func (m *MockConfig) EvmGasEstimatorFallbackMode() string {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *MockConfig) EvmGasEstimatorCheckRetries() uint8 {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *MockConfig) EvmGasOraclePollInterval() time.Duration {
+	panic("not implemented") // TODO: Implement
+}
+
+// ExpireCache makes the estimates cached by c stale, as if its TTL had passed
+func ExpireCache(c *CachedGasEstimator) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.legacy != nil {
+		c.legacy.fetchedAt = time.Time{}
+	}
+	if c.dynamic != nil {
+		c.dynamic.fetchedAt = time.Time{}
+	}
+}
+
+func ForecastARIMA(series []float64, p, d, q int) (float64, error) {
+	return forecastARIMA(series, p, d, q)
+}
+
+// SetMinBackoff sets the back-off before the first retry of r
+func SetMinBackoff(r *RetryingGasEstimator, d time.Duration) {
+	r.minBackoff = d
+}
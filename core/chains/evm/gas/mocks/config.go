@@ -3,10 +3,13 @@
 package mocks
 
 import (
+	common "github.com/ethereum/go-ethereum/common"
 	assets "github.com/smartcontractkit/chainlink/core/assets"
 	config "github.com/smartcontractkit/chainlink/core/config"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Config is an autogenerated mock type for the Config type
@@ -198,6 +201,76 @@ func (_m *Config) EvmGasBumpWei() *assets.Wei {
 	return r0
 }
 
+// EvmGasEstimatorBlockHistoryEWMAAlpha provides a mock function with given fields:
+func (_m *Config) EvmGasEstimatorBlockHistoryEWMAAlpha() float64 {
+	ret := _m.Called()
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// EvmGasEstimatorCheckRetries provides a mock function with given fields:
+func (_m *Config) EvmGasEstimatorCheckRetries() uint8 {
+	ret := _m.Called()
+
+	var r0 uint8
+	if rf, ok := ret.Get(0).(func() uint8); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint8)
+	}
+
+	return r0
+}
+
+// EvmGasEstimatorFallbackMode provides a mock function with given fields:
+func (_m *Config) EvmGasEstimatorFallbackMode() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EvmGasEstimatorMinSampleCount provides a mock function with given fields:
+func (_m *Config) EvmGasEstimatorMinSampleCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// EvmGasEstimatorTransactionPriceHistory provides a mock function with given fields:
+func (_m *Config) EvmGasEstimatorTransactionPriceHistory() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // EvmGasFeeCapDefault provides a mock function with given fields:
 func (_m *Config) EvmGasFeeCapDefault() *assets.Wei {
 	ret := _m.Called()
@@ -214,6 +287,36 @@ func (_m *Config) EvmGasFeeCapDefault() *assets.Wei {
 	return r0
 }
 
+// EvmGasFeeCapRounding provides a mock function with given fields:
+func (_m *Config) EvmGasFeeCapRounding() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasHistoryExcludeZeroPriceTxs provides a mock function with given fields:
+func (_m *Config) EvmGasHistoryExcludeZeroPriceTxs() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmGasLimitMax provides a mock function with given fields:
 func (_m *Config) EvmGasLimitMax() uint32 {
 	ret := _m.Called()
@@ -242,6 +345,50 @@ func (_m *Config) EvmGasLimitMultiplier() float32 {
 	return r0
 }
 
+// EvmGasOracleAddress provides a mock function with given fields:
+func (_m *Config) EvmGasOracleAddress() common.Address {
+	ret := _m.Called()
+
+	var r0 common.Address
+	if rf, ok := ret.Get(0).(func() common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasOraclePollInterval provides a mock function with given fields:
+func (_m *Config) EvmGasOraclePollInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmGasPriceCacheTTL provides a mock function with given fields:
+func (_m *Config) EvmGasPriceCacheTTL() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EvmGasPriceDefault provides a mock function with given fields:
 func (_m *Config) EvmGasPriceDefault() *assets.Wei {
 	ret := _m.Called()
@@ -258,6 +405,52 @@ func (_m *Config) EvmGasPriceDefault() *assets.Wei {
 	return r0
 }
 
+// EvmGasPriceFeedAddress provides a mock function with given fields:
+func (_m *Config) EvmGasPriceFeedAddress() common.Address {
+	ret := _m.Called()
+
+	var r0 common.Address
+	if rf, ok := ret.Get(0).(func() common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasPriceFeedEnabled provides a mock function with given fields:
+func (_m *Config) EvmGasPriceFeedEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmGasPriceFloor provides a mock function with given fields:
+func (_m *Config) EvmGasPriceFloor() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
 // EvmGasTipCapDefault provides a mock function with given fields:
 func (_m *Config) EvmGasTipCapDefault() *assets.Wei {
 	ret := _m.Called()
@@ -290,6 +483,20 @@ func (_m *Config) EvmGasTipCapMinimum() *assets.Wei {
 	return r0
 }
 
+// EvmMaxGasPriceWarningThresholdPercent provides a mock function with given fields:
+func (_m *Config) EvmMaxGasPriceWarningThresholdPercent() uint8 {
+	ret := _m.Called()
+
+	var r0 uint8
+	if rf, ok := ret.Get(0).(func() uint8); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint8)
+	}
+
+	return r0
+}
+
 // EvmMaxGasPriceWei provides a mock function with given fields:
 func (_m *Config) EvmMaxGasPriceWei() *assets.Wei {
 	ret := _m.Called()
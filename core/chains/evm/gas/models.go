@@ -31,11 +31,18 @@ func IsBumpErr(err error) bool {
 	return err != nil && (errors.Is(err, ErrBumpGasExceedsLimit) || errors.Is(err, ErrBump))
 }
 
-// NewEstimator returns the estimator for a given config
-func NewEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config) Estimator {
+// NewEstimator returns the estimator for a given config. If blobCfg is not
+// nil, the estimator also implements BlobGasPricer.
+func NewEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config, blobCfg BlobConfig) Estimator {
 	s := cfg.GasEstimatorMode()
+	if cfg.EvmGasPriceFeedEnabled() {
+		// the data feed takes the place of the configured mode
+		s = "DataFeed"
+	}
 	lggr.Infow(fmt.Sprintf("Initializing EVM gas estimator in mode: %s", s),
 		"estimatorMode", s,
+		"fallbackMode", cfg.EvmGasEstimatorFallbackMode(),
+		"checkRetries", cfg.EvmGasEstimatorCheckRetries(),
 		"batchSize", cfg.BlockHistoryEstimatorBatchSize(),
 		"blockDelay", cfg.BlockHistoryEstimatorBlockDelay(),
 		"blockHistorySize", cfg.BlockHistoryEstimatorBlockHistorySize(),
@@ -52,14 +59,50 @@ func NewEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config) Es
 		"gasTipCapMinimum", cfg.EvmGasTipCapMinimum(),
 		"maxGasPriceWei", cfg.EvmMaxGasPriceWei(),
 		"minGasPriceWei", cfg.EvmMinGasPriceWei(),
+		"gasPriceFloor", cfg.EvmGasPriceFloor(),
+		"gasFeeCapRounding", cfg.EvmGasFeeCapRounding(),
+		"gasPriceCacheTTL", cfg.EvmGasPriceCacheTTL(),
 	)
+	estimator := newEstimator(lggr, ethClient, cfg, s)
+	if f := cfg.EvmGasEstimatorFallbackMode(); f != "" && f != s {
+		estimator = NewCompositeGasEstimator(lggr, estimator, newEstimator(lggr, ethClient, cfg, f))
+	}
+	estimator = NewFloorGasEstimator(lggr, cfg, estimator)
+	if r := cfg.EvmGasFeeCapRounding(); r != nil {
+		estimator = NewRoundingGasEstimator(lggr, estimator, r)
+	}
+	if retries := cfg.EvmGasEstimatorCheckRetries(); retries > 0 {
+		estimator = NewRetryingGasEstimator(lggr, estimator, retries)
+	}
+	if ttl := cfg.EvmGasPriceCacheTTL(); ttl > 0 {
+		estimator = NewCachedGasEstimator(estimator, ttl)
+	}
+	// outside the cache, so that cached prices near the cap are still warned about
+	if cfg.EvmMaxGasPriceWarningThresholdPercent() > 0 {
+		estimator = NewNearCapWarningEstimator(lggr, cfg, ethClient.ChainID(), estimator)
+	}
+	if blobCfg != nil {
+		estimator = &blobGasPricingEstimator{estimator, NewBlobGasEstimator(lggr, blobCfg, ethClient)}
+	}
+	return estimator
+}
+
+func newEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config, s string) Estimator {
 	switch s {
 	case "Arbitrum":
 		return NewArbitrumEstimator(lggr, cfg, ethClient, ethClient)
+	case "ARIMA":
+		return NewARIMAEstimator(lggr, NewBlockHistoryEstimator(lggr, ethClient, cfg, *ethClient.ChainID()), cfg)
 	case "BlockHistory":
 		return NewBlockHistoryEstimator(lggr, ethClient, cfg, *ethClient.ChainID())
+	case "DataFeed":
+		return NewDataFeedEstimator(lggr, cfg, ethClient)
 	case "FixedPrice":
 		return NewFixedPriceEstimator(cfg, lggr)
+	case "OnChainOracle":
+		return NewOnChainOracleEstimator(lggr, cfg, ethClient)
+	case "Plugin":
+		return NewPluginEstimator(lggr, cfg, ethClient.ChainID())
 	case "Optimism2", "L2Suggested":
 		return NewL2SuggestedPriceEstimator(lggr, ethClient)
 	default:
@@ -85,6 +128,11 @@ type PriorAttempt interface {
 
 // Estimator provides an interface for estimating gas price and limit
 //
+// The maxGasPriceWei passed to each method is the most that may be paid by the
+// sending key, and is not capped again by EvmMaxGasPriceWei. Callers get it
+// from KeySpecificMaxGasPriceWei, which applies the chain's maximum unless the
+// key is exempt from it.
+//
 //go:generate mockery --name Estimator --output ./mocks/ --case=underscore
 type Estimator interface {
 	OnNewLongestChain(context.Context, *evmtypes.Head)
@@ -133,6 +181,10 @@ type Config interface {
 	BlockHistoryEstimatorCheckInclusionBlocks() uint16
 	BlockHistoryEstimatorEIP1559FeeCapBufferBlocks() uint16
 	BlockHistoryEstimatorTransactionPercentile() uint16
+	EvmGasEstimatorTransactionPriceHistory() uint16
+	EvmGasEstimatorBlockHistoryEWMAAlpha() float64
+	EvmGasEstimatorMinSampleCount() uint16
+	EvmGasHistoryExcludeZeroPriceTxs() bool
 	ChainType() config.ChainType
 	EvmEIP1559DynamicFees() bool
 	EvmFinalityDepth() uint32
@@ -140,14 +192,24 @@ type Config interface {
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpWei() *assets.Wei
 	EvmGasFeeCapDefault() *assets.Wei
+	EvmGasFeeCapRounding() *assets.Wei
 	EvmGasLimitMax() uint32
 	EvmGasLimitMultiplier() float32
+	EvmGasOracleAddress() common.Address
+	EvmGasPriceFeedEnabled() bool
+	EvmGasPriceFeedAddress() common.Address
 	EvmGasPriceDefault() *assets.Wei
 	EvmGasTipCapDefault() *assets.Wei
 	EvmGasTipCapMinimum() *assets.Wei
 	EvmMaxGasPriceWei() *assets.Wei
+	EvmMaxGasPriceWarningThresholdPercent() uint8
 	EvmMinGasPriceWei() *assets.Wei
+	EvmGasPriceFloor() *assets.Wei
+	EvmGasPriceCacheTTL() time.Duration
 	GasEstimatorMode() string
+	EvmGasEstimatorFallbackMode() string
+	EvmGasEstimatorCheckRetries() uint8
+	EvmGasOraclePollInterval() time.Duration
 }
 
 // Int64ToHex converts an int64 into go-ethereum's hex representation
@@ -315,9 +377,7 @@ func BumpLegacyGasPriceOnly(cfg Config, lggr logger.SugaredLogger, currentGasPri
 // - A configured percentage bump (ETH_GAS_BUMP_PERCENT) on top of the baseline price.
 // - A configured fixed amount of Wei (ETH_GAS_PRICE_WEI) on top of the baseline price.
 // The baseline price is the maximum of the previous gas price attempt and the node's current gas price.
-func bumpGasPrice(cfg Config, lggr logger.SugaredLogger, currentGasPrice, originalGasPrice *assets.Wei, maxGasPriceWei *assets.Wei) (*assets.Wei, error) {
-	maxGasPrice := getMaxGasPrice(maxGasPriceWei, cfg)
-
+func bumpGasPrice(cfg Config, lggr logger.SugaredLogger, currentGasPrice, originalGasPrice *assets.Wei, maxGasPrice *assets.Wei) (*assets.Wei, error) {
 	bumpedGasPrice := assets.MaxWei(
 		originalGasPrice.AddPercentage(cfg.EvmGasBumpPercent()),
 		originalGasPrice.Add(cfg.EvmGasBumpWei()),
@@ -367,8 +427,7 @@ func BumpDynamicFeeOnly(config Config, lggr logger.SugaredLogger, currentTipCap,
 // the Tip only. Unfortunately due to a flaw of how EIP-1559 is implemented we
 // have to bump FeeCap by at least 10% each time we bump the tip cap.
 // See: https://github.com/ethereum/go-ethereum/issues/24284
-func bumpDynamicFee(cfg Config, lggr logger.SugaredLogger, currentTipCap, currentBaseFee *assets.Wei, originalFee DynamicFee, maxGasPriceWei *assets.Wei) (bumpedFee DynamicFee, err error) {
-	maxGasPrice := getMaxGasPrice(maxGasPriceWei, cfg)
+func bumpDynamicFee(cfg Config, lggr logger.SugaredLogger, currentTipCap, currentBaseFee *assets.Wei, originalFee DynamicFee, maxGasPrice *assets.Wei) (bumpedFee DynamicFee, err error) {
 	baselineTipCap := assets.MaxWei(originalFee.TipCap, cfg.EvmGasTipCapDefault())
 
 	bumpedTipCap := assets.MaxWei(
@@ -420,12 +479,3 @@ func bumpDynamicFee(cfg Config, lggr logger.SugaredLogger, currentTipCap, curren
 
 	return DynamicFee{FeeCap: bumpedFeeCap, TipCap: bumpedTipCap}, nil
 }
-
-func getMaxGasPrice(userSpecifiedMax *assets.Wei, config Config) *assets.Wei {
-	return assets.WeiMin(config.EvmMaxGasPriceWei(), userSpecifiedMax)
-}
-
-func capGasPrice(calculatedGasPrice, userSpecifiedMax *assets.Wei, config Config) *assets.Wei {
-	maxGasPrice := getMaxGasPrice(userSpecifiedMax, config)
-	return assets.WeiMin(calculatedGasPrice, maxGasPrice)
-}
@@ -0,0 +1,97 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+var promGasPriceNearCap = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "chainlink_evm_gas_price_near_cap_total",
+	Help: "Counter is incremented every time an estimated gas price exceeds EvmMaxGasPriceWarningThresholdPercent of EvmMaxGasPriceWei",
+},
+	[]string{"evmChainID"},
+)
+
+var _ Estimator = &NearCapWarningEstimator{}
+
+// NearCapWarningEstimator wraps an Estimator and warns whenever a gas price
+// it returns exceeds EvmMaxGasPriceWarningThresholdPercent of
+// EvmMaxGasPriceWei, so that operators can intervene before transactions
+// start being capped. For EIP-1559 transactions the fee cap is checked.
+type NearCapWarningEstimator struct {
+	Estimator
+	config  Config
+	chainID string
+	lggr    logger.Logger
+}
+
+// NewNearCapWarningEstimator returns an Estimator which warns when the gas
+// prices returned by estimator near the configured maximum
+func NewNearCapWarningEstimator(lggr logger.Logger, cfg Config, chainID *big.Int, estimator Estimator) *NearCapWarningEstimator {
+	return &NearCapWarningEstimator{
+		Estimator: estimator,
+		config:    cfg,
+		chainID:   chainID.String(),
+		lggr:      lggr.Named("NearCapWarningEstimator"),
+	}
+}
+
+func (n *NearCapWarningEstimator) GetLegacyGas(ctx context.Context, calldata []byte, gasLimit uint32, maxGasPriceWei *assets.Wei, opts ...Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	gasPrice, chainSpecificGasLimit, err = n.Estimator.GetLegacyGas(ctx, calldata, gasLimit, maxGasPriceWei, opts...)
+	if err == nil {
+		n.checkNearCap(gasPrice)
+	}
+	return
+}
+
+func (n *NearCapWarningEstimator) BumpLegacyGas(ctx context.Context, originalGasPrice *assets.Wei, gasLimit uint32, maxGasPriceWei *assets.Wei, attempts []PriorAttempt) (bumpedGasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	bumpedGasPrice, chainSpecificGasLimit, err = n.Estimator.BumpLegacyGas(ctx, originalGasPrice, gasLimit, maxGasPriceWei, attempts)
+	if err == nil {
+		n.checkNearCap(bumpedGasPrice)
+	}
+	return
+}
+
+func (n *NearCapWarningEstimator) GetDynamicFee(ctx context.Context, gasLimit uint32, maxGasPriceWei *assets.Wei) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	fee, chainSpecificGasLimit, err = n.Estimator.GetDynamicFee(ctx, gasLimit, maxGasPriceWei)
+	if err == nil {
+		n.checkNearCap(fee.FeeCap)
+	}
+	return
+}
+
+func (n *NearCapWarningEstimator) BumpDynamicFee(ctx context.Context, original DynamicFee, gasLimit uint32, maxGasPriceWei *assets.Wei, attempts []PriorAttempt) (bumped DynamicFee, chainSpecificGasLimit uint32, err error) {
+	bumped, chainSpecificGasLimit, err = n.Estimator.BumpDynamicFee(ctx, original, gasLimit, maxGasPriceWei, attempts)
+	if err == nil {
+		n.checkNearCap(bumped.FeeCap)
+	}
+	return
+}
+
+// checkNearCap logs a warning and increments promGasPriceNearCap if gasPrice
+// exceeds the warning threshold
+func (n *NearCapWarningEstimator) checkNearCap(gasPrice *assets.Wei) {
+	percent := n.config.EvmMaxGasPriceWarningThresholdPercent()
+	if percent == 0 || gasPrice == nil {
+		return
+	}
+	maxGasPrice := n.config.EvmMaxGasPriceWei()
+	threshold := new(big.Int).Mul(maxGasPrice.ToInt(), big.NewInt(int64(percent)))
+	threshold.Div(threshold, big.NewInt(100))
+	if gasPrice.ToInt().Cmp(threshold) <= 0 {
+		return
+	}
+	promGasPriceNearCap.WithLabelValues(n.chainID).Inc()
+	n.lggr.Warnw("Gas price is nearing the configured maximum",
+		"gasPrice", gasPrice,
+		"threshold", assets.NewWei(threshold),
+		"thresholdPercent", percent,
+		"maxGasPriceWei", maxGasPrice,
+	)
+}
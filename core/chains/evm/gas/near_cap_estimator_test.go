@@ -0,0 +1,110 @@
+package gas_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestNearCapWarningEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(1000)
+	const gasLimit uint32 = 21000
+	const warning = "Gas price is nearing the configured maximum"
+
+	newEstimator := func(t *testing.T) (*gas.NearCapWarningEstimator, *mocks.Estimator, func() int) {
+		config := mocks.NewConfig(t)
+		config.On("EvmMaxGasPriceWarningThresholdPercent").Return(uint8(80))
+		config.On("EvmMaxGasPriceWei").Return(maxGasPrice)
+		estimator := mocks.NewEstimator(t)
+		lggr, obs := logger.TestLoggerObserved(t, zapcore.WarnLevel)
+		n := gas.NewNearCapWarningEstimator(lggr, config, big.NewInt(1), estimator)
+		return n, estimator, func() int { return obs.FilterMessage(warning).Len() }
+	}
+
+	t.Run("GetLegacyGas warns when the price exceeds the threshold", func(t *testing.T) {
+		n, estimator, warnings := newEstimator(t)
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(800), gasLimit, nil).Once()
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(801), gasLimit, nil).Once()
+
+		gasPrice, _, err := n.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(800), gasPrice)
+		assert.Equal(t, 0, warnings())
+
+		gasPrice, _, err = n.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(801), gasPrice)
+		assert.Equal(t, 1, warnings())
+	})
+
+	t.Run("warns on every call when wrapping a cached estimator", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		config.On("EvmMaxGasPriceWarningThresholdPercent").Return(uint8(80))
+		config.On("EvmMaxGasPriceWei").Return(maxGasPrice)
+		estimator := mocks.NewEstimator(t)
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(900), gasLimit, nil).Once()
+		lggr, obs := logger.TestLoggerObserved(t, zapcore.WarnLevel)
+		n := gas.NewNearCapWarningEstimator(lggr, config, big.NewInt(1), gas.NewCachedGasEstimator(estimator, time.Hour))
+
+		for i := 0; i < 2; i++ {
+			gasPrice, _, err := n.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(900), gasPrice)
+		}
+		assert.Equal(t, 2, obs.FilterMessage(warning).Len())
+	})
+
+	t.Run("BumpLegacyGas warns when the bumped price exceeds the threshold", func(t *testing.T) {
+		n, estimator, warnings := newEstimator(t)
+		estimator.On("BumpLegacyGas", mock.Anything, assets.NewWeiI(700), gasLimit, maxGasPrice, mock.Anything).Return(assets.NewWeiI(900), gasLimit, nil)
+
+		bumped, _, err := n.BumpLegacyGas(testutils.Context(t), assets.NewWeiI(700), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(900), bumped)
+		assert.Equal(t, 1, warnings())
+	})
+
+	t.Run("GetDynamicFee and BumpDynamicFee check the fee cap", func(t *testing.T) {
+		n, estimator, warnings := newEstimator(t)
+		fee := gas.DynamicFee{FeeCap: assets.NewWeiI(500), TipCap: assets.NewWeiI(10)}
+		bumped := gas.DynamicFee{FeeCap: assets.NewWeiI(950), TipCap: assets.NewWeiI(20)}
+		estimator.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(fee, gasLimit, nil)
+		estimator.On("BumpDynamicFee", mock.Anything, fee, gasLimit, maxGasPrice, mock.Anything).Return(bumped, gasLimit, nil)
+
+		actual, _, err := n.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, fee, actual)
+		assert.Equal(t, 0, warnings())
+
+		actual, _, err = n.BumpDynamicFee(testutils.Context(t), fee, gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, bumped, actual)
+		assert.Equal(t, 1, warnings())
+	})
+
+	t.Run("does not warn when disabled", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		config.On("EvmMaxGasPriceWarningThresholdPercent").Return(uint8(0))
+		estimator := mocks.NewEstimator(t)
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(maxGasPrice, gasLimit, nil)
+		lggr, obs := logger.TestLoggerObserved(t, zapcore.WarnLevel)
+		n := gas.NewNearCapWarningEstimator(lggr, config, big.NewInt(1), estimator)
+
+		_, _, err := n.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, 0, obs.FilterMessage(warning).Len())
+	})
+}
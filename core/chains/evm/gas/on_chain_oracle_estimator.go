@@ -0,0 +1,33 @@
+package gas
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// GasOracle_gasPrice is the hex encoded call to:
+// `function gasPrice() external view returns (uint256);`
+const GasOracle_gasPrice = "fe173b97"
+
+// NewOnChainOracleEstimator returns a new "OnChainOracle" estimator which uses
+// the gas price reported by the oracle contract at EvmGasOracleAddress, such
+// as the one on xDai/Gnosis
+func NewOnChainOracleEstimator(lggr logger.Logger, cfg Config, client ethClient) Estimator {
+	return newContractOracleEstimator(lggr, cfg, client, cfg.EvmGasOracleAddress(), contractOracle{
+		mode:        "OnChainOracle",
+		kind:        "oracle",
+		method:      "gasPrice()",
+		addressName: "a gas oracle address",
+		readGasPrice: func(ctx context.Context, client ethClient, address common.Address) (*assets.Wei, error) {
+			gasPrice, err := callUint256(ctx, client, address, GasOracle_gasPrice) // returns (uint256);
+			if err != nil {
+				return nil, err
+			}
+			return assets.NewWei(gasPrice), nil
+		},
+	})
+}
@@ -0,0 +1,141 @@
+package gas_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestOnChainOracleEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(1000)
+	const gasLimit uint32 = 80000
+	oracle := testutils.NewAddress()
+
+	expectGasPriceCall := func(t *testing.T, ethClient *mocks.ETHClient, price int64) *mock.Call {
+		return ethClient.On("CallContract", mock.Anything, mock.IsType(ethereum.CallMsg{}), mock.Anything).Run(func(args mock.Arguments) {
+			callMsg := args.Get(1).(ethereum.CallMsg)
+			assert.Equal(t, oracle, *callMsg.To)
+			assert.Equal(t, gas.GasOracle_gasPrice, fmt.Sprintf("%x", callMsg.Data))
+			assert.Nil(t, args.Get(2))
+		}).Return(common.BigToHash(big.NewInt(price)).Bytes(), nil)
+	}
+
+	t.Run("Start fails without an oracle address", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		config.On("EvmGasOracleAddress").Return(common.Address{})
+		o := gas.NewOnChainOracleEstimator(logger.TestLogger(t), config, mocks.NewETHClient(t))
+		assert.EqualError(t, o.Start(testutils.Context(t)), "OnChainOracle estimator requires a gas oracle address to be configured")
+	})
+
+	t.Run("calling GetLegacyGas on unstarted estimator returns error", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		config.On("EvmGasOracleAddress").Return(oracle)
+		o := gas.NewOnChainOracleEstimator(logger.TestLogger(t), config, mocks.NewETHClient(t))
+		_, _, err := o.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "estimator is not started")
+	})
+
+	t.Run("GetLegacyGas returns the oracle price, cached until the next head", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		config.On("EvmGasOracleAddress").Return(oracle)
+		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
+		ethClient := mocks.NewETHClient(t)
+		expectGasPriceCall(t, ethClient, 42).Once()
+		expectGasPriceCall(t, ethClient, 43).Once()
+
+		o := gas.NewOnChainOracleEstimator(logger.TestLogger(t), config, ethClient)
+		require.NoError(t, o.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, o.Close()) })
+		ctx := testutils.Context(t)
+		o.OnNewLongestChain(ctx, &evmtypes.Head{Hash: utils.NewHash(), Number: 1})
+
+		gasPrice, chainSpecificGasLimit, err := o.GetLegacyGas(ctx, nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+		assert.Equal(t, uint32(88000), chainSpecificGasLimit)
+
+		// same block, served from cache
+		gasPrice, _, err = o.GetLegacyGas(ctx, nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+
+		o.OnNewLongestChain(ctx, &evmtypes.Head{Hash: utils.NewHash(), Number: 2})
+		gasPrice, _, err = o.GetLegacyGas(ctx, nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(43), gasPrice)
+	})
+
+	t.Run("GetLegacyGas caps the oracle price at the maximum gas price", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		config.On("EvmGasOracleAddress").Return(oracle)
+		config.On("EvmGasLimitMultiplier").Return(float32(1))
+		ethClient := mocks.NewETHClient(t)
+		expectGasPriceCall(t, ethClient, 5000)
+
+		o := gas.NewOnChainOracleEstimator(logger.TestLogger(t), config, ethClient)
+		require.NoError(t, o.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, o.Close()) })
+
+		gasPrice, _, err := o.GetLegacyGas(testutils.Context(t), nil, gasLimit, assets.NewWeiI(100))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(100), gasPrice)
+	})
+
+	t.Run("GetLegacyGas returns error if the oracle call fails", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		config.On("EvmGasOracleAddress").Return(oracle)
+		ethClient := mocks.NewETHClient(t)
+		ethClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("kaboom"))
+
+		o := gas.NewOnChainOracleEstimator(logger.TestLogger(t), config, ethClient)
+		require.NoError(t, o.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, o.Close()) })
+
+		_, _, err := o.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, fmt.Sprintf("failed to call gasPrice() on oracle %s: kaboom", oracle))
+	})
+
+	t.Run("BumpLegacyGas bumps to at least the oracle price", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		config.On("EvmGasOracleAddress").Return(oracle)
+		config.On("EvmGasBumpPercent").Return(uint16(10))
+		config.On("EvmGasBumpWei").Return(assets.NewWeiI(1))
+		config.On("EvmGasLimitMultiplier").Return(float32(1))
+		ethClient := mocks.NewETHClient(t)
+		expectGasPriceCall(t, ethClient, 500)
+
+		o := gas.NewOnChainOracleEstimator(logger.TestLogger(t), config, ethClient)
+		require.NoError(t, o.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, o.Close()) })
+
+		gasPrice, chainSpecificGasLimit, err := o.BumpLegacyGas(testutils.Context(t), assets.NewWeiI(100), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(500), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+	})
+
+	t.Run("dynamic fees are not supported", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		config.On("EvmGasOracleAddress").Return(oracle)
+		o := gas.NewOnChainOracleEstimator(logger.TestLogger(t), config, mocks.NewETHClient(t))
+		_, _, err := o.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "dynamic fees are not supported by the OnChainOracle estimator")
+	})
+}
@@ -0,0 +1,301 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// GasEstimatorPlugin is an external source of gas prices, such as a third
+// party gas price API or an internal oracle. It is used by the "Plugin"
+// estimator mode of any chain it is registered for.
+type GasEstimatorPlugin interface {
+	// GetLegacyGas returns the gas price to use for non-EIP1559 transactions
+	GetLegacyGas(ctx context.Context) (*assets.Wei, error)
+	// GetDynamicFee returns the fee and tip caps to use for EIP1559 transactions
+	GetDynamicFee(ctx context.Context) (DynamicFee, error)
+}
+
+var (
+	gasEstimatorPluginsMu sync.RWMutex
+	gasEstimatorPlugins   = map[string]GasEstimatorPlugin{}
+)
+
+// RegisterGasEstimatorPlugin registers p as the gas estimator plugin for the
+// chain with chainID, replacing any plugin already registered for it. It must
+// be called before the chain is started.
+func RegisterGasEstimatorPlugin(chainID *big.Int, p GasEstimatorPlugin) {
+	gasEstimatorPluginsMu.Lock()
+	defer gasEstimatorPluginsMu.Unlock()
+	gasEstimatorPlugins[chainID.String()] = p
+}
+
+func getGasEstimatorPlugin(chainID *big.Int) GasEstimatorPlugin {
+	gasEstimatorPluginsMu.RLock()
+	defer gasEstimatorPluginsMu.RUnlock()
+	return gasEstimatorPlugins[chainID.String()]
+}
+
+var promGasOracleStale = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "chainlink_evm_gas_oracle_stale",
+	Help: "Set to 1 while the prices from the gas estimator plugin have not been refreshed for more than twice EvmGasOraclePollInterval",
+},
+	[]string{"evmChainID"},
+)
+
+var _ Estimator = &pluginEstimator{}
+
+// pluginEstimator is an Estimator which gets gas prices from the
+// GasEstimatorPlugin registered for its chain. Prices are capped to the
+// configured maximum, and bumped in the same way as the FixedPrice estimator.
+//
+// External oracles are usually rate limited, so unless EvmGasOraclePollInterval
+// is 0 the prices are refreshed in the background once per interval, and
+// estimates use the last prices fetched. If a refresh fails the last known
+// prices are kept until they are more than twice the interval old. From then
+// on they are reported as stale, and estimates fall back to the configured
+// default prices until a refresh succeeds.
+type pluginEstimator struct {
+	utils.StartStopOnce
+
+	config       Config
+	chainID      *big.Int
+	plugin       GasEstimatorPlugin
+	logger       logger.SugaredLogger
+	pollInterval time.Duration
+
+	mu          sync.RWMutex
+	gasPrice    *assets.Wei
+	fee         *DynamicFee
+	refreshedAt time.Time
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewPluginEstimator returns a new "Plugin" estimator which uses the
+// GasEstimatorPlugin registered for chainID
+func NewPluginEstimator(lggr logger.Logger, cfg Config, chainID *big.Int) Estimator {
+	return &pluginEstimator{
+		config:  cfg,
+		chainID: chainID,
+		logger:  logger.Sugared(lggr.Named("PluginEstimator")),
+		chStop:  make(chan struct{}),
+	}
+}
+
+func (p *pluginEstimator) Start(context.Context) error {
+	return p.StartOnce("PluginEstimator", func() error {
+		p.plugin = getGasEstimatorPlugin(p.chainID)
+		if p.plugin == nil {
+			return errors.Errorf("Plugin estimator requires a gas estimator plugin to be registered for chain %s", p.chainID)
+		}
+		p.pollInterval = p.config.EvmGasOraclePollInterval()
+		p.logger.Infow("Using gas estimator plugin", "plugin", fmt.Sprintf("%T", p.plugin), "pollInterval", p.pollInterval)
+		if p.pollInterval > 0 {
+			p.wg.Add(1)
+			go p.run()
+		}
+		return nil
+	})
+}
+
+func (p *pluginEstimator) Close() error {
+	return p.StopOnce("PluginEstimator", func() error {
+		close(p.chStop)
+		p.wg.Wait()
+		return nil
+	})
+}
+
+func (p *pluginEstimator) run() {
+	defer p.wg.Done()
+
+	ctx, cancel := utils.ContextFromChan(p.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+	for {
+		p.refresh(ctx)
+		select {
+		case <-p.chStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// refresh fetches the prices for the configured transaction type from the
+// plugin. On failure the last known prices are kept.
+func (p *pluginEstimator) refresh(ctx context.Context) {
+	var err error
+	if p.config.EvmEIP1559DynamicFees() {
+		_, err = p.fetchDynamicFee(ctx)
+	} else {
+		_, err = p.fetchLegacyGas(ctx)
+	}
+	if err == nil {
+		promGasOracleStale.WithLabelValues(p.chainID.String()).Set(0)
+		return
+	}
+
+	p.mu.RLock()
+	refreshedAt := p.refreshedAt
+	p.mu.RUnlock()
+	if refreshedAt.IsZero() {
+		p.logger.Errorw("Failed to refresh prices from gas estimator plugin, and no prices have been fetched yet", "err", err)
+		return
+	}
+	age := time.Since(refreshedAt)
+	if age > p.maxPriceAge() {
+		promGasOracleStale.WithLabelValues(p.chainID.String()).Set(1)
+		p.logger.Errorw(fmt.Sprintf("Failed to refresh prices from gas estimator plugin for %s, falling back to the default prices", age), "err", err, "age", age)
+		return
+	}
+	p.logger.Warnw("Failed to refresh prices from gas estimator plugin, using last known prices", "err", err, "age", age)
+}
+
+func (*pluginEstimator) OnNewLongestChain(context.Context, *evmtypes.Head) {}
+
+func (p *pluginEstimator) GetLegacyGas(ctx context.Context, _ []byte, gasLimit uint32, maxGasPriceWei *assets.Wei, _ ...Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	ok := p.IfStarted(func() {
+		gasPrice, err = p.getLegacyGas(ctx)
+	})
+	if !ok {
+		return nil, 0, errors.New("estimator is not started")
+	} else if err != nil {
+		return nil, 0, err
+	}
+	chainSpecificGasLimit = applyMultiplier(gasLimit, p.config.EvmGasLimitMultiplier())
+	gasPrice = assets.WeiMin(gasPrice, maxGasPriceWei)
+	return
+}
+
+func (p *pluginEstimator) BumpLegacyGas(ctx context.Context, originalGasPrice *assets.Wei, originalGasLimit uint32, maxGasPriceWei *assets.Wei, _ []PriorAttempt) (bumpedGasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	var currentGasPrice *assets.Wei
+	ok := p.IfStarted(func() {
+		currentGasPrice, err = p.getLegacyGas(ctx)
+	})
+	if !ok {
+		return nil, 0, errors.New("estimator is not started")
+	} else if err != nil {
+		// Bumping does not depend on the current price, so carry on without it
+		p.logger.Warnw("Failed to get current gas price from plugin, bumping from original price", "err", err)
+	}
+	return BumpLegacyGasPriceOnly(p.config, p.logger, currentGasPrice, originalGasPrice, originalGasLimit, maxGasPriceWei)
+}
+
+func (p *pluginEstimator) GetDynamicFee(ctx context.Context, gasLimit uint32, maxGasPriceWei *assets.Wei) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	ok := p.IfStarted(func() {
+		fee, err = p.getDynamicFee(ctx)
+	})
+	if !ok {
+		return fee, 0, errors.New("estimator is not started")
+	} else if err != nil {
+		return fee, 0, err
+	}
+	chainSpecificGasLimit = applyMultiplier(gasLimit, p.config.EvmGasLimitMultiplier())
+	fee.FeeCap = assets.WeiMin(fee.FeeCap, maxGasPriceWei)
+	fee.TipCap = assets.WeiMin(fee.TipCap, fee.FeeCap)
+	return
+}
+
+func (p *pluginEstimator) BumpDynamicFee(ctx context.Context, originalFee DynamicFee, originalGasLimit uint32, maxGasPriceWei *assets.Wei, _ []PriorAttempt) (bumped DynamicFee, chainSpecificGasLimit uint32, err error) {
+	var currentFee DynamicFee
+	ok := p.IfStarted(func() {
+		currentFee, err = p.getDynamicFee(ctx)
+	})
+	if !ok {
+		return bumped, 0, errors.New("estimator is not started")
+	} else if err != nil {
+		// Bumping does not depend on the current fee, so carry on without it
+		p.logger.Warnw("Failed to get current dynamic fee from plugin, bumping from original fee", "err", err)
+	}
+	return BumpDynamicFeeOnly(p.config, p.logger, currentFee.TipCap, nil, originalFee, originalGasLimit, maxGasPriceWei)
+}
+
+// maxPriceAge is how old the last prices fetched may get before they are
+// stale and no longer used
+func (p *pluginEstimator) maxPriceAge() time.Duration {
+	return 2 * p.pollInterval
+}
+
+// getLegacyGas returns the last legacy gas price fetched, or fetches it from
+// the plugin if it is not polled or has not been fetched yet. Once the last
+// price is stale, it returns EvmGasPriceDefault instead.
+func (p *pluginEstimator) getLegacyGas(ctx context.Context) (*assets.Wei, error) {
+	if p.pollInterval > 0 {
+		p.mu.RLock()
+		gasPrice, refreshedAt := p.gasPrice, p.refreshedAt
+		p.mu.RUnlock()
+		if gasPrice != nil {
+			if age := time.Since(refreshedAt); age > p.maxPriceAge() {
+				p.logger.Warnw("Gas price from gas estimator plugin is stale, using EvmGasPriceDefault", "age", age)
+				return p.config.EvmGasPriceDefault(), nil
+			}
+			return gasPrice, nil
+		}
+	}
+	return p.fetchLegacyGas(ctx)
+}
+
+// getDynamicFee returns the last dynamic fee fetched, or fetches it from the
+// plugin if it is not polled or has not been fetched yet. Once the last fee is
+// stale, it returns EvmGasFeeCapDefault and EvmGasTipCapDefault instead.
+func (p *pluginEstimator) getDynamicFee(ctx context.Context) (DynamicFee, error) {
+	if p.pollInterval > 0 {
+		p.mu.RLock()
+		fee, refreshedAt := p.fee, p.refreshedAt
+		p.mu.RUnlock()
+		if fee != nil {
+			if age := time.Since(refreshedAt); age > p.maxPriceAge() {
+				p.logger.Warnw("Dynamic fee from gas estimator plugin is stale, using EvmGasFeeCapDefault and EvmGasTipCapDefault", "age", age)
+				return DynamicFee{FeeCap: p.config.EvmGasFeeCapDefault(), TipCap: p.config.EvmGasTipCapDefault()}, nil
+			}
+			return *fee, nil
+		}
+	}
+	return p.fetchDynamicFee(ctx)
+}
+
+func (p *pluginEstimator) fetchLegacyGas(ctx context.Context) (*assets.Wei, error) {
+	gasPrice, err := p.plugin.GetLegacyGas(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "gas estimator plugin failed to get legacy gas price")
+	}
+	if gasPrice == nil || gasPrice.ToInt().Sign() <= 0 {
+		return nil, errors.Errorf("gas estimator plugin returned invalid gas price %v: must be positive", gasPrice)
+	}
+	p.mu.Lock()
+	p.gasPrice = gasPrice
+	p.refreshedAt = time.Now()
+	p.mu.Unlock()
+	return gasPrice, nil
+}
+
+func (p *pluginEstimator) fetchDynamicFee(ctx context.Context) (DynamicFee, error) {
+	fee, err := p.plugin.GetDynamicFee(ctx)
+	if err != nil {
+		return DynamicFee{}, errors.Wrap(err, "gas estimator plugin failed to get dynamic fee")
+	}
+	if fee.FeeCap == nil || fee.TipCap == nil {
+		return DynamicFee{}, errors.New("gas estimator plugin returned a dynamic fee without a fee cap or tip cap")
+	}
+	p.mu.Lock()
+	p.fee = &fee
+	p.refreshedAt = time.Now()
+	p.mu.Unlock()
+	return fee, nil
+}
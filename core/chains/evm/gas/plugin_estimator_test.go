@@ -0,0 +1,217 @@
+package gas_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+type fakeGasEstimatorPlugin struct {
+	gasPrice *assets.Wei
+	fee      gas.DynamicFee
+	err      error
+}
+
+func (f *fakeGasEstimatorPlugin) GetLegacyGas(context.Context) (*assets.Wei, error) {
+	return f.gasPrice, f.err
+}
+
+func (f *fakeGasEstimatorPlugin) GetDynamicFee(context.Context) (gas.DynamicFee, error) {
+	return f.fee, f.err
+}
+
+// pollingGasEstimatorPlugin is safe to update while the estimator is
+// polling it
+type pollingGasEstimatorPlugin struct {
+	mu       sync.Mutex
+	gasPrice *assets.Wei
+	err      error
+	calls    int
+}
+
+func (f *pollingGasEstimatorPlugin) set(gasPrice *assets.Wei, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.gasPrice, f.err = gasPrice, err
+}
+
+func (f *pollingGasEstimatorPlugin) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func (f *pollingGasEstimatorPlugin) GetLegacyGas(context.Context) (*assets.Wei, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	return f.gasPrice, f.err
+}
+
+func (f *pollingGasEstimatorPlugin) GetDynamicFee(context.Context) (gas.DynamicFee, error) {
+	return gas.DynamicFee{}, errors.New("not implemented")
+}
+
+func TestPluginEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(1000)
+	const gasLimit uint32 = 80000
+
+	t.Run("Start fails without a registered plugin", func(t *testing.T) {
+		chainID := testutils.NewRandomEVMChainID()
+		p := gas.NewPluginEstimator(logger.TestLogger(t), mocks.NewConfig(t), chainID)
+		assert.EqualError(t, p.Start(testutils.Context(t)), fmt.Sprintf("Plugin estimator requires a gas estimator plugin to be registered for chain %s", chainID))
+	})
+
+	t.Run("calling GetLegacyGas on unstarted estimator returns error", func(t *testing.T) {
+		chainID := testutils.NewRandomEVMChainID()
+		gas.RegisterGasEstimatorPlugin(chainID, &fakeGasEstimatorPlugin{gasPrice: assets.NewWeiI(42)})
+		p := gas.NewPluginEstimator(logger.TestLogger(t), mocks.NewConfig(t), chainID)
+		_, _, err := p.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "estimator is not started")
+	})
+
+	t.Run("GetLegacyGas returns the plugin price, capped to the maximum", func(t *testing.T) {
+		chainID := testutils.NewRandomEVMChainID()
+		plugin := &fakeGasEstimatorPlugin{gasPrice: assets.NewWeiI(42)}
+		gas.RegisterGasEstimatorPlugin(chainID, plugin)
+		config := mocks.NewConfig(t)
+		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
+		config.On("EvmGasOraclePollInterval").Return(time.Duration(0))
+
+		p := gas.NewPluginEstimator(logger.TestLogger(t), config, chainID)
+		require.NoError(t, p.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, p.Close()) })
+
+		gasPrice, chainSpecificGasLimit, err := p.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+		assert.Equal(t, uint32(88000), chainSpecificGasLimit)
+
+		plugin.gasPrice = assets.NewWeiI(2000)
+		gasPrice, _, err = p.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, maxGasPrice, gasPrice)
+	})
+
+	t.Run("GetLegacyGas returns error if the plugin fails or returns an invalid price", func(t *testing.T) {
+		chainID := testutils.NewRandomEVMChainID()
+		plugin := &fakeGasEstimatorPlugin{err: errors.New("kaboom")}
+		gas.RegisterGasEstimatorPlugin(chainID, plugin)
+		config := mocks.NewConfig(t)
+		config.On("EvmGasOraclePollInterval").Return(time.Duration(0))
+
+		p := gas.NewPluginEstimator(logger.TestLogger(t), config, chainID)
+		require.NoError(t, p.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, p.Close()) })
+
+		_, _, err := p.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "gas estimator plugin failed to get legacy gas price: kaboom")
+
+		plugin.err = nil
+		plugin.gasPrice = assets.NewWeiI(0)
+		_, _, err = p.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "gas estimator plugin returned invalid gas price 0: must be positive")
+	})
+
+	t.Run("GetDynamicFee returns the plugin fee, capped to the maximum", func(t *testing.T) {
+		chainID := testutils.NewRandomEVMChainID()
+		plugin := &fakeGasEstimatorPlugin{fee: gas.DynamicFee{FeeCap: assets.NewWeiI(500), TipCap: assets.NewWeiI(10)}}
+		gas.RegisterGasEstimatorPlugin(chainID, plugin)
+		config := mocks.NewConfig(t)
+		config.On("EvmGasLimitMultiplier").Return(float32(1))
+		config.On("EvmGasOraclePollInterval").Return(time.Duration(0))
+
+		p := gas.NewPluginEstimator(logger.TestLogger(t), config, chainID)
+		require.NoError(t, p.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, p.Close()) })
+
+		fee, chainSpecificGasLimit, err := p.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, plugin.fee, fee)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+
+		plugin.fee = gas.DynamicFee{FeeCap: assets.NewWeiI(3000), TipCap: assets.NewWeiI(2000)}
+		fee, _, err = p.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: maxGasPrice, TipCap: maxGasPrice}, fee)
+
+		plugin.fee = gas.DynamicFee{FeeCap: assets.NewWeiI(500)}
+		_, _, err = p.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "gas estimator plugin returned a dynamic fee without a fee cap or tip cap")
+	})
+
+	t.Run("BumpLegacyGas bumps from the original price if the plugin fails", func(t *testing.T) {
+		chainID := testutils.NewRandomEVMChainID()
+		gas.RegisterGasEstimatorPlugin(chainID, &fakeGasEstimatorPlugin{err: errors.New("kaboom")})
+		config := mocks.NewConfig(t)
+		config.On("EvmGasBumpPercent").Return(uint16(10))
+		config.On("EvmGasBumpWei").Return(assets.NewWeiI(150))
+		config.On("EvmGasLimitMultiplier").Return(float32(1))
+		config.On("EvmGasOraclePollInterval").Return(time.Duration(0))
+
+		p := gas.NewPluginEstimator(logger.TestLogger(t), config, chainID)
+		require.NoError(t, p.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, p.Close()) })
+
+		gasPrice, _, err := p.BumpLegacyGas(testutils.Context(t), assets.NewWeiI(100), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(250), gasPrice)
+	})
+	t.Run("GetLegacyGas polls the plugin, keeps the last price if it fails, and falls back to the default price once it is stale", func(t *testing.T) {
+		chainID := testutils.NewRandomEVMChainID()
+		plugin := &pollingGasEstimatorPlugin{gasPrice: assets.NewWeiI(42)}
+		gas.RegisterGasEstimatorPlugin(chainID, plugin)
+		config := mocks.NewConfig(t)
+		config.On("EvmEIP1559DynamicFees").Return(false)
+		config.On("EvmGasLimitMultiplier").Return(float32(1))
+		config.On("EvmGasOraclePollInterval").Return(100 * time.Millisecond)
+		config.On("EvmGasPriceDefault").Return(assets.NewWeiI(20)).Maybe()
+
+		p := gas.NewPluginEstimator(logger.TestLogger(t), config, chainID)
+		require.NoError(t, p.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, p.Close()) })
+
+		require.Eventually(t, func() bool { return plugin.callCount() > 0 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+		gasPrice, _, err := p.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+
+		plugin.set(assets.NewWeiI(43), nil)
+		require.Eventually(t, func() bool {
+			gasPrice, _, err = p.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+			return err == nil && gasPrice.Equal(assets.NewWeiI(43))
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+		plugin.set(nil, errors.New("kaboom"))
+		calls := plugin.callCount()
+		require.Eventually(t, func() bool { return plugin.callCount() > calls }, testutils.WaitTimeout(t), 10*time.Millisecond)
+		gasPrice, _, err = p.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(43), gasPrice)
+
+		require.Eventually(t, func() bool { return plugin.callCount() > calls+2 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+		gasPrice, _, err = p.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(20), gasPrice)
+
+		plugin.set(assets.NewWeiI(44), nil)
+		require.Eventually(t, func() bool {
+			gasPrice, _, err = p.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+			return err == nil && gasPrice.Equal(assets.NewWeiI(44))
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+	})
+}
@@ -0,0 +1,72 @@
+package gas
+
+import (
+	"context"
+	"time"
+
+	"github.com/jpillora/backoff"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+var _ Estimator = &RetryingGasEstimator{}
+
+// RetryingGasEstimator wraps an Estimator and retries failed estimates up to
+// EvmGasEstimatorCheckRetries times, backing off exponentially between
+// attempts, so that a transient RPC failure does not delay transactions. Only
+// GetLegacyGas and GetDynamicFee are retried.
+type RetryingGasEstimator struct {
+	Estimator
+	logger     logger.Logger
+	retries    uint8
+	minBackoff time.Duration
+}
+
+// NewRetryingGasEstimator returns an Estimator which retries the failed
+// estimates of estimator up to retries times
+func NewRetryingGasEstimator(lggr logger.Logger, estimator Estimator, retries uint8) *RetryingGasEstimator {
+	return &RetryingGasEstimator{
+		Estimator:  estimator,
+		logger:     lggr.Named("RetryingGasEstimator"),
+		retries:    retries,
+		minBackoff: 100 * time.Millisecond,
+	}
+}
+
+func (r *RetryingGasEstimator) GetLegacyGas(ctx context.Context, calldata []byte, gasLimit uint32, maxGasPriceWei *assets.Wei, opts ...Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	err = r.retry(ctx, "GetLegacyGas", func() (err error) {
+		gasPrice, chainSpecificGasLimit, err = r.Estimator.GetLegacyGas(ctx, calldata, gasLimit, maxGasPriceWei, opts...)
+		return
+	})
+	return
+}
+
+func (r *RetryingGasEstimator) GetDynamicFee(ctx context.Context, gasLimit uint32, maxGasPriceWei *assets.Wei) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	err = r.retry(ctx, "GetDynamicFee", func() (err error) {
+		fee, chainSpecificGasLimit, err = r.Estimator.GetDynamicFee(ctx, gasLimit, maxGasPriceWei)
+		return
+	})
+	return
+}
+
+// retry calls fn until it succeeds, it has been retried r.retries times, or
+// ctx is done, and returns its last error
+func (r *RetryingGasEstimator) retry(ctx context.Context, method string, fn func() error) error {
+	b := backoff.Backoff{
+		Min: r.minBackoff,
+		Max: 50 * r.minBackoff,
+	}
+	err := fn()
+	for attempt := 1; err != nil && attempt <= int(r.retries); attempt++ {
+		wait := b.Duration()
+		r.logger.Debugw("Gas estimate failed, retrying", "method", method, "attempt", attempt, "retries", r.retries, "wait", wait, "err", err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+		err = fn()
+	}
+	return err
+}
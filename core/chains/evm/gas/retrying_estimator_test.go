@@ -0,0 +1,75 @@
+package gas_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestRetryingGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(1000)
+	const gasLimit uint32 = 21000
+
+	newEstimator := func(t *testing.T, retries uint8) (*mocks.Estimator, *gas.RetryingGasEstimator) {
+		estimator := mocks.NewEstimator(t)
+		r := gas.NewRetryingGasEstimator(logger.TestLogger(t), estimator, retries)
+		gas.SetMinBackoff(r, time.Millisecond)
+		return estimator, r
+	}
+
+	t.Run("GetLegacyGas retries until it succeeds", func(t *testing.T) {
+		estimator, r := newEstimator(t, 3)
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(nil, uint32(0), errors.New("connection refused")).Twice()
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(42), gasLimit, nil).Once()
+
+		gasPrice, limit, err := r.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+		assert.Equal(t, gasLimit, limit)
+	})
+
+	t.Run("GetLegacyGas returns the last error once out of retries", func(t *testing.T) {
+		estimator, r := newEstimator(t, 2)
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(nil, uint32(0), errors.New("connection refused")).Times(3)
+
+		_, _, err := r.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.EqualError(t, err, "connection refused")
+	})
+
+	t.Run("GetDynamicFee retries until it succeeds", func(t *testing.T) {
+		estimator, r := newEstimator(t, 3)
+		fee := gas.DynamicFee{FeeCap: assets.NewWeiI(100), TipCap: assets.NewWeiI(10)}
+		estimator.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(gas.DynamicFee{}, uint32(0), errors.New("connection refused")).Once()
+		estimator.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(fee, gasLimit, nil).Once()
+
+		got, limit, err := r.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, fee, got)
+		assert.Equal(t, gasLimit, limit)
+	})
+
+	t.Run("stops retrying once the context is done", func(t *testing.T) {
+		estimator, r := newEstimator(t, 3)
+		gas.SetMinBackoff(r, time.Hour)
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(nil, uint32(0), errors.New("connection refused")).Run(func(mock.Arguments) {
+			cancel()
+		}).Once()
+
+		_, _, err := r.GetLegacyGas(ctx, nil, gasLimit, maxGasPrice)
+		require.EqualError(t, err, "connection refused")
+	})
+}
@@ -0,0 +1,69 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+var _ Estimator = &RoundingGasEstimator{}
+
+// RoundingGasEstimator wraps an Estimator and rounds the fee cap of EIP-1559
+// transactions up to the nearest multiple of EvmGasFeeCapRounding, for
+// strategies which prefer fees aligned to round values. A fee cap is left
+// unrounded if rounding it would exceed the maximum gas price. Legacy gas
+// prices are not rounded.
+type RoundingGasEstimator struct {
+	Estimator
+	step   *big.Int
+	logger logger.Logger
+}
+
+// NewRoundingGasEstimator returns an Estimator which rounds the fee caps
+// returned by estimator up to a multiple of step
+func NewRoundingGasEstimator(lggr logger.Logger, estimator Estimator, step *assets.Wei) *RoundingGasEstimator {
+	return &RoundingGasEstimator{
+		Estimator: estimator,
+		step:      step.ToInt(),
+		logger:    lggr.Named("RoundingGasEstimator"),
+	}
+}
+
+func (r *RoundingGasEstimator) GetDynamicFee(ctx context.Context, gasLimit uint32, maxGasPriceWei *assets.Wei) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	fee, chainSpecificGasLimit, err = r.Estimator.GetDynamicFee(ctx, gasLimit, maxGasPriceWei)
+	if err != nil {
+		return
+	}
+	fee.FeeCap = r.roundUp(fee.FeeCap, maxGasPriceWei)
+	return
+}
+
+func (r *RoundingGasEstimator) BumpDynamicFee(ctx context.Context, original DynamicFee, gasLimit uint32, maxGasPriceWei *assets.Wei, attempts []PriorAttempt) (bumped DynamicFee, chainSpecificGasLimit uint32, err error) {
+	bumped, chainSpecificGasLimit, err = r.Estimator.BumpDynamicFee(ctx, original, gasLimit, maxGasPriceWei, attempts)
+	if err != nil {
+		return
+	}
+	bumped.FeeCap = r.roundUp(bumped.FeeCap, maxGasPriceWei)
+	return
+}
+
+// roundUp returns feeCap rounded up to the nearest multiple of the step, or
+// feeCap itself if it is already a multiple or the rounded value would exceed
+// maxGasPriceWei
+func (r *RoundingGasEstimator) roundUp(feeCap *assets.Wei, maxGasPriceWei *assets.Wei) *assets.Wei {
+	if feeCap == nil || r.step.Sign() <= 0 {
+		return feeCap
+	}
+	q, m := new(big.Int).DivMod(feeCap.ToInt(), r.step, new(big.Int))
+	if m.Sign() == 0 {
+		return feeCap
+	}
+	rounded := assets.NewWei(q.Add(q, big.NewInt(1)).Mul(q, r.step))
+	if maxGasPriceWei != nil && rounded.Cmp(maxGasPriceWei) > 0 {
+		r.logger.Debugw("Rounded fee cap would exceed the maximum gas price, leaving it unrounded", "feeCap", feeCap, "rounded", rounded, "maxGasPriceWei", maxGasPriceWei)
+		return feeCap
+	}
+	return rounded
+}
@@ -0,0 +1,65 @@
+package gas_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestRoundingGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(990)
+	const gasLimit uint32 = 21000
+
+	tests := []struct {
+		name   string
+		feeCap *assets.Wei
+		want   *assets.Wei
+	}{
+		{"rounds up", assets.NewWeiI(101), assets.NewWeiI(150)},
+		{"keeps multiples", assets.NewWeiI(150), assets.NewWeiI(150)},
+		{"does not exceed the maximum", assets.NewWeiI(960), assets.NewWeiI(960)},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run("GetDynamicFee "+tt.name, func(t *testing.T) {
+			estimator := mocks.NewEstimator(t)
+			r := gas.NewRoundingGasEstimator(logger.TestLogger(t), estimator, assets.NewWeiI(50))
+			estimator.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(gas.DynamicFee{FeeCap: tt.feeCap, TipCap: assets.NewWeiI(7)}, gasLimit, nil)
+
+			fee, _, err := r.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+			require.NoError(t, err)
+			assert.Equal(t, gas.DynamicFee{FeeCap: tt.want, TipCap: assets.NewWeiI(7)}, fee)
+		})
+	}
+
+	t.Run("BumpDynamicFee rounds up", func(t *testing.T) {
+		estimator := mocks.NewEstimator(t)
+		r := gas.NewRoundingGasEstimator(logger.TestLogger(t), estimator, assets.NewWeiI(50))
+		original := gas.DynamicFee{FeeCap: assets.NewWeiI(150), TipCap: assets.NewWeiI(7)}
+		estimator.On("BumpDynamicFee", mock.Anything, original, gasLimit, maxGasPrice, mock.Anything).Return(gas.DynamicFee{FeeCap: assets.NewWeiI(165), TipCap: assets.NewWeiI(8)}, gasLimit, nil)
+
+		fee, _, err := r.BumpDynamicFee(testutils.Context(t), original, gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: assets.NewWeiI(200), TipCap: assets.NewWeiI(8)}, fee)
+	})
+
+	t.Run("GetLegacyGas is not rounded", func(t *testing.T) {
+		estimator := mocks.NewEstimator(t)
+		r := gas.NewRoundingGasEstimator(logger.TestLogger(t), estimator, assets.NewWeiI(50))
+		estimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(101), gasLimit, nil)
+
+		gasPrice, _, err := r.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(101), gasPrice)
+	})
+}
@@ -11,4 +11,9 @@ type Config interface {
 	EvmHeadTrackerHistoryDepth() uint32
 	EvmHeadTrackerMaxBufferSize() uint32
 	EvmHeadTrackerSamplingInterval() time.Duration
+	EvmHeadTrackerCallbackTimeout() time.Duration
+	EvmHeadTrackerBlockDelay() uint16
+	EvmMaxReorgDepth() uint32
+	EvmMaxBlockAge() time.Duration
+	EvmTrackerBlockHistoryFlushInterval() time.Duration
 }
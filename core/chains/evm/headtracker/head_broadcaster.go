@@ -7,14 +7,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+
 	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
-const TrackableCallbackTimeout = 2 * time.Second
-
 type callbackSet map[int]httypes.HeadTrackable
 
 func (set callbackSet) values() []httypes.HeadTrackable {
@@ -26,15 +26,16 @@ func (set callbackSet) values() []httypes.HeadTrackable {
 }
 
 // NewHeadBroadcaster creates a new HeadBroadcaster
-func NewHeadBroadcaster(lggr logger.Logger) httypes.HeadBroadcaster {
+func NewHeadBroadcaster(lggr logger.Logger, config Config) httypes.HeadBroadcaster {
 	return &headBroadcaster{
-		logger:        lggr.Named("HeadBroadcaster"),
-		callbacks:     make(callbackSet),
-		mailbox:       utils.NewMailbox[*evmtypes.Head](1),
-		mutex:         &sync.Mutex{},
-		chClose:       make(chan struct{}),
-		wgDone:        sync.WaitGroup{},
-		StartStopOnce: utils.StartStopOnce{},
+		logger:          lggr.Named("HeadBroadcaster"),
+		callbackTimeout: config.EvmHeadTrackerCallbackTimeout(),
+		callbacks:       make(callbackSet),
+		mailbox:         utils.NewMailbox[*evmtypes.Head](1),
+		mutex:           &sync.Mutex{},
+		chClose:         make(chan struct{}),
+		wgDone:          sync.WaitGroup{},
+		StartStopOnce:   utils.StartStopOnce{},
 	}
 }
 
@@ -46,8 +47,9 @@ type headBroadcaster struct {
 	chClose   chan struct{}
 	wgDone    sync.WaitGroup
 	utils.StartStopOnce
-	latest         *evmtypes.Head
-	lastCallbackID int
+	latest          *evmtypes.Head
+	lastCallbackID  int
+	callbackTimeout time.Duration
 }
 
 func (hb *headBroadcaster) Start(context.Context) error {
@@ -138,10 +140,14 @@ func (hb *headBroadcaster) executeCallbacks() {
 		go func(trackable httypes.HeadTrackable) {
 			defer wg.Done()
 			start := time.Now()
-			cctx, cancel := context.WithTimeout(ctx, TrackableCallbackTimeout)
+			cctx, cancel := context.WithTimeout(ctx, hb.callbackTimeout)
 			defer cancel()
 			trackable.OnNewLongestChain(cctx, head)
 			elapsed := time.Since(start)
+			if errors.Is(cctx.Err(), context.DeadlineExceeded) {
+				hb.logger.Warnw(fmt.Sprintf("Callback exceeded timeout of %s", hb.callbackTimeout),
+					"callbackType", reflect.TypeOf(trackable), "blockNumber", head.Number, "time", elapsed)
+			}
 			hb.logger.Debugw(fmt.Sprintf("Finished callback in %s", elapsed),
 				"callbackType", reflect.TypeOf(trackable), "blockNumber", head.Number, "time", elapsed)
 		}(callback)
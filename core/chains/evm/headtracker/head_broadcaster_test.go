@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
@@ -65,10 +66,10 @@ func TestHeadBroadcaster_Subscribe(t *testing.T) {
 	checker1 := &cltest.MockHeadTrackable{}
 	checker2 := &cltest.MockHeadTrackable{}
 
-	hb := headtracker.NewHeadBroadcaster(logger)
+	hb := headtracker.NewHeadBroadcaster(logger, evmCfg)
 	orm := headtracker.NewORM(db, logger, cfg, *ethClient.ChainID())
 	hs := headtracker.NewHeadSaver(logger, orm, evmCfg)
-	ht := headtracker.NewHeadTracker(logger, ethClient, evmCfg, hb, hs)
+	ht := headtracker.NewHeadTracker(logger, ethClient, evmCfg, hb, hs, orm)
 	require.NoError(t, hb.Start(testutils.Context(t)))
 	require.NoError(t, ht.Start(testutils.Context(t)))
 
@@ -100,7 +101,7 @@ func TestHeadBroadcaster_BroadcastNewLongestChain(t *testing.T) {
 	g := gomega.NewWithT(t)
 
 	lggr := logger.TestLogger(t)
-	broadcaster := headtracker.NewHeadBroadcaster(lggr)
+	broadcaster := headtracker.NewHeadBroadcaster(lggr, evmtest.NewChainScopedConfig(t, configtest.NewTestGeneralConfig(t)))
 
 	err := broadcaster.Start(testutils.Context(t))
 	require.NoError(t, err)
@@ -141,8 +142,12 @@ func TestHeadBroadcaster_BroadcastNewLongestChain(t *testing.T) {
 func TestHeadBroadcaster_TrackableCallbackTimeout(t *testing.T) {
 	t.Parallel()
 
-	lggr := logger.TestLogger(t)
-	broadcaster := headtracker.NewHeadBroadcaster(lggr)
+	const callbackTimeout = 500 * time.Millisecond
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].HeadTracker.CallbackTimeout = models.MustNewDuration(callbackTimeout)
+	})
+	lggr, observed := logger.TestLoggerObserved(t, zapcore.WarnLevel)
+	broadcaster := headtracker.NewHeadBroadcaster(lggr, evmtest.NewChainScopedConfig(t, cfg))
 
 	err := broadcaster.Start(testutils.Context(t))
 	require.NoError(t, err)
@@ -151,8 +156,8 @@ func TestHeadBroadcaster_TrackableCallbackTimeout(t *testing.T) {
 
 	slowAwaiter := cltest.NewAwaiter()
 	fastAwaiter := cltest.NewAwaiter()
-	slow := &sleepySubscriber{awaiter: slowAwaiter, delay: headtracker.TrackableCallbackTimeout * 2}
-	fast := &sleepySubscriber{awaiter: fastAwaiter, delay: headtracker.TrackableCallbackTimeout / 2}
+	slow := &sleepySubscriber{awaiter: slowAwaiter, delay: callbackTimeout * 2}
+	fast := &sleepySubscriber{awaiter: fastAwaiter, delay: callbackTimeout / 2}
 	_, unsubscribe1 := broadcaster.Subscribe(slow)
 	_, unsubscribe2 := broadcaster.Subscribe(fast)
 
@@ -162,6 +167,7 @@ func TestHeadBroadcaster_TrackableCallbackTimeout(t *testing.T) {
 
 	require.True(t, slow.contextDone)
 	require.False(t, fast.contextDone)
+	testutils.WaitForLogMessage(t, observed, "Callback exceeded timeout of 500ms")
 
 	unsubscribe1()
 	unsubscribe2()
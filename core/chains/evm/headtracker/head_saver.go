@@ -2,6 +2,8 @@ package headtracker
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -15,26 +17,62 @@ type headSaver struct {
 	config Config
 	logger logger.Logger
 	heads  Heads
+
+	// pending holds heads waiting to be written to the database when
+	// EvmTrackerBlockHistoryFlushInterval is set
+	mu        sync.Mutex
+	pending   []*evmtypes.Head
+	lastFlush time.Time
 }
 
 func NewHeadSaver(lggr logger.Logger, orm ORM, config Config) httypes.HeadSaver {
 	return &headSaver{
-		orm:    orm,
-		config: config,
-		logger: lggr.Named("HeadSaver"),
-		heads:  NewHeads(),
+		orm:       orm,
+		config:    config,
+		logger:    lggr.Named("HeadSaver"),
+		heads:     NewHeads(),
+		lastFlush: time.Now(),
 	}
 }
 
 func (hs *headSaver) Save(ctx context.Context, head *evmtypes.Head) error {
-	if err := hs.orm.IdempotentInsertHead(ctx, head); err != nil {
-		return err
+	historyDepth := uint(hs.config.EvmHeadTrackerHistoryDepth())
+	interval := hs.config.EvmTrackerBlockHistoryFlushInterval()
+	if interval <= 0 {
+		if err := hs.orm.IdempotentInsertHead(ctx, head); err != nil {
+			return err
+		}
+		hs.heads.AddHeads(historyDepth, head)
+		return hs.orm.TrimOldHeads(ctx, historyDepth)
 	}
 
-	historyDepth := uint(hs.config.EvmHeadTrackerHistoryDepth())
 	hs.heads.AddHeads(historyDepth, head)
 
-	return hs.orm.TrimOldHeads(ctx, historyDepth)
+	hs.mu.Lock()
+	hs.pending = append(hs.pending, head)
+	full := uint(len(hs.pending)) >= historyDepth || time.Since(hs.lastFlush) >= interval
+	hs.mu.Unlock()
+
+	if !full {
+		return nil
+	}
+	return hs.Flush(ctx)
+}
+
+// Flush writes any pending heads to the database in a single insert, and
+// trims old heads. If the insert fails the heads are kept for the next try.
+func (hs *headSaver) Flush(ctx context.Context) error {
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+	hs.lastFlush = time.Now()
+	if len(hs.pending) == 0 {
+		return nil
+	}
+	if err := hs.orm.IdempotentInsertHeads(ctx, hs.pending); err != nil {
+		return err
+	}
+	hs.pending = nil
+	return hs.orm.TrimOldHeads(ctx, uint(hs.config.EvmHeadTrackerHistoryDepth()))
 }
 
 func (hs *headSaver) LoadFromDB(ctx context.Context) (chain *evmtypes.Head, err error) {
@@ -72,6 +110,7 @@ var NullSaver httypes.HeadSaver = &nullSaver{}
 type nullSaver struct{}
 
 func (*nullSaver) Save(ctx context.Context, head *evmtypes.Head) error          { return nil }
+func (*nullSaver) Flush(ctx context.Context) error                              { return nil }
 func (*nullSaver) LoadFromDB(ctx context.Context) (*evmtypes.Head, error)       { return nil, nil }
 func (*nullSaver) LatestHeadFromDB(ctx context.Context) (*evmtypes.Head, error) { return nil, nil }
 func (*nullSaver) LatestChain() *evmtypes.Head                                  { return nil }
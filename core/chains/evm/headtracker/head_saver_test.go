@@ -2,6 +2,7 @@ package headtracker_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -22,6 +23,7 @@ func configureSaver(t *testing.T) (httypes.HeadSaver, headtracker.ORM) {
 	htCfg := htmocks.NewConfig(t)
 	htCfg.On("EvmHeadTrackerHistoryDepth").Return(uint32(6))
 	htCfg.On("EvmFinalityDepth").Return(uint32(1))
+	htCfg.On("EvmTrackerBlockHistoryFlushInterval").Return(time.Duration(0)).Maybe()
 	orm := headtracker.NewORM(db, lggr, cfg, cltest.FixtureChainID)
 	saver := headtracker.NewHeadSaver(lggr, orm, htCfg)
 	return saver, orm
@@ -49,6 +51,47 @@ func TestHeadSaver_Save(t *testing.T) {
 	require.Equal(t, int64(1), latest.Number)
 }
 
+func TestHeadSaver_FlushInterval(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	htCfg := htmocks.NewConfig(t)
+	htCfg.On("EvmHeadTrackerHistoryDepth").Return(uint32(3))
+	htCfg.On("EvmFinalityDepth").Return(uint32(1))
+	htCfg.On("EvmTrackerBlockHistoryFlushInterval").Return(time.Hour)
+	orm := headtracker.NewORM(db, lggr, cfg, cltest.FixtureChainID)
+	saver := headtracker.NewHeadSaver(lggr, orm, htCfg)
+	ctx := testutils.Context(t)
+
+	// Heads are held back until the batch is full...
+	require.NoError(t, saver.Save(ctx, cltest.Head(1)))
+	require.NoError(t, saver.Save(ctx, cltest.Head(2)))
+	latest, err := saver.LatestHeadFromDB(ctx)
+	require.NoError(t, err)
+	require.Nil(t, latest)
+	require.Equal(t, int64(2), saver.LatestChain().Number)
+
+	require.NoError(t, saver.Save(ctx, cltest.Head(3)))
+	heads, err := orm.LatestHeads(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, heads, 3)
+	require.Equal(t, int64(3), heads[0].Number)
+
+	// ...or until they are flushed
+	require.NoError(t, saver.Save(ctx, cltest.Head(4)))
+	latest, err = saver.LatestHeadFromDB(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), latest.Number)
+
+	require.NoError(t, saver.Flush(ctx))
+	heads, err = orm.LatestHeads(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, heads, 3)
+	require.Equal(t, int64(4), heads[0].Number)
+}
+
 func TestHeadSaver_LoadFromDB(t *testing.T) {
 	t.Parallel()
 
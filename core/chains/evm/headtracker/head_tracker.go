@@ -17,6 +17,7 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -30,8 +31,27 @@ var (
 		Name: "head_tracker_very_old_head",
 		Help: "Counter is incremented every time we get a head that is much lower than the highest seen head ('much lower' is defined as a block that is ETH_FINALITY_DEPTH or greater below the highest seen head)",
 	}, []string{"evmChainID"})
+
+	promTooOldHead = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "head_tracker_too_old_head",
+		Help: "Counter is incremented every time we drop a new head whose timestamp is older than ETH_MAX_BLOCK_AGE",
+	}, []string{"evmChainID"})
+
+	promReorgHalted = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_reorg_halted",
+		Help: "Set to 1 while the head tracker is halted after a re-org deeper than ETH_MAX_REORG_DEPTH, until it is acknowledged",
+	}, []string{"evmChainID"})
 )
 
+// ReorgHaltedError is returned by Healthy while the head tracker is halted after
+// a re-org deeper than EvmMaxReorgDepth. Unlike other health check errors, the
+// chain's health check grace period never hides it.
+type ReorgHaltedError struct {
+	Reason string
+}
+
+func (e ReorgHaltedError) Error() string { return e.Reason }
+
 // HeadsBufferSize - The buffer is used when heads sampling is disabled, to ensure the callback is run for every head
 const HeadsBufferSize = 10
 
@@ -47,7 +67,12 @@ type headTracker struct {
 	broadcastMB  *utils.Mailbox[*evmtypes.Head]
 	headListener httypes.HeadListener
 	chStop       chan struct{}
-	wgDone       sync.WaitGroup
+
+	orm     ORM
+	haltMu  sync.RWMutex
+	haltErr error // set while halted after a re-org deeper than EvmMaxReorgDepth, persisted by orm until acknowledged
+
+	wgDone sync.WaitGroup
 	utils.StartStopOnce
 }
 
@@ -58,6 +83,7 @@ func NewHeadTracker(
 	config Config,
 	headBroadcaster httypes.HeadBroadcaster,
 	headSaver httypes.HeadSaver,
+	orm ORM,
 ) httypes.HeadTracker {
 	chStop := make(chan struct{})
 	lggr = lggr.Named("HeadTracker")
@@ -72,6 +98,7 @@ func NewHeadTracker(
 		chStop:          chStop,
 		headListener:    NewHeadListener(lggr, ethClient, config, chStop),
 		headSaver:       headSaver,
+		orm:             orm,
 	}
 }
 
@@ -83,6 +110,9 @@ func (ht *headTracker) Start(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		if err = ht.loadHalt(ctx); err != nil {
+			return err
+		}
 		if latestChain != nil {
 			ht.log.Debugw(
 				fmt.Sprintf("HeadTracker: Tracking logs from last block %v with hash %s", config.FriendlyBigInt(latestChain.ToInt()), latestChain.Hash.Hex()),
@@ -117,6 +147,11 @@ func (ht *headTracker) Start(ctx context.Context) error {
 		go ht.backfillLoop()
 		go ht.broadcastLoop()
 
+		if interval := ht.config.EvmTrackerBlockHistoryFlushInterval(); interval > 0 {
+			ht.wgDone.Add(1)
+			go ht.flushLoop(interval)
+		}
+
 		return nil
 	})
 }
@@ -126,11 +161,16 @@ func (ht *headTracker) Close() error {
 	return ht.StopOnce("HeadTracker", func() error {
 		close(ht.chStop)
 		ht.wgDone.Wait()
-		return nil
+		ctx, cancel := pg.DefaultQueryCtx()
+		defer cancel()
+		return errors.Wrap(ht.headSaver.Flush(ctx), "failed to flush heads")
 	})
 }
 
 func (ht *headTracker) Healthy() error {
+	if err := ht.halted(); err != nil {
+		return err
+	}
 	if !ht.headListener.ReceivingHeads() {
 		return errors.New("Listener is not receiving heads")
 	}
@@ -175,6 +215,15 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 		"parentHeadHash", head.ParentHash,
 	)
 
+	if maxAge := ht.config.EvmMaxBlockAge(); maxAge > 0 {
+		if age := time.Since(head.Timestamp); age > maxAge {
+			promTooOldHead.WithLabelValues(ht.chainID.String()).Inc()
+			ht.log.Warnw(fmt.Sprintf("Dropping head %d with timestamp %s, which is %s old, older than the maximum of %s. Either the RPC node is far behind, or the chain produces blocks with inaccurate timestamps, in which case ETH_MAX_BLOCK_AGE should be disabled.", head.Number, head.Timestamp, age, maxAge),
+				"blockNum", head.Number, "blockHash", head.Hash, "timestamp", head.Timestamp, "age", age, "maxBlockAge", maxAge)
+			return nil
+		}
+	}
+
 	err := ht.headSaver.Save(ctx, head)
 	if ctx.Err() != nil {
 		return nil
@@ -189,8 +238,19 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 		if headWithChain == nil {
 			return errors.Errorf("HeadTracker#handleNewHighestHead headWithChain was unexpectedly nil")
 		}
+		if prevHead != nil {
+			ht.checkReorgDepth(ctx, prevHead, headWithChain)
+		}
+		// keep backfilling while halted, so that the depth of any further
+		// re-org can still be told from the saved heads
 		ht.backfillMB.Deliver(headWithChain)
-		ht.broadcastMB.Deliver(headWithChain)
+		if err := ht.halted(); err != nil {
+			ht.log.Debugw("Halted, not notifying subscribers of new head", "blockNum", head.Number, "err", err)
+			return nil
+		}
+		if delayedHead := ht.delayedHead(headWithChain); delayedHead != nil {
+			ht.broadcastMB.Deliver(delayedHead)
+		}
 	} else if head.Number == prevHead.Number {
 		if head.Hash != prevHead.Hash {
 			ht.log.Debugw("Got duplicate head", "blockNum", head.Number, "head", head.Hash.Hex(), "prevHead", prevHead.Hash.Hex())
@@ -207,6 +267,96 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 	return nil
 }
 
+// loadHalt restores a halt which was not acknowledged before the last shutdown
+func (ht *headTracker) loadHalt(ctx context.Context) error {
+	reason, err := ht.orm.ReorgHalt(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to load re-org halt")
+	}
+	if reason == nil {
+		return nil
+	}
+	ht.haltMu.Lock()
+	ht.haltErr = ReorgHaltedError{Reason: *reason}
+	ht.haltMu.Unlock()
+	promReorgHalted.WithLabelValues(ht.chainID.String()).Set(1)
+	ht.log.Criticalw("The head tracker is still halted after a deep re-org and will not notify any services of new heads until the re-org is acknowledged", "err", *reason)
+	return nil
+}
+
+// checkReorgDepth halts the head tracker if head replaces more than
+// EvmMaxReorgDepth blocks of prevHead's chain
+func (ht *headTracker) checkReorgDepth(ctx context.Context, prevHead, head *evmtypes.Head) {
+	depth := reorgDepth(prevHead, head)
+	maxDepth := ht.config.EvmMaxReorgDepth()
+	if depth <= int64(maxDepth) {
+		return
+	}
+	err := ReorgHaltedError{Reason: fmt.Sprintf("halted after a re-org of %d blocks at block %d, deeper than the maximum of %d: acknowledge the re-org to resume", depth, head.Number, maxDepth)}
+	ht.haltMu.Lock()
+	if ht.haltErr == nil {
+		ht.haltErr = err
+	}
+	ht.haltMu.Unlock()
+	promReorgHalted.WithLabelValues(ht.chainID.String()).Set(1)
+	// halt in memory regardless, the halt is only lost on restart if it cannot be saved
+	if perr := ht.orm.InsertReorgHalt(ctx, err.Error()); perr != nil {
+		ht.log.Errorw("Failed to save re-org halt, it will not survive a restart", "err", perr)
+	}
+	ht.log.Criticalw(fmt.Sprintf("Got a re-org of %d blocks, deeper than the maximum of %d. The head tracker has halted and will not notify any services of new heads until the re-org is acknowledged. Check the chain and your RPC nodes before acknowledging it.", depth, maxDepth),
+		"blockNum", head.Number, "blockHash", head.Hash, "prevBlockNum", prevHead.Number, "prevBlockHash", prevHead.Hash, "depth", depth, "maxReorgDepth", maxDepth)
+}
+
+// reorgDepth returns the number of blocks of prevHead's chain which head's
+// chain replaces, as far back as head's chain is known. It is 0 if head's
+// chain includes prevHead.
+func reorgDepth(prevHead, head *evmtypes.Head) (depth int64) {
+	earliest := head.EarliestInChain()
+	for h := prevHead; h != nil && h.Number >= earliest.Number; h = h.Parent {
+		if head.HashAtHeight(h.Number) == h.Hash {
+			break
+		}
+		depth = prevHead.Number - h.Number + 1
+	}
+	return
+}
+
+func (ht *headTracker) halted() error {
+	ht.haltMu.RLock()
+	defer ht.haltMu.RUnlock()
+	return ht.haltErr
+}
+
+func (ht *headTracker) AcknowledgeReorg(ctx context.Context) error {
+	ht.haltMu.Lock()
+	defer ht.haltMu.Unlock()
+	if ht.haltErr == nil {
+		return errors.New("head tracker is not halted")
+	}
+	if _, err := ht.orm.DeleteReorgHalt(ctx); err != nil {
+		return errors.Wrap(err, "failed to acknowledge re-org")
+	}
+	ht.log.Warnw("Re-org acknowledged, resuming", "err", ht.haltErr)
+	ht.haltErr = nil
+	promReorgHalted.WithLabelValues(ht.chainID.String()).Set(0)
+	return nil
+}
+
+// delayedHead returns the ancestor of head which subscribers should be notified
+// of, trailing EvmHeadTrackerBlockDelay blocks behind it. Returns nil if that
+// ancestor has not been fetched yet.
+func (ht *headTracker) delayedHead(head *evmtypes.Head) *evmtypes.Head {
+	delay := int64(ht.config.EvmHeadTrackerBlockDelay())
+	if delay == 0 {
+		return head
+	}
+	delayedHead := head.HeadAtHeight(head.Number - delay)
+	if delayedHead == nil {
+		ht.log.Debugw("Delayed head is not in the chain yet, skipping broadcast", "blockNum", head.Number, "blockDelay", delay)
+	}
+	return delayedHead
+}
+
 func (ht *headTracker) broadcastLoop() {
 	defer ht.wgDone.Done()
 
@@ -275,6 +425,28 @@ func (ht *headTracker) backfillLoop() {
 	}
 }
 
+// flushLoop writes pending heads to the database every interval, so that
+// they are not held back indefinitely when no new heads arrive
+func (ht *headTracker) flushLoop(interval time.Duration) {
+	defer ht.wgDone.Done()
+
+	ctx, cancel := utils.ContextFromChan(ht.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ht.chStop:
+			return
+		case <-ticker.C:
+			if err := ht.headSaver.Flush(ctx); err != nil && ctx.Err() == nil {
+				ht.log.Errorw("Failed to flush heads to the database", "err", err)
+			}
+		}
+	}
+}
+
 // backfill fetches all missing heads up until the base height
 func (ht *headTracker) backfill(ctx context.Context, head *evmtypes.Head, baseHeight int64) (err error) {
 	if head.Number <= baseHeight {
@@ -344,3 +516,6 @@ func (*nullTracker) SetLogLevel(zapcore.Level)   {}
 func (*nullTracker) Backfill(ctx context.Context, headWithChain *evmtypes.Head, depth uint) (err error) {
 	return nil
 }
+func (*nullTracker) AcknowledgeReorg(ctx context.Context) error {
+	return errors.New("head tracker is not halted")
+}
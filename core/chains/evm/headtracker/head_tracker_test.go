@@ -694,6 +694,219 @@ func TestHeadTracker_SwitchesToLongestChainWithHeadSamplingDisabled(t *testing.T
 	}
 }
 
+func TestHeadTracker_BlockDelay(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].HeadTracker.BlockDelay = ptr[uint16](2)
+		c.EVM[0].HeadTracker.SamplingInterval = models.MustNewDuration(0)
+	})
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	chchHeaders := make(chan evmtest.RawSub[*evmtypes.Head], 1)
+	mockEth := &evmtest.MockEth{EthClient: ethClient}
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).
+		Return(
+			func(ctx context.Context, ch chan<- *evmtypes.Head) ethereum.Subscription {
+				sub := mockEth.NewSub(t)
+				chchHeaders <- evmtest.NewRawSub(ch, sub.Err())
+				return sub
+			},
+			func(ctx context.Context, ch chan<- *evmtypes.Head) error { return nil },
+		)
+
+	blocks := cltest.NewBlocks(t, 6)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(blocks.Head(0), nil)
+
+	broadcast := make(chan int64, 10)
+	checker := htmocks.NewHeadTrackable(t)
+	checker.On("OnNewLongestChain", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			broadcast <- args.Get(1).(*evmtypes.Head).Number
+		}).Return()
+
+	orm := headtracker.NewORM(db, logger, config, cltest.FixtureChainID)
+	ht := createHeadTrackerWithChecker(t, ethClient, evmtest.NewChainScopedConfig(t, config), orm, checker)
+	ht.Start(t)
+
+	headers := <-chchHeaders
+	for i := 1; i < 6; i++ {
+		headers.TrySend(blocks.Head(uint64(i)))
+		time.Sleep(testutils.TestInterval)
+	}
+
+	// subscribers trail the latest head by two blocks
+	for _, expected := range []int64{0, 1, 2, 3} {
+		select {
+		case n := <-broadcast:
+			assert.Equal(t, expected, n)
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatalf("timed out waiting for head %d", expected)
+		}
+	}
+	ht.Stop(t)
+	assert.Equal(t, int64(5), ht.headSaver.LatestChain().Number)
+	assert.Len(t, broadcast, 0)
+}
+
+func TestHeadTracker_MaxReorgDepth(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].HeadTracker.MaxReorgDepth = ptr[uint32](1)
+		c.EVM[0].HeadTracker.SamplingInterval = models.MustNewDuration(0)
+	})
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	chchHeaders := make(chan evmtest.RawSub[*evmtypes.Head], 1)
+	mockEth := &evmtest.MockEth{EthClient: ethClient}
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).
+		Return(
+			func(ctx context.Context, ch chan<- *evmtypes.Head) ethereum.Subscription {
+				sub := mockEth.NewSub(t)
+				chchHeaders <- evmtest.NewRawSub(ch, sub.Err())
+				return sub
+			},
+			func(ctx context.Context, ch chan<- *evmtypes.Head) error { return nil },
+		)
+
+	// Heads are arranged as follows:
+	// 0 -> 1 -> 2 -> 3 -> 4 -> 5
+	//                \
+	//                 -> 3' -> 4' -> 5' -> 6'
+	blocks := cltest.NewBlocks(t, 6)
+	fork := blocks.ForkAt(t, 3, 2)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(blocks.Head(0), nil)
+
+	broadcast := make(chan int64, 10)
+	checker := htmocks.NewHeadTrackable(t)
+	checker.On("OnNewLongestChain", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			broadcast <- args.Get(1).(*evmtypes.Head).Number
+		}).Return()
+
+	orm := headtracker.NewORM(db, logger, config, cltest.FixtureChainID)
+	ht := createHeadTrackerWithChecker(t, ethClient, evmtest.NewChainScopedConfig(t, config), orm, checker)
+	ht.Start(t)
+
+	awaitBroadcast := func(expected int64) {
+		select {
+		case n := <-broadcast:
+			assert.Equal(t, expected, n)
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatalf("timed out waiting for head %d", expected)
+		}
+	}
+
+	headers := <-chchHeaders
+	for i := 1; i < 5; i++ {
+		headers.TrySend(blocks.Head(uint64(i)))
+	}
+	for _, expected := range []int64{0, 1, 2, 3, 4} {
+		awaitBroadcast(expected)
+	}
+	require.Error(t, ht.headTracker.AcknowledgeReorg(testutils.Context(t)))
+
+	// 5' replaces 3 and 4, which is deeper than the maximum of 1
+	headers.TrySend(fork.Head(3))
+	headers.TrySend(fork.Head(4))
+	headers.TrySend(fork.Head(5))
+	g := gomega.NewWithT(t)
+	g.Eventually(ht.headTracker.Healthy).Should(gomega.MatchError(gomega.ContainSubstring("re-org of 2 blocks")))
+	assert.Equal(t, int64(5), ht.headSaver.LatestChain().Number)
+	assert.Len(t, broadcast, 0)
+
+	// the halt survives a restart
+	ht.Stop(t)
+	reason, err := orm.ReorgHalt(testutils.Context(t))
+	require.NoError(t, err)
+	require.NotNil(t, reason)
+	assert.Contains(t, *reason, "re-org of 2 blocks")
+	ht = createHeadTrackerWithChecker(t, ethClient, evmtest.NewChainScopedConfig(t, config), orm, checker)
+	ht.Start(t)
+	defer ht.Stop(t)
+	assert.ErrorContains(t, ht.headTracker.Healthy(), "re-org of 2 blocks")
+
+	require.NoError(t, ht.headTracker.AcknowledgeReorg(testutils.Context(t)))
+	reason, err = orm.ReorgHalt(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Nil(t, reason)
+	headers = <-chchHeaders
+	headers.TrySend(fork.Head(6))
+	awaitBroadcast(6)
+}
+
+func TestHeadTracker_MaxBlockAge(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].HeadTracker.MaxBlockAge = models.MustNewDuration(time.Hour)
+		c.EVM[0].HeadTracker.SamplingInterval = models.MustNewDuration(0)
+	})
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	chchHeaders := make(chan evmtest.RawSub[*evmtypes.Head], 1)
+	mockEth := &evmtest.MockEth{EthClient: ethClient}
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).
+		Return(
+			func(ctx context.Context, ch chan<- *evmtypes.Head) ethereum.Subscription {
+				sub := mockEth.NewSub(t)
+				chchHeaders <- evmtest.NewRawSub(ch, sub.Err())
+				return sub
+			},
+			func(ctx context.Context, ch chan<- *evmtypes.Head) error { return nil },
+		)
+
+	// head 1 is too old, the others are recent
+	blocks := cltest.NewBlocks(t, 3)
+	blocks.Head(0).Timestamp = time.Now()
+	blocks.Head(2).Timestamp = time.Now()
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(blocks.Head(0), nil)
+	// backfilling the parent of head 2 is not subject to the age check
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(1)).Return(blocks.Head(1), nil).Maybe()
+
+	broadcast := make(chan int64, 10)
+	checker := htmocks.NewHeadTrackable(t)
+	checker.On("OnNewLongestChain", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			broadcast <- args.Get(1).(*evmtypes.Head).Number
+		}).Return()
+
+	orm := headtracker.NewORM(db, logger, config, cltest.FixtureChainID)
+	ht := createHeadTrackerWithChecker(t, ethClient, evmtest.NewChainScopedConfig(t, config), orm, checker)
+	ht.Start(t)
+
+	headers := <-chchHeaders
+	headers.TrySend(blocks.Head(1))
+	time.Sleep(testutils.TestInterval)
+	assert.Equal(t, int64(0), ht.headSaver.LatestChain().Number)
+	headers.TrySend(blocks.Head(2))
+
+	for _, expected := range []int64{0, 2} {
+		select {
+		case n := <-broadcast:
+			assert.Equal(t, expected, n)
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatalf("timed out waiting for head %d", expected)
+		}
+	}
+	ht.Stop(t)
+	assert.Equal(t, int64(2), ht.headSaver.LatestChain().Number)
+	assert.Len(t, broadcast, 0)
+}
+
 func TestHeadTracker_Backfill(t *testing.T) {
 	t.Parallel()
 
@@ -961,11 +1174,11 @@ func TestHeadTracker_Backfill(t *testing.T) {
 
 func createHeadTracker(t *testing.T, ethClient evmclient.Client, config headtracker.Config, orm headtracker.ORM) *headTrackerUniverse {
 	lggr := logger.TestLogger(t)
-	hb := headtracker.NewHeadBroadcaster(lggr)
+	hb := headtracker.NewHeadBroadcaster(lggr, config)
 	hs := headtracker.NewHeadSaver(lggr, orm, config)
 	return &headTrackerUniverse{
 		mu:              new(sync.Mutex),
-		headTracker:     headtracker.NewHeadTracker(lggr, ethClient, config, hb, hs),
+		headTracker:     headtracker.NewHeadTracker(lggr, ethClient, config, hb, hs, orm),
 		headBroadcaster: hb,
 		headSaver:       hs,
 	}
@@ -974,9 +1187,9 @@ func createHeadTracker(t *testing.T, ethClient evmclient.Client, config headtrac
 func createHeadTrackerWithNeverSleeper(t *testing.T, ethClient evmclient.Client, cfg config.GeneralConfig, orm headtracker.ORM) *headTrackerUniverse {
 	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
 	lggr := logger.TestLogger(t)
-	hb := headtracker.NewHeadBroadcaster(lggr)
+	hb := headtracker.NewHeadBroadcaster(lggr, evmcfg)
 	hs := headtracker.NewHeadSaver(lggr, orm, evmcfg)
-	ht := headtracker.NewHeadTracker(lggr, ethClient, evmcfg, hb, hs)
+	ht := headtracker.NewHeadTracker(lggr, ethClient, evmcfg, hb, hs, orm)
 	_, err := hs.LoadFromDB(testutils.Context(t))
 	require.NoError(t, err)
 	return &headTrackerUniverse{
@@ -989,10 +1202,10 @@ func createHeadTrackerWithNeverSleeper(t *testing.T, ethClient evmclient.Client,
 
 func createHeadTrackerWithChecker(t *testing.T, ethClient evmclient.Client, config headtracker.Config, orm headtracker.ORM, checker httypes.HeadTrackable) *headTrackerUniverse {
 	lggr := logger.TestLogger(t)
-	hb := headtracker.NewHeadBroadcaster(lggr)
+	hb := headtracker.NewHeadBroadcaster(lggr, config)
 	hs := headtracker.NewHeadSaver(lggr, orm, config)
 	hb.Subscribe(checker)
-	ht := headtracker.NewHeadTracker(lggr, ethClient, config, hb, hs)
+	ht := headtracker.NewHeadTracker(lggr, ethClient, config, hb, hs, orm)
 	return &headTrackerUniverse{
 		mu:              new(sync.Mutex),
 		headTracker:     ht,
@@ -41,6 +41,34 @@ func (_m *Config) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmHeadTrackerBlockDelay provides a mock function with given fields:
+func (_m *Config) EvmHeadTrackerBlockDelay() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// EvmHeadTrackerCallbackTimeout provides a mock function with given fields:
+func (_m *Config) EvmHeadTrackerCallbackTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EvmHeadTrackerHistoryDepth provides a mock function with given fields:
 func (_m *Config) EvmHeadTrackerHistoryDepth() uint32 {
 	ret := _m.Called()
@@ -83,6 +111,48 @@ func (_m *Config) EvmHeadTrackerSamplingInterval() time.Duration {
 	return r0
 }
 
+// EvmMaxBlockAge provides a mock function with given fields:
+func (_m *Config) EvmMaxBlockAge() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmMaxReorgDepth provides a mock function with given fields:
+func (_m *Config) EvmMaxReorgDepth() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmTrackerBlockHistoryFlushInterval provides a mock function with given fields:
+func (_m *Config) EvmTrackerBlockHistoryFlushInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewConfig interface {
 	mock.TestingT
 	Cleanup(func())
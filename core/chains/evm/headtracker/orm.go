@@ -20,6 +20,8 @@ type ORM interface {
 	// IdempotentInsertHead inserts a head only if the hash is new. Will do nothing if hash exists already.
 	// No advisory lock required because this is thread safe.
 	IdempotentInsertHead(ctx context.Context, head *evmtypes.Head) error
+	// IdempotentInsertHeads inserts heads in a single statement, skipping any whose hash exists already.
+	IdempotentInsertHeads(ctx context.Context, heads []*evmtypes.Head) error
 	// TrimOldHeads deletes heads such that only the top N block numbers remain
 	TrimOldHeads(ctx context.Context, n uint) (err error)
 	// LatestHead returns the highest seen head
@@ -28,6 +30,12 @@ type ORM interface {
 	LatestHeads(ctx context.Context, limit uint) (heads []*evmtypes.Head, err error)
 	// HeadByHash fetches the head with the given hash from the db, returns nil if none exists
 	HeadByHash(ctx context.Context, hash common.Hash) (head *evmtypes.Head, err error)
+	// ReorgHalt returns the reason the head tracker halted after a deep re-org, or nil if it is not halted
+	ReorgHalt(ctx context.Context) (reason *string, err error)
+	// InsertReorgHalt records that the head tracker halted for the given reason. Does nothing if it is halted already.
+	InsertReorgHalt(ctx context.Context, reason string) error
+	// DeleteReorgHalt clears the halt once the re-org is acknowledged, returning false if the head tracker was not halted
+	DeleteReorgHalt(ctx context.Context) (deleted bool, err error)
 }
 
 type orm struct {
@@ -50,6 +58,19 @@ func (orm *orm) IdempotentInsertHead(ctx context.Context, head *evmtypes.Head) e
 	return errors.Wrap(err, "IdempotentInsertHead failed to insert head")
 }
 
+func (orm *orm) IdempotentInsertHeads(ctx context.Context, heads []*evmtypes.Head) error {
+	if len(heads) == 0 {
+		return nil
+	}
+	q := orm.q.WithOpts(pg.WithParentCtx(ctx))
+	query := `
+	INSERT INTO evm_heads (hash, number, parent_hash, created_at, timestamp, l1_block_number, evm_chain_id, base_fee_per_gas) VALUES (
+	:hash, :number, :parent_hash, :created_at, :timestamp, :l1_block_number, :evm_chain_id, :base_fee_per_gas)
+	ON CONFLICT (evm_chain_id, hash) DO NOTHING`
+	err := q.ExecQNamed(query, heads)
+	return errors.Wrap(err, "IdempotentInsertHeads failed to insert heads")
+}
+
 func (orm *orm) TrimOldHeads(ctx context.Context, n uint) (err error) {
 	q := orm.q.WithOpts(pg.WithParentCtx(ctx))
 	return q.ExecQ(`
@@ -92,3 +113,30 @@ func (orm *orm) HeadByHash(ctx context.Context, hash common.Hash) (head *evmtype
 	}
 	return head, err
 }
+
+func (orm *orm) ReorgHalt(ctx context.Context) (reason *string, err error) {
+	q := orm.q.WithOpts(pg.WithParentCtx(ctx))
+	reason = new(string)
+	err = q.Get(reason, `SELECT reason FROM evm_head_tracker_halts WHERE evm_chain_id = $1`, orm.chainID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return reason, errors.Wrap(err, "ReorgHalt failed")
+}
+
+func (orm *orm) InsertReorgHalt(ctx context.Context, reason string) error {
+	q := orm.q.WithOpts(pg.WithParentCtx(ctx))
+	err := q.ExecQ(`INSERT INTO evm_head_tracker_halts (evm_chain_id, reason, created_at) VALUES ($1, $2, NOW()) ON CONFLICT (evm_chain_id) DO NOTHING`, orm.chainID, reason)
+	return errors.Wrap(err, "InsertReorgHalt failed")
+}
+
+func (orm *orm) DeleteReorgHalt(ctx context.Context) (deleted bool, err error) {
+	q := orm.q.WithOpts(pg.WithParentCtx(ctx))
+	res, cancel, err := q.ExecQIter(`DELETE FROM evm_head_tracker_halts WHERE evm_chain_id = $1`, orm.chainID)
+	defer cancel()
+	if err != nil {
+		return false, errors.Wrap(err, "DeleteReorgHalt failed")
+	}
+	rowsAffected, err := res.RowsAffected()
+	return rowsAffected > 0, errors.Wrap(err, "DeleteReorgHalt failed to get RowsAffected")
+}
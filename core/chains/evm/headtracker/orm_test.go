@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -43,6 +44,31 @@ func TestORM_IdempotentInsertHead(t *testing.T) {
 	assert.Equal(t, head.Hash, foundHead.Hash)
 }
 
+func TestORM_IdempotentInsertHeads(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	orm := headtracker.NewORM(db, logger, cfg, cltest.FixtureChainID)
+
+	require.NoError(t, orm.IdempotentInsertHeads(testutils.Context(t), nil))
+
+	existing := cltest.Head(1)
+	require.NoError(t, orm.IdempotentInsertHead(testutils.Context(t), existing))
+
+	// Heads which already exist are skipped
+	heads := []*evmtypes.Head{cltest.Head(0), existing, cltest.Head(2)}
+	require.NoError(t, orm.IdempotentInsertHeads(testutils.Context(t), heads))
+
+	found, err := orm.LatestHeads(testutils.Context(t), 10)
+	require.NoError(t, err)
+	require.Len(t, found, 3)
+	assert.Equal(t, heads[2].Hash, found[0].Hash)
+	assert.Equal(t, heads[1].Hash, found[1].Hash)
+	assert.Equal(t, heads[0].Hash, found[2].Hash)
+}
+
 func TestORM_TrimOldHeads(t *testing.T) {
 	t.Parallel()
 
@@ -119,3 +145,36 @@ func TestORM_LatestHeads_NoRows(t *testing.T) {
 	require.Zero(t, len(heads))
 	require.NoError(t, err)
 }
+
+func TestORM_ReorgHalt(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	orm := headtracker.NewORM(db, logger, cfg, cltest.FixtureChainID)
+	ctx := testutils.Context(t)
+
+	reason, err := orm.ReorgHalt(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, reason)
+
+	require.NoError(t, orm.InsertReorgHalt(ctx, "first"))
+	// the first halt is kept
+	require.NoError(t, orm.InsertReorgHalt(ctx, "second"))
+	reason, err = orm.ReorgHalt(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, reason)
+	assert.Equal(t, "first", *reason)
+
+	deleted, err := orm.DeleteReorgHalt(ctx)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	reason, err = orm.ReorgHalt(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, reason)
+
+	deleted, err = orm.DeleteReorgHalt(ctx)
+	require.NoError(t, err)
+	assert.False(t, deleted)
+}
@@ -14,6 +14,9 @@ type HeadSaver interface {
 	// Save updates the latest block number, if indeed the latest, and persists
 	// this number in case of reboot.
 	Save(ctx context.Context, head *evmtypes.Head) error
+	// Flush writes any heads which Save is holding back, when
+	// EvmTrackerBlockHistoryFlushInterval is set, to the database.
+	Flush(ctx context.Context) error
 	// LoadFromDB loads latest EvmHeadTrackerHistoryDepth heads, returns the latest chain.
 	LoadFromDB(ctx context.Context) (*evmtypes.Head, error)
 	// LatestHeadFromDB returns the highest seen head from DB.
@@ -26,11 +29,16 @@ type HeadSaver interface {
 
 // HeadTracker holds and stores the latest block number experienced by this particular node in a thread safe manner.
 // Reconstitutes the last block number from the data store on reboot.
+//
+//go:generate mockery --name HeadTracker --output ../mocks/ --case=underscore
 type HeadTracker interface {
 	services.ServiceCtx
 	// Backfill given a head will fill in any missing heads up to the given depth
 	// (used for testing)
 	Backfill(ctx context.Context, headWithChain *evmtypes.Head, depth uint) (err error)
+	// AcknowledgeReorg resumes a head tracker which halted after a re-org
+	// deeper than EvmMaxReorgDepth. It returns an error if it is not halted.
+	AcknowledgeReorg(ctx context.Context) error
 }
 
 // HeadTrackable represents any object that wishes to respond to ethereum events,
@@ -0,0 +1,11 @@
+package evm
+
+import (
+	"context"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func StartChains(ctx context.Context, lggr logger.Logger, chains []Chain) ([]Chain, error) {
+	return startChains(ctx, lggr, chains)
+}
@@ -31,6 +31,7 @@ type LogPoller interface {
 	Replay(ctx context.Context, fromBlock int64) error
 	RegisterFilter(filter Filter) (int, error)
 	UnregisterFilter(filterID int) error
+	RegisterLogFilterPlugin(plugin EvmLogFilterPlugin)
 	LatestBlock(qopts ...pg.QOpt) (int64, error)
 	GetBlocks(ctx context.Context, numbers []uint64, qopts ...pg.QOpt) ([]LogPollerBlock, error)
 
@@ -48,6 +49,13 @@ type LogPoller interface {
 	LogsDataWordGreaterThan(eventSig common.Hash, address common.Address, wordIndex int, wordValueMin common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error)
 }
 
+// EvmLogFilterPlugin allows logs matched by the registered filters to be
+// dropped before they are saved. FilterLog returns false if the log should be
+// dropped.
+type EvmLogFilterPlugin interface {
+	FilterLog(log types.Log) bool
+}
+
 type Client interface {
 	HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error)
 	HeadByHash(ctx context.Context, n common.Hash) (*evmtypes.Head, error)
@@ -56,6 +64,13 @@ type Client interface {
 	ChainID() *big.Int
 }
 
+// HeadCache provides the most recent heads seen by the node, such as those
+// held in memory by the head tracker.
+type HeadCache interface {
+	// LatestChain returns the highest head seen, with its parents attached, or nil.
+	LatestChain() *evmtypes.Head
+}
+
 var (
 	_                          LogPoller = &logPoller{}
 	ErrReplayAbortedByClient             = errors.New("replay aborted by client")
@@ -72,6 +87,10 @@ type logPoller struct {
 	keepBlocksDepth   int64         // the number of blocks behind the head for which we keep the blocks. Must be greater than finality depth + 1.
 	backfillBatchSize int64         // batch size to use when backfilling finalized logs
 	rpcBatchSize      int64         // batch size to use for fallback RPC calls made in GetBlocks
+	logTTL            time.Duration // how long logs are kept regardless of block depth, zero disables time-based pruning
+	logPruneInterval  time.Duration // how often logs older than logTTL are pruned
+	useBlockHash      bool          // backfill one block at a time, querying logs by block hash (EIP-234)
+	headCache         HeadCache     // source of block hashes for useBlockHash, may be nil
 
 	filterMu        sync.RWMutex
 	currentFilterID int
@@ -80,6 +99,9 @@ type logPoller struct {
 	cachedAddresses []common.Address
 	cachedEventSigs []common.Hash
 
+	pluginMu sync.RWMutex
+	plugins  []EvmLogFilterPlugin
+
 	replayStart    chan ReplayRequest
 	replayComplete chan error
 	ctx            context.Context
@@ -101,7 +123,7 @@ type ReplayRequest struct {
 // - 1 db tx including block write and logs write to logs.
 // How fast that can be done depends largely on network speed and DB, but even for the fastest
 // support chain, polygon, which has 2s block times, we need RPCs roughly with <= 500ms latency
-func NewLogPoller(orm *ORM, ec Client, lggr logger.Logger, pollPeriod time.Duration, finalityDepth int64, backfillBatchSize int64, rpcBatchSize int64, keepBlocksDepth int64) *logPoller {
+func NewLogPoller(orm *ORM, ec Client, lggr logger.Logger, pollPeriod time.Duration, finalityDepth int64, backfillBatchSize int64, rpcBatchSize int64, keepBlocksDepth int64, logTTL time.Duration, logPruneInterval time.Duration, useBlockHash bool, headCache HeadCache) *logPoller {
 	return &logPoller{
 		ec:                ec,
 		orm:               orm,
@@ -114,6 +136,10 @@ func NewLogPoller(orm *ORM, ec Client, lggr logger.Logger, pollPeriod time.Durat
 		backfillBatchSize: backfillBatchSize,
 		rpcBatchSize:      rpcBatchSize,
 		keepBlocksDepth:   keepBlocksDepth,
+		logTTL:            logTTL,
+		logPruneInterval:  logPruneInterval,
+		useBlockHash:      useBlockHash,
+		headCache:         headCache,
 		filters:           make(map[int]Filter),
 		filterDirty:       true, // Always build filter on first call to cache an empty filter if nothing registered yet.
 	}
@@ -173,6 +199,34 @@ func (lp *logPoller) UnregisterFilter(filterID int) error {
 	return nil
 }
 
+// RegisterLogFilterPlugin adds a plugin which is consulted for every log picked up by the log poller.
+// A log is only saved if all registered plugins return true for it.
+func (lp *logPoller) RegisterLogFilterPlugin(plugin EvmLogFilterPlugin) {
+	lp.pluginMu.Lock()
+	defer lp.pluginMu.Unlock()
+	lp.plugins = append(lp.plugins, plugin)
+}
+
+// applyLogFilterPlugins returns the logs accepted by every registered plugin.
+func (lp *logPoller) applyLogFilterPlugins(logs []types.Log) []types.Log {
+	lp.pluginMu.RLock()
+	defer lp.pluginMu.RUnlock()
+	if len(lp.plugins) == 0 {
+		return logs
+	}
+	var filtered []types.Log
+outer:
+	for _, l := range logs {
+		for _, plugin := range lp.plugins {
+			if !plugin.FilterLog(l) {
+				continue outer
+			}
+		}
+		filtered = append(filtered, l)
+	}
+	return filtered
+}
+
 func (lp *logPoller) filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQuery {
 	lp.filterMu.Lock()
 	defer lp.filterMu.Unlock()
@@ -293,6 +347,10 @@ func (lp *logPoller) run() {
 	defer close(lp.done)
 	logPollTick := time.After(0)
 	blockPruneTick := time.After(0)
+	var logPruneTick <-chan time.Time
+	if lp.logTTL > 0 {
+		logPruneTick = time.After(0)
+	}
 	for {
 		select {
 		case <-lp.ctx.Done():
@@ -352,6 +410,11 @@ func (lp *logPoller) run() {
 			if err := lp.pruneOldBlocks(lp.ctx); err != nil {
 				lp.lggr.Errorw("unable to prune old blocks", "err", err)
 			}
+		case <-logPruneTick:
+			logPruneTick = time.After(utils.WithJitter(lp.logPruneInterval))
+			if err := lp.pruneExpiredLogs(lp.ctx); err != nil {
+				lp.lggr.Errorw("unable to prune expired logs", "err", err)
+			}
 		}
 	}
 }
@@ -391,11 +454,18 @@ func convertTopics(topics []common.Hash) [][]byte {
 func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 	for from := start; from <= end; from += lp.backfillBatchSize {
 		to := mathutil.Min(from+lp.backfillBatchSize-1, end)
-		logs, err := lp.ec.FilterLogs(ctx, lp.filter(big.NewInt(from), big.NewInt(to), nil))
+		var logs []types.Log
+		var err error
+		if lp.useBlockHash {
+			logs, err = lp.logsByBlockHash(ctx, from, to)
+		} else {
+			logs, err = lp.ec.FilterLogs(ctx, lp.filter(big.NewInt(from), big.NewInt(to), nil))
+		}
 		if err != nil {
 			lp.lggr.Warnw("Unable query for logs, retrying", "err", err, "from", from, "to", to)
 			return err
 		}
+		logs = lp.applyLogFilterPlugins(logs)
 		if len(logs) == 0 {
 			continue
 		}
@@ -411,6 +481,48 @@ func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 	return nil
 }
 
+// logsByBlockHash fetches the logs for blocks from to to inclusive, one block
+// at a time, using the EIP-234 blockHash filter parameter. Unlike a block
+// number range, this can never return logs from a block which has been
+// re-orged out. Block hashes are taken from the head cache where possible,
+// falling back to the log poller's blocks table and then to RPC.
+func (lp *logPoller) logsByBlockHash(ctx context.Context, from, to int64) ([]types.Log, error) {
+	hashes := make(map[int64]common.Hash)
+	if lp.headCache != nil {
+		for h := lp.headCache.LatestChain(); h != nil && h.Number >= from; h = h.Parent {
+			if h.Number <= to {
+				hashes[h.Number] = h.Hash
+			}
+		}
+	}
+	var missing []uint64
+	for n := from; n <= to; n++ {
+		if _, ok := hashes[n]; !ok {
+			missing = append(missing, uint64(n))
+		}
+	}
+	if len(missing) > 0 {
+		blocks, err := lp.GetBlocks(ctx, missing)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get block hashes")
+		}
+		for _, b := range blocks {
+			hashes[b.BlockNumber] = b.BlockHash
+		}
+	}
+
+	var logs []types.Log
+	for n := from; n <= to; n++ {
+		h := hashes[n]
+		blockLogs, err := lp.ec.FilterLogs(ctx, lp.filter(nil, nil, &h))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get logs for block %d (%s)", n, h)
+		}
+		logs = append(logs, blockLogs...)
+	}
+	return logs, nil
+}
+
 // getCurrentBlockMaybeHandleReorg accepts a block number
 // and will return that block if its parent points to our last saved block.
 // One can optionally pass the block header if it has already been queried to avoid an extra RPC call.
@@ -564,6 +676,7 @@ func (lp *logPoller) pollAndSaveLogs(ctx context.Context, currentBlockNumber int
 			lp.lggr.Warnw("Unable to query for logs, retrying", "err", err, "block", currentBlockNumber)
 			return
 		}
+		logs = lp.applyLogFilterPlugins(logs)
 		lp.lggr.Infow("Unfinalized log query", "logs", len(logs), "currentBlockNumber", currentBlockNumber, "blockHash", currentBlock.Hash)
 		err = lp.orm.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
 			if err2 := lp.orm.InsertBlock(h, currentBlockNumber, pg.WithQueryer(tx)); err2 != nil {
@@ -648,6 +761,18 @@ func (lp *logPoller) pruneOldBlocks(ctx context.Context) error {
 	return lp.orm.DeleteBlocksBefore(latest.Number-lp.keepBlocksDepth, pg.WithParentCtx(ctx))
 }
 
+// pruneExpiredLogs removes logs which were observed more than logTTL ago.
+func (lp *logPoller) pruneExpiredLogs(ctx context.Context) error {
+	n, err := lp.orm.DeleteLogsObservedBefore(time.Now().Add(-lp.logTTL), pg.WithParentCtx(ctx))
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		lp.lggr.Debugw("Pruned expired logs", "count", n, "ttl", lp.logTTL)
+	}
+	return nil
+}
+
 // Logs returns logs matching topics and address (exactly) in the given block range,
 // which are canonical at time of query.
 func (lp *logPoller) Logs(start, end int64, eventSig common.Hash, address common.Address, qopts ...pg.QOpt) ([]Log, error) {
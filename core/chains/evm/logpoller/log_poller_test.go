@@ -8,11 +8,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
 	"github.com/leanovate/gopter/prop"
@@ -22,6 +24,7 @@ import (
 	"github.com/tendermint/tendermint/libs/rand"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/log_emitter"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
@@ -109,7 +112,7 @@ func TestLogPoller_SynchronizedWithGeth(t *testing.T) {
 		}, 10e6)
 		_, _, emitter1, err := log_emitter.DeployLogEmitter(owner, ec)
 		require.NoError(t, err)
-		lp := NewLogPoller(orm, client.NewSimulatedBackendClient(t, ec, chainID), lggr, 15*time.Second, int64(finalityDepth), 3, 2, 1000)
+		lp := NewLogPoller(orm, client.NewSimulatedBackendClient(t, ec, chainID), lggr, 15*time.Second, int64(finalityDepth), 3, 2, 1000, 0, 0, false, nil)
 		for i := 0; i < finalityDepth; i++ { // Have enough blocks that we could reorg the full finalityDepth-1.
 			ec.Commit()
 		}
@@ -419,7 +422,7 @@ func TestLogPoller_Logs(t *testing.T) {
 }
 
 func TestLogPoller_RegisterFilter(t *testing.T) {
-	lp := NewLogPoller(nil, nil, nil, 15*time.Second, 1, 1, 2, 1000)
+	lp := NewLogPoller(nil, nil, nil, 15*time.Second, 1, 1, 2, 1000, 0, 0, false, nil)
 	a1 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbb")
 	a2 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
 
@@ -468,6 +471,75 @@ func TestLogPoller_RegisterFilter(t *testing.T) {
 	assert.Equal(t, id2+1, id3)
 }
 
+type logFilterPluginFunc func(log types.Log) bool
+
+func (f logFilterPluginFunc) FilterLog(log types.Log) bool { return f(log) }
+
+func TestLogPoller_RegisterLogFilterPlugin(t *testing.T) {
+	lp := NewLogPoller(nil, nil, nil, 15*time.Second, 1, 1, 2, 1000, 0, 0, false, nil)
+	a1 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbb")
+	a2 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
+	logs := []types.Log{
+		{Address: a1, BlockNumber: 1},
+		{Address: a2, BlockNumber: 2},
+		{Address: a1, BlockNumber: 3},
+	}
+
+	// All logs pass if no plugins are registered.
+	assert.Equal(t, logs, lp.applyLogFilterPlugins(logs))
+
+	lp.RegisterLogFilterPlugin(logFilterPluginFunc(func(log types.Log) bool { return log.Address == a1 }))
+	assert.Equal(t, []types.Log{logs[0], logs[2]}, lp.applyLogFilterPlugins(logs))
+
+	// A log is dropped if any plugin rejects it.
+	lp.RegisterLogFilterPlugin(logFilterPluginFunc(func(log types.Log) bool { return log.BlockNumber > 1 }))
+	assert.Equal(t, []types.Log{logs[2]}, lp.applyLogFilterPlugins(logs))
+
+	lp.RegisterLogFilterPlugin(logFilterPluginFunc(func(types.Log) bool { return false }))
+	assert.Empty(t, lp.applyLogFilterPlugins(logs))
+}
+
+type filterLogsClient struct {
+	Client
+	queries []ethereum.FilterQuery
+}
+
+func (c *filterLogsClient) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	c.queries = append(c.queries, q)
+	return []types.Log{{BlockHash: *q.BlockHash}}, nil
+}
+
+type headCacheFunc func() *evmtypes.Head
+
+func (f headCacheFunc) LatestChain() *evmtypes.Head { return f() }
+
+func TestLogPoller_LogsByBlockHash(t *testing.T) {
+	var head *evmtypes.Head
+	for i := int64(1); i <= 5; i++ {
+		head = &evmtypes.Head{Number: i, Hash: utils.NewHash(), Parent: head}
+	}
+	hashes := map[int64]common.Hash{}
+	for h := head; h != nil; h = h.Parent {
+		hashes[h.Number] = h.Hash
+	}
+
+	ec := &filterLogsClient{}
+	lp := NewLogPoller(nil, ec, logger.TestLogger(t), 15*time.Second, 1, 1, 2, 1000, 0, 0, true, headCacheFunc(func() *evmtypes.Head { return head }))
+	logs, err := lp.logsByBlockHash(testutils.Context(t), 2, 4)
+	require.NoError(t, err)
+
+	// One query per block, by hash rather than by number
+	require.Len(t, ec.queries, 3)
+	for i, q := range ec.queries {
+		assert.Nil(t, q.FromBlock)
+		assert.Nil(t, q.ToBlock)
+		assert.Equal(t, hashes[int64(i+2)], *q.BlockHash)
+	}
+	require.Len(t, logs, 3)
+	assert.Equal(t, hashes[2], logs[0].BlockHash)
+	assert.Equal(t, hashes[4], logs[2].BlockHash)
+}
+
 func TestLogPoller_GetBlocks(t *testing.T) {
 	th := SetupTH(t, 2, 3, 2)
 
@@ -586,7 +658,7 @@ func TestGetReplayFromBlock(t *testing.T) {
 
 func benchmarkFilter(b *testing.B, nFilters, nAddresses, nEvents int) {
 	lggr := logger.TestLogger(b)
-	lp := NewLogPoller(nil, nil, lggr, 1*time.Hour, 2, 3, 2, 1000)
+	lp := NewLogPoller(nil, nil, lggr, 1*time.Hour, 2, 3, 2, 1000, 0, 0, false, nil)
 	for i := 0; i < nFilters; i++ {
 		var addresses []common.Address
 		var events []common.Hash
@@ -409,6 +409,11 @@ func (_m *LogPoller) RegisterFilter(filter logpoller.Filter) (int, error) {
 	return r0, r1
 }
 
+// RegisterLogFilterPlugin provides a mock function with given fields: plugin
+func (_m *LogPoller) RegisterLogFilterPlugin(plugin logpoller.EvmLogFilterPlugin) {
+	_m.Called(plugin)
+}
+
 // Replay provides a mock function with given fields: ctx, fromBlock
 func (_m *LogPoller) Replay(ctx context.Context, fromBlock int64) error {
 	ret := _m.Called(ctx, fromBlock)
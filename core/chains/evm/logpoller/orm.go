@@ -3,6 +3,7 @@ package logpoller
 import (
 	"database/sql"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/lib/pq"
@@ -19,10 +20,11 @@ type ORM struct {
 	q       pg.Q
 }
 
-// NewORM creates an ORM scoped to chainID.
-func NewORM(chainID *big.Int, db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) *ORM {
+// NewORM creates an ORM scoped to chainID. qopts apply to every query, e.g. to
+// set a chain specific query timeout.
+func NewORM(chainID *big.Int, db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, qopts ...pg.QOpt) *ORM {
 	namedLogger := lggr.Named("ORM")
-	q := pg.NewQ(db, namedLogger, cfg)
+	q := pg.NewQ(db, namedLogger, cfg, qopts...)
 	return &ORM{
 		chainID: chainID,
 		q:       q,
@@ -96,6 +98,16 @@ func (o *ORM) DeleteLogsAfter(start int64, qopts ...pg.QOpt) error {
 	return q.ExecQ(`DELETE FROM logs WHERE block_number >= $1 AND evm_chain_id = $2`, start, utils.NewBig(o.chainID))
 }
 
+// DeleteLogsObservedBefore deletes all logs which were inserted before t, and returns the number deleted.
+func (o *ORM) DeleteLogsObservedBefore(t time.Time, qopts ...pg.QOpt) (int64, error) {
+	q := o.q.WithOpts(qopts...)
+	res, err := q.Exec(`DELETE FROM logs WHERE created_at < $1 AND evm_chain_id = $2`, t, utils.NewBig(o.chainID))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
 // InsertLogs is idempotent to support replays.
 func (o *ORM) InsertLogs(logs []Log, qopts ...pg.QOpt) error {
 	for _, log := range logs {
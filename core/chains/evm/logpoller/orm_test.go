@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -515,6 +516,31 @@ func TestORM_DeleteBlocksBefore(t *testing.T) {
 	require.Equal(t, err, sql.ErrNoRows)
 }
 
+func TestORM_DeleteLogsObservedBefore(t *testing.T) {
+	o1, o2 := setup(t)
+	eventSig := common.HexToHash("0x1599")
+	addr := common.HexToAddress("0x1234")
+	insertLogsTopicValueRange(t, o1, addr, 1, eventSig, 1, 3)
+	insertLogsTopicValueRange(t, o1, addr, 2, eventSig, 4, 4)
+	insertLogsTopicValueRange(t, o2, addr, 1, eventSig, 1, 3)
+	// Backdate the logs in block 1 on both chains.
+	_, err := o1.q.Exec(`UPDATE logs SET created_at = NOW() - interval '2 hours' WHERE block_number = 1`)
+	require.NoError(t, err)
+
+	n, err := o1.DeleteLogsObservedBefore(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	lgs, err := o1.selectLogsByBlockRange(1, 2)
+	require.NoError(t, err)
+	require.Len(t, lgs, 1)
+	assert.Equal(t, int64(2), lgs[0].BlockNumber)
+	// Other chains are unaffected.
+	lgs, err = o2.selectLogsByBlockRange(1, 2)
+	require.NoError(t, err)
+	assert.Len(t, lgs, 3)
+}
+
 func BenchmarkLogs(b *testing.B) {
 	o, _ := setup(b)
 	var lgs []Log
@@ -388,6 +388,20 @@ func (_m *Node) PendingNonceAt(ctx context.Context, account common.Address) (uin
 	return r0, r1
 }
 
+// Priority provides a mock function with given fields:
+func (_m *Node) Priority() uint8 {
+	ret := _m.Called()
+
+	var r0 uint8
+	if rf, ok := ret.Get(0).(func() uint8); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint8)
+	}
+
+	return r0
+}
+
 // SendTransaction provides a mock function with given fields: ctx, tx
 func (_m *Node) SendTransaction(ctx context.Context, tx *types.Transaction) error {
 	ret := _m.Called(ctx, tx)
@@ -0,0 +1,214 @@
+package monitor
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// WalletFunderConfig is the configuration used by the WalletFunder
+type WalletFunderConfig interface {
+	EvmAutoWalletFundThreshold() *assets.Eth
+	EvmAutoWalletFundAmount() *assets.Eth
+	EvmAutoWalletFundTreasuryAddress() gethCommon.Address
+	EvmWalletBalancePollInterval() time.Duration
+	EvmMaxOutstandingBalance() *assets.Eth
+	EvmGasLimitTransfer() uint32
+}
+
+// walletFunder polls the balance of each key, and tops up any key whose
+// balance has fallen below EvmAutoWalletFundThreshold by sending
+// EvmAutoWalletFundAmount from the treasury key. A key is never topped up
+// beyond EvmMaxOutstandingBalance.
+type walletFunder struct {
+	utils.StartStopOnce
+	logger      logger.Logger
+	config      WalletFunderConfig
+	ethClient   evmclient.Client
+	ethKeyStore keystore.Eth
+	txm         txmgr.TxManager
+	txORM       txmgr.ORM
+	chainID     *big.Int
+	treasury    gethCommon.Address
+
+	// funding holds the ID of the top up eth_tx sent to each key which has not
+	// yet landed. A key is not topped up again until its balance is seen at or
+	// above the threshold, or its top up is confirmed or fails. Only accessed
+	// from the run goroutine.
+	funding map[gethCommon.Address]int64
+
+	chStop chan struct{}
+	chDone chan struct{}
+}
+
+// NewWalletFunder returns a new service which automatically funds keys from
+// the configured treasury key
+func NewWalletFunder(ethClient evmclient.Client, ethKeyStore keystore.Eth, txm txmgr.TxManager, txORM txmgr.ORM, config WalletFunderConfig, lggr logger.Logger) services.ServiceCtx {
+	return &walletFunder{
+		logger:      lggr.Named("WalletFunder"),
+		config:      config,
+		ethClient:   ethClient,
+		ethKeyStore: ethKeyStore,
+		txm:         txm,
+		txORM:       txORM,
+		chainID:     ethClient.ChainID(),
+		treasury:    config.EvmAutoWalletFundTreasuryAddress(),
+		funding:     make(map[gethCommon.Address]int64),
+		chStop:      make(chan struct{}),
+		chDone:      make(chan struct{}),
+	}
+}
+
+func (wf *walletFunder) Start(context.Context) error {
+	return wf.StartOnce("WalletFunder", func() error {
+		if wf.treasury == (gethCommon.Address{}) {
+			return errors.New("automatic wallet funding requires a treasury address to be configured")
+		}
+		if wf.config.EvmWalletBalancePollInterval() <= 0 {
+			return errors.New("automatic wallet funding requires a positive balance poll interval")
+		}
+		if err := wf.ethKeyStore.CheckEnabled(wf.treasury, wf.chainID); err != nil {
+			return errors.Wrapf(err, "treasury %s cannot be used for automatic wallet funding", wf.treasury.Hex())
+		}
+		wf.logger.Infow("Automatically funding keys from treasury", "treasury", wf.treasury,
+			"threshold", wf.config.EvmAutoWalletFundThreshold(), "amount", wf.config.EvmAutoWalletFundAmount())
+		go wf.run()
+		return nil
+	})
+}
+
+func (wf *walletFunder) Close() error {
+	return wf.StopOnce("WalletFunder", func() error {
+		close(wf.chStop)
+		<-wf.chDone
+		return nil
+	})
+}
+
+func (wf *walletFunder) run() {
+	defer close(wf.chDone)
+
+	ctx, cancel := utils.ContextFromChan(wf.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(wf.config.EvmWalletBalancePollInterval())
+	defer ticker.Stop()
+	for {
+		wf.fundKeys(ctx)
+		select {
+		case <-wf.chStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (wf *walletFunder) fundKeys(ctx context.Context) {
+	keys, err := wf.ethKeyStore.EnabledKeysForChain(wf.chainID)
+	if err != nil {
+		wf.logger.Errorw("Failed to get keys", "err", err)
+		return
+	}
+
+	if err = wf.ethKeyStore.CheckEnabled(wf.treasury, wf.chainID); err != nil {
+		wf.logger.Errorw(fmt.Sprintf("Treasury %s cannot be used for automatic wallet funding", wf.treasury.Hex()), "err", err)
+		return
+	}
+
+	amount := wf.config.EvmAutoWalletFundAmount()
+	treasuryBal, err := wf.getBalance(ctx, wf.treasury)
+	if err != nil {
+		wf.logger.Errorw(fmt.Sprintf("Failed to get balance for treasury %s", wf.treasury.Hex()), "err", err)
+		return
+	}
+
+	threshold := wf.config.EvmAutoWalletFundThreshold()
+	maxBal := wf.config.EvmMaxOutstandingBalance()
+	for _, k := range keys {
+		if k.Address == wf.treasury {
+			continue
+		}
+		bal, err := wf.getBalance(ctx, k.Address)
+		if err != nil {
+			wf.logger.Errorw(fmt.Sprintf("Failed to get balance for key %s", k.Address.Hex()), "err", err, "address", k.Address)
+			continue
+		}
+		if bal.Cmp(threshold) >= 0 {
+			delete(wf.funding, k.Address)
+			continue
+		}
+		lggr := wf.logger.With("address", k.Address, "balance", bal, "threshold", threshold, "amount", amount)
+		if etxID, ok := wf.funding[k.Address]; ok {
+			if wf.topUpInFlight(lggr, etxID) {
+				lggr.Debugw("Key is below the funding threshold, but a top up is already in flight", "ethTxID", etxID)
+				continue
+			}
+			delete(wf.funding, k.Address)
+		}
+		if maxBal != nil && new(big.Int).Add(bal.ToInt(), amount.ToInt()).Cmp(maxBal.ToInt()) > 0 {
+			lggr.Errorw(fmt.Sprintf("Key %s is below the funding threshold, but topping it up would exceed the maximum outstanding balance", k.Address.Hex()),
+				"maxOutstandingBalance", maxBal)
+			continue
+		}
+		if treasuryBal.Cmp(amount) < 0 {
+			lggr.Errorw(fmt.Sprintf("Key %s is below the funding threshold, but treasury %s has insufficient balance to top it up", k.Address.Hex(), wf.treasury.Hex()),
+				"treasuryBalance", treasuryBal)
+			continue
+		}
+		etx, err := wf.txm.SendEther(wf.chainID, wf.treasury, k.Address, *amount, wf.config.EvmGasLimitTransfer())
+		if err != nil {
+			lggr.Errorw(fmt.Sprintf("Failed to top up key %s", k.Address.Hex()), "err", err)
+			continue
+		}
+		wf.funding[k.Address] = etx.ID
+		treasuryBal = (*assets.Eth)(new(big.Int).Sub(treasuryBal.ToInt(), amount.ToInt()))
+		lggr.Infow(fmt.Sprintf("Topping up key %s from treasury %s", k.Address.Hex(), wf.treasury.Hex()), "ethTxID", etx.ID)
+	}
+}
+
+// topUpInFlight returns false once the top up eth_tx is confirmed, has failed,
+// or no longer exists, so that the key may be topped up again.
+func (wf *walletFunder) topUpInFlight(lggr logger.Logger, etxID int64) bool {
+	etx, err := wf.txORM.FindEthTxWithAttempts(etxID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false
+	} else if err != nil {
+		lggr.Errorw("Failed to load top up transaction", "ethTxID", etxID, "err", err)
+		return true
+	}
+	switch etx.State {
+	case txmgr.EthTxConfirmed:
+		return false
+	case txmgr.EthTxFatalError:
+		lggr.Warnw("Top up transaction failed", "ethTxID", etxID, "err", etx.Error.String)
+		return false
+	default:
+		return true
+	}
+}
+
+func (wf *walletFunder) getBalance(ctx context.Context, address gethCommon.Address) (*assets.Eth, error) {
+	ctx, cancel := context.WithTimeout(ctx, ethFetchTimeout)
+	defer cancel()
+
+	bal, err := wf.ethClient.BalanceAt(ctx, address, nil)
+	if err != nil {
+		return nil, err
+	} else if bal == nil {
+		return nil, errors.New("invariant violation, bal may not be nil")
+	}
+	return (*assets.Eth)(bal), nil
+}
@@ -0,0 +1,133 @@
+package monitor_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+type walletFunderConfig struct {
+	treasury gethCommon.Address
+	maxBal   *assets.Eth
+}
+
+func (walletFunderConfig) EvmAutoWalletFundThreshold() *assets.Eth { return assets.NewEth(100) }
+func (walletFunderConfig) EvmAutoWalletFundAmount() *assets.Eth    { return assets.NewEth(500) }
+func (c walletFunderConfig) EvmAutoWalletFundTreasuryAddress() gethCommon.Address {
+	return c.treasury
+}
+func (walletFunderConfig) EvmWalletBalancePollInterval() time.Duration { return 100 * time.Millisecond }
+func (c walletFunderConfig) EvmMaxOutstandingBalance() *assets.Eth     { return c.maxBal }
+func (walletFunderConfig) EvmGasLimitTransfer() uint32                 { return 21000 }
+
+func TestWalletFunder(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, nil)
+
+	t.Run("tops up keys below the threshold once", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		ethClient := newEthClientMock(t)
+		txm := txmmocks.NewTxManager(t)
+		_, treasury := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+		_, lowAddr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+		_, highAddr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+		var polls atomic.Int32
+		ethClient.On("BalanceAt", mock.Anything, treasury, nilBigInt).Run(func(mock.Arguments) { polls.Inc() }).Return(big.NewInt(10_000), nil)
+		ethClient.On("BalanceAt", mock.Anything, lowAddr, nilBigInt).Return(big.NewInt(99), nil)
+		ethClient.On("BalanceAt", mock.Anything, highAddr, nilBigInt).Return(big.NewInt(100), nil)
+		txm.On("SendEther", big.NewInt(0), treasury, lowAddr, assets.NewEthValue(500), uint32(21000)).Return(txmgr.EthTx{ID: 1}, nil).Once()
+		txORM := txmmocks.NewORM(t)
+		txORM.On("FindEthTxWithAttempts", int64(1)).Return(txmgr.EthTx{ID: 1, State: txmgr.EthTxUnconfirmed}, nil)
+
+		wf := monitor.NewWalletFunder(ethClient, ethKeyStore, txm, txORM, walletFunderConfig{treasury: treasury}, logger.TestLogger(t))
+		require.NoError(t, wf.Start(testutils.Context(t)))
+
+		// wait for a few polls to ensure the in flight top up is not repeated
+		gomega.NewWithT(t).Eventually(polls.Load).Should(gomega.BeNumerically(">=", 3))
+		require.NoError(t, wf.Close())
+
+		txm.AssertNumberOfCalls(t, "SendEther", 1)
+	})
+
+	t.Run("tops up keys again once the previous top up has failed", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		ethClient := newEthClientMock(t)
+		txm := txmmocks.NewTxManager(t)
+		_, treasury := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+		_, lowAddr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+		var sends atomic.Int32
+		ethClient.On("BalanceAt", mock.Anything, treasury, nilBigInt).Return(big.NewInt(10_000), nil)
+		ethClient.On("BalanceAt", mock.Anything, lowAddr, nilBigInt).Return(big.NewInt(99), nil)
+		txm.On("SendEther", big.NewInt(0), treasury, lowAddr, assets.NewEthValue(500), uint32(21000)).Run(func(mock.Arguments) { sends.Inc() }).Return(txmgr.EthTx{ID: 1}, nil)
+		txORM := txmmocks.NewORM(t)
+		txORM.On("FindEthTxWithAttempts", int64(1)).Return(txmgr.EthTx{ID: 1, State: txmgr.EthTxFatalError}, nil)
+
+		wf := monitor.NewWalletFunder(ethClient, ethKeyStore, txm, txORM, walletFunderConfig{treasury: treasury}, logger.TestLogger(t))
+		require.NoError(t, wf.Start(testutils.Context(t)))
+
+		gomega.NewWithT(t).Eventually(sends.Load).Should(gomega.BeNumerically(">=", 2))
+		require.NoError(t, wf.Close())
+	})
+
+	t.Run("does not top up keys beyond the maximum outstanding balance", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		ethClient := newEthClientMock(t)
+		txm := txmmocks.NewTxManager(t)
+		_, treasury := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+		_, cappedAddr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+		_, lowAddr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+		var polls atomic.Int32
+		ethClient.On("BalanceAt", mock.Anything, treasury, nilBigInt).Run(func(mock.Arguments) { polls.Inc() }).Return(big.NewInt(10_000), nil)
+		ethClient.On("BalanceAt", mock.Anything, cappedAddr, nilBigInt).Return(big.NewInt(99), nil)
+		ethClient.On("BalanceAt", mock.Anything, lowAddr, nilBigInt).Return(big.NewInt(0), nil)
+		txm.On("SendEther", big.NewInt(0), treasury, lowAddr, assets.NewEthValue(500), uint32(21000)).Return(txmgr.EthTx{ID: 1}, nil).Once()
+
+		txORM := txmmocks.NewORM(t)
+		txORM.On("FindEthTxWithAttempts", int64(1)).Return(txmgr.EthTx{ID: 1, State: txmgr.EthTxUnconfirmed}, nil).Maybe()
+
+		config := walletFunderConfig{treasury: treasury, maxBal: assets.NewEth(550)}
+		wf := monitor.NewWalletFunder(ethClient, ethKeyStore, txm, txORM, config, logger.TestLogger(t))
+		require.NoError(t, wf.Start(testutils.Context(t)))
+
+		gomega.NewWithT(t).Eventually(polls.Load).Should(gomega.BeNumerically(">=", 2))
+		require.NoError(t, wf.Close())
+
+		txm.AssertNumberOfCalls(t, "SendEther", 1)
+	})
+
+	t.Run("does not start if the treasury is not a key", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		ethClient := newEthClientMock(t)
+		treasury := testutils.NewAddress()
+
+		wf := monitor.NewWalletFunder(ethClient, ethKeyStore, txmmocks.NewTxManager(t), txmmocks.NewORM(t), walletFunderConfig{treasury: treasury}, logger.TestLogger(t))
+		err := wf.Start(testutils.Context(t))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be used for automatic wallet funding")
+	})
+}
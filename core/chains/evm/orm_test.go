@@ -134,3 +134,34 @@ func Test_EVMORM_Node(t *testing.T) {
 
 	require.Equal(t, node, actual)
 }
+
+func Test_EVMORM_GetAuditLog(t *testing.T) {
+	_, orm := setupORM(t)
+	chain := mustInsertChain(t, orm)
+
+	require.NoError(t, orm.StoreString(chain.ID, "EvmGasPriceDefault", "1000"))
+	require.NoError(t, orm.StoreString(chain.ID, "EvmGasPriceDefault", "2000"))
+	require.NoError(t, orm.Clear(chain.ID, "EvmGasPriceDefault"))
+	require.NoError(t, orm.StoreString(chain.ID, "EvmGasLimitDefault", "500000"))
+
+	entries, err := orm.GetAuditLog(chain.ID, "EvmGasPriceDefault")
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	for _, e := range entries {
+		assert.Equal(t, chain.ID, e.ChainID)
+		assert.Equal(t, "EvmGasPriceDefault", e.Key)
+		assert.False(t, e.ChangedAt.IsZero())
+	}
+	assert.Equal(t, null.String{}, entries[0].OldValue)
+	assert.Equal(t, null.StringFrom("1000"), entries[0].NewValue)
+	assert.Equal(t, null.StringFrom("1000"), entries[1].OldValue)
+	assert.Equal(t, null.StringFrom("2000"), entries[1].NewValue)
+	assert.Equal(t, null.StringFrom("2000"), entries[2].OldValue)
+	assert.Equal(t, null.String{}, entries[2].NewValue)
+
+	t.Run("missing chain", func(t *testing.T) {
+		err := orm.StoreString(*utils.NewBigI(12345), "EvmGasPriceDefault", "1000")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no chain found with ID 12345")
+	})
+}
@@ -23,7 +23,7 @@ func (c *ChainKeyStore) NewDynamicFeeAttempt(etx EthTx, fee gas.DynamicFee, gasL
 	}
 	d := newDynamicFeeTransaction(
 		uint64(*etx.Nonce),
-		etx.ToAddress,
+		etx.txToAddress(),
 		&etx.Value,
 		gasLimit,
 		&c.chainID,
@@ -83,14 +83,14 @@ func validateDynamicFeeGas(cfg Config, fee gas.DynamicFee, gasLimit uint32, etx
 	return nil
 }
 
-func newDynamicFeeTransaction(nonce uint64, to common.Address, value *assets.Eth, gasLimit uint32, chainID *big.Int, gasTipCap, gasFeeCap *assets.Wei, data []byte, accessList types.AccessList) types.DynamicFeeTx {
+func newDynamicFeeTransaction(nonce uint64, to *common.Address, value *assets.Eth, gasLimit uint32, chainID *big.Int, gasTipCap, gasFeeCap *assets.Wei, data []byte, accessList types.AccessList) types.DynamicFeeTx {
 	return types.DynamicFeeTx{
 		ChainID:    chainID,
 		Nonce:      nonce,
 		GasTipCap:  gasTipCap.ToInt(),
 		GasFeeCap:  gasFeeCap.ToInt(),
 		Gas:        uint64(gasLimit),
-		To:         &to,
+		To:         to,
 		Value:      value.ToInt(),
 		Data:       data,
 		AccessList: accessList,
@@ -98,13 +98,16 @@ func newDynamicFeeTransaction(nonce uint64, to common.Address, value *assets.Eth
 }
 
 func (c *ChainKeyStore) NewLegacyAttempt(etx EthTx, gasPrice *assets.Wei, gasLimit uint32) (attempt EthTxAttempt, err error) {
+	if c.config.EvmMinimumFeeMarket() {
+		return attempt, errors.New("cannot create legacy tx attempt: EIP-1559 transactions are required by EvmMinimumFeeMarket, check that the RPC endpoint supports EIP-1559")
+	}
 	if err = validateLegacyGas(c.config, gasPrice, gasLimit, etx); err != nil {
 		return attempt, errors.Wrap(err, "error validating gas")
 	}
 
 	tx := newLegacyTransaction(
 		uint64(*etx.Nonce),
-		etx.ToAddress,
+		etx.txToAddress(),
 		etx.Value.ToInt(),
 		gasLimit,
 		gasPrice,
@@ -161,10 +164,10 @@ func (c *ChainKeyStore) newSignedAttempt(etx EthTx, tx *types.Transaction) (atte
 	return attempt, nil
 }
 
-func newLegacyTransaction(nonce uint64, to common.Address, value *big.Int, gasLimit uint32, gasPrice *assets.Wei, data []byte) types.LegacyTx {
+func newLegacyTransaction(nonce uint64, to *common.Address, value *big.Int, gasLimit uint32, gasPrice *assets.Wei, data []byte) types.LegacyTx {
 	return types.LegacyTx{
 		Nonce:    nonce,
-		To:       &to,
+		To:       to,
 		Value:    value,
 		Gas:      uint64(gasLimit),
 		GasPrice: gasPrice.ToInt(),
@@ -173,7 +176,7 @@ func newLegacyTransaction(nonce uint64, to common.Address, value *big.Int, gasLi
 }
 
 func (c *ChainKeyStore) signTx(address common.Address, tx *types.Transaction) (common.Hash, []byte, error) {
-	signedTx, err := c.keystore.SignTx(address, tx, &c.chainID)
+	signedTx, err := c.keystore.SignTx(address, tx, c.signerChainID())
 	if err != nil {
 		return common.Hash{}, nil, errors.Wrap(err, "signTx failed")
 	}
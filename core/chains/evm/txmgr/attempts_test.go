@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -103,9 +104,37 @@ func TestTxm_NewLegacyAttempt(t *testing.T) {
 		assert.Nil(t, a.GasFeeCap)
 	})
 
+	t.Run("creates a contract creation transaction without a to address", func(t *testing.T) {
+		kst := ksmocks.NewEth(t)
+		isContractCreation := mock.MatchedBy(func(tx *types.Transaction) bool { return tx.To() == nil })
+		kst.On("SignTx", addr, isContractCreation, big.NewInt(1)).Return(tx, nil).Once()
+		cks := txmgr.NewChainKeyStore(*big.NewInt(1), cfg, kst)
+		var n int64
+		_, err := cks.NewLegacyAttempt(txmgr.EthTx{Nonce: &n, FromAddress: addr, ContractCreation: true}, assets.NewWeiI(25), 100)
+		require.NoError(t, err)
+
+		// the zero address is an ordinary recipient
+		isCallToZero := mock.MatchedBy(func(tx *types.Transaction) bool { return tx.To() != nil && *tx.To() == (common.Address{}) })
+		kst.On("SignTx", addr, isCallToZero, big.NewInt(1)).Return(tx, nil).Once()
+		_, err = cks.NewLegacyAttempt(txmgr.EthTx{Nonce: &n, FromAddress: addr}, assets.NewWeiI(25), 100)
+		require.NoError(t, err)
+	})
+
 	t.Run("verifies max gas price", func(t *testing.T) {
 		_, err := cks.NewLegacyAttempt(txmgr.EthTx{FromAddress: addr}, assets.NewWeiI(100), 100)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), fmt.Sprintf("specified gas price of 100 wei would exceed max configured gas price of 50 wei for key %s", addr.Hex()))
 	})
+
+	t.Run("errors if the EIP-1559 fee market is required", func(t *testing.T) {
+		gcfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.EVM[0].GasEstimator.EIP1559DynamicFees = ptr(true)
+			c.EVM[0].GasEstimator.MinimumFeeMarket = ptr(true)
+		})
+		cks := txmgr.NewChainKeyStore(*big.NewInt(1), evmtest.NewChainScopedConfig(t, gcfg), kst)
+		var n int64
+		_, err := cks.NewLegacyAttempt(txmgr.EthTx{Nonce: &n, FromAddress: addr}, assets.NewWeiI(25), 100)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "EIP-1559 transactions are required by EvmMinimumFeeMarket")
+	})
 }
@@ -0,0 +1,169 @@
+package txmgr
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+//go:generate mockery --name AutoAdjustGasPriceDefaultConfig --output ./mocks/ --case=underscore
+
+// AutoAdjustGasPriceDefaultConfig is the config subset used by AutoAdjustGasPriceDefault
+type AutoAdjustGasPriceDefaultConfig interface {
+	EvmGasPriceDefault() *assets.Wei
+	EvmGasPriceAutoAdjustInterval() time.Duration
+	EvmGasBumpWei() *assets.Wei
+	EvmGasBumpThreshold() uint64
+	EvmMinGasPriceWei() *assets.Wei
+	EvmMaxGasPriceWei() *assets.Wei
+	SetEvmGasPriceDefault(value *big.Int) error
+}
+
+// AutoAdjustGasPriceDefault periodically nudges the default gas price towards
+// the lowest price which still gets transactions confirmed promptly.
+//
+// Every EvmGasPriceAutoAdjustInterval it averages the number of blocks the transactions confirmed
+// since the previous adjustment took to be mined, counted the same way as the
+// blocks until confirmation metric. If they confirmed in a block or less the
+// node is overpaying, so the default gas price is lowered by EvmGasBumpWei.
+// If they took EvmGasBumpThreshold blocks or more it is raised by
+// EvmGasBumpWei. The default gas price is kept within EvmMinGasPriceWei and
+// EvmMaxGasPriceWei.
+type AutoAdjustGasPriceDefault struct {
+	utils.StartStopOnce
+	db      *sqlx.DB
+	config  AutoAdjustGasPriceDefaultConfig
+	chainID utils.Big
+	logger  logger.Logger
+
+	// since is the time of the previous adjustment. Only accessed from the
+	// run goroutine.
+	since time.Time
+
+	chStop chan struct{}
+	chDone chan struct{}
+}
+
+// NewAutoAdjustGasPriceDefault returns a new service which adjusts the
+// default gas price of chainID every EvmGasPriceAutoAdjustInterval
+func NewAutoAdjustGasPriceDefault(lggr logger.Logger, db *sqlx.DB, config AutoAdjustGasPriceDefaultConfig, chainID big.Int) *AutoAdjustGasPriceDefault {
+	return &AutoAdjustGasPriceDefault{
+		db:      db,
+		config:  config,
+		chainID: *utils.NewBig(&chainID),
+		logger:  lggr.Named("AutoAdjustGasPriceDefault"),
+		chStop:  make(chan struct{}),
+		chDone:  make(chan struct{}),
+	}
+}
+
+func (a *AutoAdjustGasPriceDefault) Start(context.Context) error {
+	return a.StartOnce("AutoAdjustGasPriceDefault", func() error {
+		a.logger.Infow(fmt.Sprintf("Automatically adjusting the default gas price every %s", a.config.EvmGasPriceAutoAdjustInterval()),
+			"gasPriceDefault", a.config.EvmGasPriceDefault(), "gasBumpWei", a.config.EvmGasBumpWei())
+		a.since = time.Now()
+		go a.run()
+		return nil
+	})
+}
+
+func (a *AutoAdjustGasPriceDefault) Close() error {
+	return a.StopOnce("AutoAdjustGasPriceDefault", func() error {
+		close(a.chStop)
+		<-a.chDone
+		return nil
+	})
+}
+
+func (a *AutoAdjustGasPriceDefault) run() {
+	defer close(a.chDone)
+
+	ctx, cancel := utils.ContextFromChan(a.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(utils.WithJitter(a.config.EvmGasPriceAutoAdjustInterval()))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.chStop:
+			return
+		case <-ticker.C:
+			now := time.Now()
+			if err := a.Adjust(ctx, a.since); err != nil {
+				a.logger.Errorw("Failed to adjust the default gas price", "err", err)
+			}
+			a.since = now
+			ticker.Reset(utils.WithJitter(a.config.EvmGasPriceAutoAdjustInterval()))
+		}
+	}
+}
+
+// Adjust adjusts the default gas price based on the transactions which have
+// been confirmed since the given time
+func (a *AutoAdjustGasPriceDefault) Adjust(ctx context.Context, since time.Time) error {
+	var stats struct {
+		Count     int64   `db:"count"`
+		AvgBlocks float64 `db:"avg_blocks"`
+	}
+	// Since a transaction can have many attempts, the number of blocks to
+	// confirm it is the block number of its receipt minus the block number
+	// of the first ever broadcast of the transaction.
+	err := a.db.GetContext(ctx, &stats, `
+SELECT COUNT(*) AS count, COALESCE(AVG(blocks), 0)::float8 AS avg_blocks FROM (
+	SELECT eth_receipts.block_number - MIN(eth_tx_attempts.broadcast_before_block_num) AS blocks
+	FROM eth_receipts
+	JOIN eth_tx_attempts confirmed_attempts ON confirmed_attempts.hash = eth_receipts.tx_hash
+	JOIN eth_txes ON eth_txes.id = confirmed_attempts.eth_tx_id
+	JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id
+	WHERE eth_txes.evm_chain_id = $1
+	AND eth_txes.state = 'confirmed'
+	AND eth_receipts.created_at > $2
+	AND eth_tx_attempts.broadcast_before_block_num IS NOT NULL
+	GROUP BY eth_receipts.id
+) AS confirmations`, a.chainID, since)
+	if err != nil {
+		return errors.Wrap(err, "failed to load confirmation times")
+	}
+	if stats.Count == 0 {
+		a.logger.Debugw("No transactions were confirmed, leaving the default gas price unchanged", "since", since)
+		return nil
+	}
+
+	current := a.config.EvmGasPriceDefault()
+	bumpWei := a.config.EvmGasBumpWei()
+	threshold := a.config.EvmGasBumpThreshold()
+	var adjusted *assets.Wei
+	switch {
+	case stats.AvgBlocks <= 1:
+		adjusted = current.Sub(bumpWei)
+		if min := a.config.EvmMinGasPriceWei(); adjusted.Cmp(min) < 0 {
+			adjusted = min
+		}
+	case threshold > 0 && stats.AvgBlocks >= float64(threshold):
+		adjusted = current.Add(bumpWei)
+		if max := a.config.EvmMaxGasPriceWei(); adjusted.Cmp(max) > 0 {
+			adjusted = max
+		}
+	default:
+		adjusted = current
+	}
+
+	lggr := a.logger.With("transactions", stats.Count, "avgBlocksToConfirm", stats.AvgBlocks, "gasPriceDefault", current)
+	if adjusted.Cmp(current) == 0 {
+		lggr.Debug("Leaving the default gas price unchanged")
+		return nil
+	}
+	if err = a.config.SetEvmGasPriceDefault(adjusted.ToInt()); err != nil {
+		return errors.Wrapf(err, "failed to set the default gas price to %s", adjusted)
+	}
+	lggr.Infow(fmt.Sprintf("Adjusted the default gas price to %s", adjusted), "adjustedGasPriceDefault", adjusted)
+	return nil
+}
@@ -0,0 +1,125 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestAutoAdjustGasPriceDefault_Adjust(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	borm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, from := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+
+	newConfig := func(t *testing.T, gasPriceDefault int64) *mocks.AutoAdjustGasPriceDefaultConfig {
+		config := mocks.NewAutoAdjustGasPriceDefaultConfig(t)
+		config.On("EvmGasPriceDefault").Return(assets.GWei(gasPriceDefault)).Maybe()
+		config.On("EvmGasBumpWei").Return(assets.GWei(5)).Maybe()
+		config.On("EvmGasBumpThreshold").Return(uint64(3)).Maybe()
+		config.On("EvmMinGasPriceWei").Return(assets.GWei(1)).Maybe()
+		config.On("EvmMaxGasPriceWei").Return(assets.GWei(100)).Maybe()
+		return config
+	}
+	newAdjuster := func(t *testing.T, config txmgr.AutoAdjustGasPriceDefaultConfig) *txmgr.AutoAdjustGasPriceDefault {
+		return txmgr.NewAutoAdjustGasPriceDefault(logger.TestLogger(t), db, config, cltest.FixtureChainID)
+	}
+	var nonce int64
+	insertConfirmedTx := func(t *testing.T, broadcastBeforeBlockNum, blockNum int64, confirmedAt time.Time) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, nonce, broadcastBeforeBlockNum, from)
+		r := cltest.MustInsertEthReceipt(t, borm, blockNum, utils.NewHash(), etx.EthTxAttempts[0].Hash)
+		_, err := db.Exec(`UPDATE eth_receipts SET created_at = $1 WHERE id = $2`, confirmedAt, r.ID)
+		require.NoError(t, err)
+		nonce++
+	}
+
+	t.Run("does nothing without confirmed transactions", func(t *testing.T) {
+		config := newConfig(t, 20)
+
+		require.NoError(t, newAdjuster(t, config).Adjust(testutils.Context(t), time.Now().Add(-time.Hour)))
+	})
+
+	// Each group of transactions is confirmed after the previous one, and
+	// adjusted for on its own by passing the time it was confirmed
+	start := time.Now().Add(-3 * time.Hour)
+	insertConfirmedTx(t, 10, 11, start)
+	insertConfirmedTx(t, 20, 21, start)
+
+	t.Run("lowers the gas price if transactions confirm in one block", func(t *testing.T) {
+		config := newConfig(t, 20)
+		config.On("SetEvmGasPriceDefault", assets.GWei(15).ToInt()).Return(nil).Once()
+
+		require.NoError(t, newAdjuster(t, config).Adjust(testutils.Context(t), start.Add(-time.Minute)))
+	})
+
+	t.Run("does not go below the minimum gas price", func(t *testing.T) {
+		config := newConfig(t, 3)
+		config.On("SetEvmGasPriceDefault", assets.GWei(1).ToInt()).Return(nil).Once()
+
+		require.NoError(t, newAdjuster(t, config).Adjust(testutils.Context(t), start.Add(-time.Minute)))
+	})
+
+	t.Run("does nothing if already at the minimum gas price", func(t *testing.T) {
+		config := newConfig(t, 1)
+
+		require.NoError(t, newAdjuster(t, config).Adjust(testutils.Context(t), start.Add(-time.Minute)))
+	})
+
+	t.Run("returns an error if the gas price cannot be set", func(t *testing.T) {
+		config := newConfig(t, 20)
+		config.On("SetEvmGasPriceDefault", assets.GWei(15).ToInt()).Return(errors.New("read only")).Once()
+
+		err := newAdjuster(t, config).Adjust(testutils.Context(t), start.Add(-time.Minute))
+		require.EqualError(t, err, "failed to set the default gas price to 15 gwei: read only")
+	})
+
+	start = start.Add(time.Hour)
+	insertConfirmedTx(t, 30, 32, start)
+	insertConfirmedTx(t, 40, 42, start)
+
+	t.Run("does nothing if transactions confirm below the bump threshold", func(t *testing.T) {
+		config := newConfig(t, 20)
+
+		require.NoError(t, newAdjuster(t, config).Adjust(testutils.Context(t), start.Add(-time.Minute)))
+	})
+
+	start = start.Add(time.Hour)
+	insertConfirmedTx(t, 50, 54, start)
+
+	t.Run("raises the gas price if transactions reach the bump threshold", func(t *testing.T) {
+		config := newConfig(t, 20)
+		config.On("SetEvmGasPriceDefault", assets.GWei(25).ToInt()).Return(nil).Once()
+
+		require.NoError(t, newAdjuster(t, config).Adjust(testutils.Context(t), start.Add(-time.Minute)))
+	})
+
+	t.Run("does not go above the maximum gas price", func(t *testing.T) {
+		config := newConfig(t, 98)
+		config.On("SetEvmGasPriceDefault", assets.GWei(100).ToInt()).Return(nil).Once()
+
+		require.NoError(t, newAdjuster(t, config).Adjust(testutils.Context(t), start.Add(-time.Minute)))
+	})
+
+	t.Run("ignores transactions on other chains", func(t *testing.T) {
+		config := newConfig(t, 20)
+
+		adjuster := txmgr.NewAutoAdjustGasPriceDefault(logger.TestLogger(t), db, config, *big.NewInt(42))
+		require.NoError(t, adjuster.Adjust(testutils.Context(t), start.Add(-time.Hour)))
+	})
+}
@@ -39,6 +39,15 @@ const (
 	// TransmitCheckTimeout controls the maximum amount of time that will be
 	// spent on the transmit check.
 	TransmitCheckTimeout = 2 * time.Second
+
+	// TxPriorityQueueSize is the maximum number of unstarted transactions of
+	// a key that are considered when EvmTxPriorityQueue is enabled
+	TxPriorityQueueSize = 100
+
+	// TxPriorityQueueMaxWait is how long an unstarted transaction can be
+	// passed over for more valuable ones when EvmTxPriorityQueue is enabled,
+	// after which it is sent in the usual order
+	TxPriorityQueueMaxWait = 1 * time.Minute
 )
 
 var (
@@ -126,7 +135,7 @@ func NewEthBroadcaster(db *sqlx.DB, ethClient evmclient.Client, config Config, k
 	return &EthBroadcaster{
 		logger:    logger,
 		db:        db,
-		q:         pg.NewQ(db, logger, config),
+		q:         pg.NewQ(db, logger, config, pg.WithDefaultQueryTimeout(config.EvmDatabaseQueryTimeout())),
 		ethClient: ethClient,
 		ChainKeyStore: ChainKeyStore{
 			chainID:  *ethClient.ChainID(),
@@ -425,6 +434,9 @@ func (eb *EthBroadcaster) handleInProgressEthTx(ctx context.Context, etx EthTx,
 	checkCtx, cancel := context.WithTimeout(ctx, TransmitCheckTimeout)
 	defer cancel()
 	err = checker.Check(checkCtx, lgr, etx, attempt)
+	if err == nil && eb.config.EvmSimulateTransactions() && checkerSpec.CheckerType != TransmitCheckerTypeSimulate {
+		err = (&SimulateChecker{eb.ethClient}).Check(checkCtx, lgr, etx, attempt)
+	}
 	if errors.Is(err, context.Canceled) {
 		lgr.Warn("Transmission checker timed out, sending anyway")
 	} else if err != nil {
@@ -436,6 +448,12 @@ func (eb *EthBroadcaster) handleInProgressEthTx(ctx context.Context, etx EthTx,
 
 	sendError := sendTransaction(ctx, eb.ethClient, attempt, etx, lgr)
 
+	if sendError.IsIntrinsicGasTooLow() {
+		if newGasLimit, ok := eb.incrementedGasLimit(attempt.ChainSpecificGasLimit); ok {
+			return eb.tryAgainWithIncrementedGasLimit(ctx, lgr, sendError, etx, attempt, initialBroadcastAt, newGasLimit)
+		}
+	}
+
 	if sendError.Fatal() {
 		lgr.Criticalw("Fatal error sending transaction", "err", sendError, "etx", etx)
 		etx.Error = null.StringFrom(sendError.Error())
@@ -602,13 +620,26 @@ func (eb *EthBroadcaster) handleInProgressEthTx(ctx context.Context, etx EthTx,
 // Finds next transaction in the queue, assigns a nonce, and moves it to "in_progress" state ready for broadcast.
 // Returns nil if no transactions are in queue
 func (eb *EthBroadcaster) nextUnstartedTransactionWithNonce(fromAddress gethCommon.Address) (*EthTx, error) {
-	etx := &EthTx{}
-	if err := findNextUnstartedTransactionFromAddress(eb.db, etx, fromAddress, eb.chainID); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+	var etx *EthTx
+	if eb.config.EvmTxPriorityQueue() {
+		etxs, err := findUnstartedTransactionsFromAddress(eb.db, fromAddress, eb.chainID, TxPriorityQueueSize)
+		if err != nil {
+			return nil, errors.Wrap(err, "findUnstartedTransactionsFromAddress failed")
+		}
+		etx = NewTxPriorityQueue(eb.logger, etxs, TxPriorityQueueMaxWait).RemoveNextTx()
+		if etx == nil {
 			// Finish. No more transactions left to process. Hoorah!
 			return nil, nil
 		}
-		return nil, errors.Wrap(err, "findNextUnstartedTransactionFromAddress failed")
+	} else {
+		etx = &EthTx{}
+		if err := findNextUnstartedTransactionFromAddress(eb.db, etx, fromAddress, eb.chainID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				// Finish. No more transactions left to process. Hoorah!
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "findNextUnstartedTransactionFromAddress failed")
+		}
 	}
 
 	nonce, err := eb.getNextNonce(etx.FromAddress)
@@ -653,6 +684,13 @@ func findNextUnstartedTransactionFromAddress(db *sqlx.DB, etx *EthTx, fromAddres
 	return errors.Wrap(err, "failed to findNextUnstartedTransactionFromAddress")
 }
 
+// Finds up to limit saved transactions that have yet to be broadcast from the
+// given address, earliest first
+func findUnstartedTransactionsFromAddress(db *sqlx.DB, fromAddress gethCommon.Address, chainID big.Int, limit int) (etxs []EthTx, err error) {
+	err = db.Select(&etxs, `SELECT * FROM eth_txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC LIMIT $3`, fromAddress, chainID.String(), limit)
+	return etxs, errors.Wrap(err, "failed to findUnstartedTransactionsFromAddress")
+}
+
 func (eb *EthBroadcaster) saveAttempt(etx *EthTx, attempt EthTxAttempt, NewAttemptState EthTxAttemptState, callbacks ...func(tx pg.Queryer) error) error {
 	if etx.State != EthTxInProgress {
 		return errors.Errorf("can only transition to unconfirmed from in_progress, transaction is currently %s", etx.State)
@@ -749,6 +787,56 @@ func (eb *EthBroadcaster) tryAgainWithNewEstimation(ctx context.Context, lgr log
 	return eb.tryAgainWithNewLegacyGas(ctx, lgr, etx, attempt, initialBroadcastAt, gasPrice, gasLimit)
 }
 
+// incrementedGasLimit returns gasLimit increased by
+// EvmGasLimitIncrementOnFailure and capped to EvmGasLimitMax, or false if the
+// gas limit cannot be increased any further
+func (eb *EthBroadcaster) incrementedGasLimit(gasLimit uint32) (uint32, bool) {
+	increment := eb.config.EvmGasLimitIncrementOnFailure()
+	max := eb.config.EvmGasLimitMax()
+	if increment == 0 || gasLimit >= max {
+		return 0, false
+	}
+	newGasLimit := uint64(gasLimit) + uint64(increment)
+	if newGasLimit > uint64(max) {
+		newGasLimit = uint64(max)
+	}
+	return uint32(newGasLimit), true
+}
+
+func (eb *EthBroadcaster) tryAgainWithIncrementedGasLimit(ctx context.Context, lgr logger.Logger, sendError *evmclient.SendError, etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time, newGasLimit uint32) (err error, retryable bool) {
+	var replacementAttempt EthTxAttempt
+	switch attempt.TxType {
+	case 0x0:
+		replacementAttempt, err = eb.NewLegacyAttempt(etx, attempt.GasPrice, newGasLimit)
+	case 0x2:
+		replacementAttempt, err = eb.NewDynamicFeeAttempt(etx, attempt.DynamicFee(), newGasLimit)
+	default:
+		err = errors.Errorf("invariant violation: Attempt %v had unrecognised transaction type %v"+
+			"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", attempt.ID, attempt.TxType)
+		logger.Sugared(eb.logger).AssumptionViolation(err.Error())
+		return err, false
+	}
+	if err != nil {
+		return errors.Wrap(err, "tryAgainWithIncrementedGasLimit failed"), true
+	}
+
+	// The eth_tx gas limit is raised by the same amount, so that any attempts
+	// later created by the EthConfirmer do not fall back to the old limit
+	etx.GasLimit += newGasLimit - attempt.ChainSpecificGasLimit
+	err = eb.q.Transaction(func(tx pg.Queryer) error {
+		if _, err := tx.Exec(`UPDATE eth_txes SET gas_limit=$1 WHERE id=$2`, etx.GasLimit, etx.ID); err != nil {
+			return errors.Wrap(err, "failed to update eth_txes gas_limit")
+		}
+		return saveReplacementInProgressAttempt(eb.q.WithOpts(pg.WithQueryer(tx)), attempt, &replacementAttempt)
+	})
+	if err != nil {
+		return errors.Wrap(err, "tryAgainWithIncrementedGasLimit failed"), true
+	}
+	lgr.Warnw("Transaction gas limit was too low to cover its intrinsic gas, increased gas limit and will try again",
+		"err", sendError, "oldGasLimit", attempt.ChainSpecificGasLimit, "newGasLimit", newGasLimit)
+	return eb.handleInProgressEthTx(ctx, etx, replacementAttempt, initialBroadcastAt)
+}
+
 func (eb *EthBroadcaster) tryAgainWithNewLegacyGas(ctx context.Context, lgr logger.Logger, etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time, newGasPrice *assets.Wei, newGasLimit uint32) (err error, retyrable bool) {
 	replacementAttempt, err := eb.NewLegacyAttempt(etx, newGasPrice, newGasLimit)
 	if err != nil {
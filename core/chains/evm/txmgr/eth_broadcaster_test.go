@@ -558,6 +558,151 @@ func TestEthBroadcaster_TransmitChecking(t *testing.T) {
 	})
 }
 
+func TestEthBroadcaster_SimulateTransactions(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.Simulate = ptr(true)
+	})
+	borm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState}, &testCheckerFactory{})
+
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+	gasLimit := uint32(242)
+
+	t.Run("when simulation succeeds, sends tx as normal", func(t *testing.T) {
+		ethTx := txmgr.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: []byte{42, 0, 0},
+			Value:          assets.NewEthValue(442),
+			GasLimit:       gasLimit,
+			CreatedAt:      time.Unix(0, 0),
+			State:          txmgr.EthTxUnstarted,
+		}
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.MatchedBy(func(callarg map[string]interface{}) bool {
+			return fmt.Sprintf("%s", callarg["value"]) == "0x1ba" // 442
+		}), "latest").Return(nil).Once()
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && tx.Value().Cmp(big.NewInt(442)) == 0
+		})).Return(nil).Once()
+
+		require.NoError(t, borm.InsertEthTx(&ethTx))
+		{
+			err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+			assert.NoError(t, err)
+			assert.False(t, retryable)
+		}
+
+		ethTx, err := borm.FindEthTxWithAttempts(ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, ethTx.State)
+	})
+
+	t.Run("on revert, marks tx as fatally errored and does not send", func(t *testing.T) {
+		ethTx := txmgr.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: []byte{42, 0, 0},
+			Value:          assets.NewEthValue(642),
+			GasLimit:       gasLimit,
+			CreatedAt:      time.Unix(0, 0),
+			State:          txmgr.EthTxUnstarted,
+		}
+
+		// Error(string) selector followed by the ABI encoded reason
+		revertData := "0x08c379a0" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"000000000000000000000000000000000000000000000000000000000000000f" +
+			"6e6f7420656e6f756768204c494e4b0000000000000000000000000000000000"
+		jerr := evmclient.JsonError{
+			Code:    3,
+			Message: "execution reverted: not enough LINK",
+			Data:    revertData,
+		}
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.MatchedBy(func(callarg map[string]interface{}) bool {
+			return fmt.Sprintf("%s", callarg["value"]) == "0x282" // 642
+		}), "latest").Return(&jerr).Once()
+
+		require.NoError(t, borm.InsertEthTx(&ethTx))
+		{
+			err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+			assert.NoError(t, err)
+			assert.False(t, retryable)
+		}
+
+		ethTx, err := borm.FindEthTxWithAttempts(ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxFatalError, ethTx.State)
+		assert.True(t, ethTx.Error.Valid)
+		assert.Equal(t, fmt.Sprintf("transaction reverted during simulation: json-rpc error { Code = 3, Message = 'execution reverted: not enough LINK', Data = '%s' }", revertData), ethTx.Error.String)
+	})
+}
+
+func TestEthBroadcaster_TxPriorityQueue(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.PriorityQueue = ptr(true)
+	})
+	borm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState}, &testCheckerFactory{})
+
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+	insertTx := func(value int64, revenue *big.Int) txmgr.EthTx {
+		etx := txmgr.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: []byte{42, 0, 0},
+			Value:          assets.NewEthValue(value),
+			GasLimit:       1000,
+			CreatedAt:      time.Now(),
+			State:          txmgr.EthTxUnstarted,
+		}
+		if revenue != nil {
+			b, err := json.Marshal(txmgr.EthTxMeta{EstimatedRevenue: revenue})
+			require.NoError(t, err)
+			meta := datatypes.JSON(b)
+			etx.Meta = &meta
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+		return etx
+	}
+	// Without prioritisation these would be sent in order of value
+	noRevenue := insertTx(1, nil)
+	lowRevenue := insertTx(2, big.NewInt(100))
+	highRevenue := insertTx(3, big.NewInt(300))
+
+	for nonce, value := range []int64{3, 2, 1} {
+		nonce, value := uint64(nonce), value
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == nonce && tx.Value().Cmp(big.NewInt(value)) == 0
+		})).Return(nil).Once()
+	}
+
+	err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+	require.NoError(t, err)
+	assert.False(t, retryable)
+
+	for nonce, etx := range []txmgr.EthTx{highRevenue, lowRevenue, noRevenue} {
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+		require.NotNil(t, etx.Nonce)
+		assert.Equal(t, int64(nonce), *etx.Nonce)
+	}
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_OptimisticLockingOnEthTx(t *testing.T) {
 	// non-transactional DB needed because we deliberately test for FK violation
 	cfg, db := heavyweight.FullTestDBV2(t, "eth_broadcaster_optimistic_locking", nil)
@@ -662,6 +807,76 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing
 	}
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_IntrinsicGasTooLow(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].GasEstimator.LimitIncrementOnFailure = ptr[uint32](10_000)
+		c.EVM[0].GasEstimator.LimitMax = ptr[uint32](25_000)
+	})
+	borm := cltest.NewTxmORM(t, db, cfg)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState}, &testCheckerFactory{})
+
+	newEthTx := func(gasLimit uint32) txmgr.EthTx {
+		etx := txmgr.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411"),
+			EncodedPayload: []byte{42, 42, 0},
+			Value:          assets.NewEthValue(242),
+			GasLimit:       gasLimit,
+			State:          txmgr.EthTxUnstarted,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+		return etx
+	}
+	expectSend := func(gas uint64, err error) {
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Gas() == gas
+		})).Return(err).Once()
+	}
+
+	t.Run("retries with an incremented gas limit", func(t *testing.T) {
+		etx := newEthTx(1_000)
+		expectSend(1_000, errors.New("intrinsic gas too low"))
+		expectSend(11_000, errors.New("intrinsic gas too low"))
+		expectSend(21_000, nil)
+
+		err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+		assert.NoError(t, err)
+		assert.False(t, retryable)
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+		assert.Equal(t, uint32(21_000), etx.GasLimit)
+		require.Len(t, etx.EthTxAttempts, 1)
+		assert.Equal(t, uint32(21_000), etx.EthTxAttempts[0].ChainSpecificGasLimit)
+	})
+
+	t.Run("marks the transaction as fatally errored once LimitMax is reached", func(t *testing.T) {
+		etx := newEthTx(20_000)
+		expectSend(20_000, errors.New("intrinsic gas too low"))
+		expectSend(25_000, errors.New("intrinsic gas too low"))
+
+		err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+		assert.NoError(t, err)
+		assert.False(t, retryable)
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxFatalError, etx.State)
+		assert.Equal(t, "intrinsic gas too low", etx.Error.String)
+		assert.Len(t, etx.EthTxAttempts, 0)
+	})
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_ResumingFromCrash(t *testing.T) {
 	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
 	value := assets.NewEthValue(142)
@@ -77,6 +77,10 @@ var (
 		Name: "tx_manager_num_tx_reverted",
 		Help: "Number of times a transaction reverted on-chain. Note that this can err to be too high since transactions are counted on each confirmation, which can happen multiple times per transaction in the case of re-orgs",
 	}, []string{"evmChainID"})
+	promRevertedTxLimitExceeded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_reverted_transactions_per_block_limit_exceeded",
+		Help: "Number of times a block had more reverted transactions than EvmMaxRevertedTransactionsPerBlock, and the rest of its receipts were left for the next check. Any counts of this type indicate a storm of reverts, for example from a buggy contract.",
+	}, []string{"evmChainID"})
 	promFwdTxCount = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "tx_manager_fwd_tx_count",
 		Help: "The number of forwarded transaction attempts labeled by status",
@@ -146,7 +150,7 @@ func NewEthConfirmer(db *sqlx.DB, ethClient evmclient.Client, config Config, key
 
 	ctx, cancel := context.WithCancel(context.Background())
 	lggr = lggr.Named("EthConfirmer")
-	q := pg.NewQ(db, lggr, config)
+	q := pg.NewQ(db, lggr, config, pg.WithDefaultQueryTimeout(config.EvmDatabaseQueryTimeout()))
 
 	return &EthConfirmer{
 		utils.StartStopOnce{},
@@ -198,9 +202,34 @@ func (ec *EthConfirmer) Close() error {
 
 func (ec *EthConfirmer) runLoop() {
 	defer ec.wg.Done()
+
+	var receiptTick <-chan time.Time
+	if interval := ec.config.EvmReceiptPollingInterval(); interval > 0 {
+		ticker := time.NewTicker(utils.WithJitter(interval))
+		defer ticker.Stop()
+		receiptTick = ticker.C
+	}
+	// latestHead is the last head processed, receipts are polled against it
+	var latestHead *evmtypes.Head
+	// An in-progress receipt poll, if any. The poll runs in its own goroutine
+	// so that a new head can cancel it, but never concurrently with
+	// ProcessHead.
+	var cancelPoll context.CancelFunc
+	var pollDone chan struct{}
+	stopPoll := func() {
+		if cancelPoll != nil {
+			cancelPoll()
+			<-pollDone
+			cancelPoll, pollDone = nil, nil
+		}
+	}
+	defer stopPoll()
+
 	for {
 		select {
 		case <-ec.mb.Notify():
+			// The head checks for receipts anyway, so don't wait for the poll
+			stopPoll()
 			for {
 				if ec.ctx.Err() != nil {
 					return
@@ -209,17 +238,51 @@ func (ec *EthConfirmer) runLoop() {
 				if !exists {
 					break
 				}
+				latestHead = head
 				if err := ec.ProcessHead(ec.ctx, head); err != nil {
 					ec.lggr.Errorw("Error processing head", "err", err)
 					continue
 				}
 			}
+		case <-receiptTick:
+			if cancelPoll != nil || latestHead == nil {
+				// Previous poll is still running, or there is no head yet to
+				// check receipts against
+				continue
+			}
+			ctx, cancel := context.WithTimeout(ec.ctx, processHeadTimeout)
+			cancelPoll, pollDone = cancel, make(chan struct{})
+			go func(head *evmtypes.Head, done chan struct{}) {
+				defer close(done)
+				defer cancel()
+				ec.pollReceipts(ctx, head)
+			}(latestHead, pollDone)
+		case <-pollDone:
+			cancelPoll()
+			cancelPoll, pollDone = nil, nil
 		case <-ec.ctx.Done():
 			return
 		}
 	}
 }
 
+// pollReceipts checks for receipts between heads, see EvmReceiptPollingInterval
+func (ec *EthConfirmer) pollReceipts(ctx context.Context, head *evmtypes.Head) {
+	if err := ec.CheckForReceipts(ctx, head.Number); err != nil {
+		if ctx.Err() != nil {
+			ec.lggr.Debugw("Receipt poll cancelled", "headNum", head.Number, "err", err)
+			return
+		}
+		ec.lggr.Errorw("Error polling for receipts", "headNum", head.Number, "err", err)
+		return
+	}
+	if ec.resumeCallback != nil {
+		if err := ec.ResumePendingTaskRuns(ctx, head); err != nil {
+			ec.lggr.Errorw("Error resuming pending task runs after polling for receipts", "headNum", head.Number, "err", err)
+		}
+	}
+}
+
 // ProcessHead takes all required transactions for the confirmer on a new head
 func (ec *EthConfirmer) ProcessHead(ctx context.Context, head *evmtypes.Head) error {
 	ctx, cancel := context.WithTimeout(ctx, processHeadTimeout)
@@ -361,6 +424,8 @@ func (ec *EthConfirmer) CheckForReceipts(ctx context.Context, blockNum int64) er
 
 	ec.lggr.Debugw(fmt.Sprintf("Fetching receipts for %v transaction attempts", len(attempts)), "blockNum", blockNum)
 
+	reverted := newRevertedTxLimiter(ec.config.EvmMaxRevertedTransactionsPerBlock())
+
 	attemptsByAddress := make(map[gethCommon.Address][]EthTxAttempt)
 	for _, att := range attempts {
 		attemptsByAddress[att.EthTx.FromAddress] = append(attemptsByAddress[att.EthTx.FromAddress], att)
@@ -384,7 +449,7 @@ func (ec *EthConfirmer) CheckForReceipts(ctx context.Context, blockNum int64) er
 				likelyConfirmedCount, likelyUnconfirmedCount)
 
 			start := time.Now()
-			err = ec.fetchAndSaveReceipts(ctx, likelyConfirmed, blockNum)
+			err = ec.fetchAndSaveReceipts(ctx, likelyConfirmed, blockNum, reverted)
 			if err != nil {
 				return errors.Wrapf(err, "unable to fetch and save receipts for likely confirmed txs, for address: %v", from)
 			}
@@ -439,7 +504,7 @@ func (ec *EthConfirmer) separateLikelyConfirmedAttempts(from gethCommon.Address,
 	return likelyConfirmed
 }
 
-func (ec *EthConfirmer) fetchAndSaveReceipts(ctx context.Context, attempts []EthTxAttempt, blockNum int64) error {
+func (ec *EthConfirmer) fetchAndSaveReceipts(ctx context.Context, attempts []EthTxAttempt, blockNum int64, reverted *revertedTxLimiter) error {
 	promTxAttemptCount.WithLabelValues(ec.chainID.String()).Set(float64(len(attempts)))
 
 	batchSize := int(ec.config.EvmRPCDefaultBatchSize())
@@ -457,7 +522,7 @@ func (ec *EthConfirmer) fetchAndSaveReceipts(ctx context.Context, attempts []Eth
 
 		batch := attempts[i:j]
 
-		receipts, err := ec.batchFetchReceipts(ctx, batch, blockNum)
+		receipts, err := ec.batchFetchReceipts(ctx, batch, blockNum, reverted)
 		if err != nil {
 			return errors.Wrap(err, "batchFetchReceipts failed")
 		}
@@ -474,6 +539,31 @@ func (ec *EthConfirmer) fetchAndSaveReceipts(ctx context.Context, attempts []Eth
 	return nil
 }
 
+// revertedTxLimiter counts reverted transactions per block while checking
+// for receipts, so that processing of a block can be paused once it exceeds
+// EvmMaxRevertedTransactionsPerBlock
+type revertedTxLimiter struct {
+	limit    uint16
+	reverted map[int64]uint16
+}
+
+func newRevertedTxLimiter(limit uint16) *revertedTxLimiter {
+	return &revertedTxLimiter{limit, make(map[int64]uint16)}
+}
+
+// add counts a reverted transaction in blockNum, and returns true if this
+// took the block over the limit
+func (r *revertedTxLimiter) add(blockNum int64) (exceeded bool) {
+	r.reverted[blockNum]++
+	return r.limit > 0 && r.reverted[blockNum] == r.limit+1
+}
+
+// paused returns true if blockNum has exceeded the limit, so the rest of its
+// receipts should be left for the next check
+func (r *revertedTxLimiter) paused(blockNum int64) bool {
+	return r.limit > 0 && r.reverted[blockNum] > r.limit
+}
+
 func (ec *EthConfirmer) findEthTxAttemptsRequiringReceiptFetch() (attempts []EthTxAttempt, err error) {
 	err = ec.q.Transaction(func(tx pg.Queryer) error {
 		err = tx.Select(&attempts, `
@@ -497,7 +587,7 @@ func (ec *EthConfirmer) getMinedTransactionCount(ctx context.Context, from gethC
 
 // Note this function will increment promRevertedTxCount upon receiving
 // a reverted transaction receipt. Should only be called with unconfirmed attempts.
-func (ec *EthConfirmer) batchFetchReceipts(ctx context.Context, attempts []EthTxAttempt, blockNum int64) (receipts []evmtypes.Receipt, err error) {
+func (ec *EthConfirmer) batchFetchReceipts(ctx context.Context, attempts []EthTxAttempt, blockNum int64, reverted *revertedTxLimiter) (receipts []evmtypes.Receipt, err error) {
 	var reqs []rpc.BatchElem
 
 	// Metadata is required to determine whether a tx is forwarded or not.
@@ -574,11 +664,21 @@ func (ec *EthConfirmer) batchFetchReceipts(ctx context.Context, attempts []EthTx
 			continue
 		}
 
+		if reverted.paused(receipt.BlockNumber.Int64()) {
+			l.Debugw("Too many reverted transactions in block, leaving receipt for the next check", "blockNumber", receipt.BlockNumber)
+			continue
+		}
+
 		if receipt.Status == 0 {
+			if reverted.add(receipt.BlockNumber.Int64()) {
+				l.Errorw(fmt.Sprintf("More than %d transactions reverted in block %s, leaving the rest of its receipts for the next check. This could indicate a buggy contract", reverted.limit, receipt.BlockNumber),
+					"blockNumber", receipt.BlockNumber, "maxRevertedTransactionsPerBlock", reverted.limit)
+				promRevertedTxLimitExceeded.WithLabelValues(ec.chainID.String()).Add(1)
+			}
 			// Do an eth call to obtain the revert reason.
 			_, errCall := ec.ethClient.CallContract(ctx, ethereum.CallMsg{
 				From:       attempt.EthTx.FromAddress,
-				To:         &attempt.EthTx.ToAddress,
+				To:         attempt.EthTx.txToAddress(),
 				Gas:        uint64(attempt.EthTx.GasLimit),
 				GasPrice:   attempt.GasPrice.ToInt(),
 				GasFeeCap:  attempt.GasFeeCap.ToInt(),
@@ -868,15 +968,27 @@ func (ec *EthConfirmer) rebroadcastWhereNecessary(ctx context.Context, address g
 	}
 
 	threshold := int64(ec.config.EvmGasBumpThreshold())
+	interval := ec.config.EvmGasBumpInterval()
 	bumpDepth := int64(ec.config.EvmGasBumpTxDepth())
 	maxInFlightTransactions := ec.config.EvmMaxInFlightTransactions()
-	etxs, err := FindEthTxsRequiringRebroadcast(ctx, ec.q, ec.lggr, address, blockHeight, threshold, bumpDepth, maxInFlightTransactions, ec.chainID)
+	etxs, err := FindEthTxsRequiringRebroadcast(ctx, ec.q, ec.lggr, address, blockHeight, threshold, interval, bumpDepth, maxInFlightTransactions, ec.chainID)
 	if err != nil {
 		return errors.Wrap(err, "FindEthTxsRequiringRebroadcast failed")
 	}
+	noBumpThreshold := int64(ec.config.EvmGasNoBumpThreshold())
 	for _, etx := range etxs {
 		lggr := etx.GetLogger(ec.lggr)
 
+		if noBumpThreshold > 0 && len(etx.EthTxAttempts) > 0 && etx.EthTxAttempts[0].State != EthTxAttemptInsufficientEth {
+			if blocksBehind, ok := blocksSinceFirstBroadcast(*etx, blockHeight); ok && blocksBehind >= noBumpThreshold {
+				// Inclusion is not ordered by fee on this chain, so bumping gas
+				// would only waste it. The EthResender keeps resending the
+				// existing attempts.
+				lggr.Debugw("Not bumping gas for transaction past the no bump threshold", "blocksBehind", blocksBehind, "noBumpThreshold", noBumpThreshold)
+				continue
+			}
+		}
+
 		attempt, err := ec.attemptForRebroadcast(ctx, lggr, *etx)
 		if err != nil {
 			return errors.Wrap(err, "attemptForRebroadcast failed")
@@ -961,7 +1073,7 @@ func loadEthTxes(q pg.Queryer, attempts []EthTxAttempt) error {
 
 // FindEthTxsRequiringRebroadcast returns attempts that hit insufficient eth,
 // and attempts that need bumping, in nonce ASC order
-func FindEthTxsRequiringRebroadcast(ctx context.Context, q pg.Q, lggr logger.Logger, address gethCommon.Address, blockNum, gasBumpThreshold, bumpDepth int64, maxInFlightTransactions uint32, chainID big.Int) (etxs []*EthTx, err error) {
+func FindEthTxsRequiringRebroadcast(ctx context.Context, q pg.Q, lggr logger.Logger, address gethCommon.Address, blockNum, gasBumpThreshold int64, gasBumpInterval time.Duration, bumpDepth int64, maxInFlightTransactions uint32, chainID big.Int) (etxs []*EthTx, err error) {
 	// NOTE: These two queries could be combined into one using union but it
 	// becomes harder to read and difficult to test in isolation. KISS principle
 	etxInsufficientEths, err := FindEthTxsRequiringResubmissionDueToInsufficientEth(ctx, q, lggr, address, chainID)
@@ -974,7 +1086,7 @@ func FindEthTxsRequiringRebroadcast(ctx context.Context, q pg.Q, lggr logger.Log
 	}
 
 	// TODO: Just pass the Q through everything
-	etxBumps, err := FindEthTxsRequiringGasBump(ctx, q, lggr, address, blockNum, gasBumpThreshold, bumpDepth, chainID)
+	etxBumps, err := FindEthTxsRequiringGasBump(ctx, q, lggr, address, blockNum, gasBumpThreshold, gasBumpInterval, bumpDepth, chainID)
 	if ctx.Err() != nil {
 		return nil, nil
 	} else if err != nil {
@@ -1063,24 +1175,30 @@ func loadEthTxesAttempts(q pg.Queryer, etxs []*EthTx) error {
 }
 
 // FindEthTxsRequiringGasBump returns transactions that have all
-// attempts which are unconfirmed for at least gasBumpThreshold blocks,
-// limited by limit pending transactions
+// attempts which are unconfirmed for at least gasBumpThreshold blocks or
+// gasBumpInterval (whichever comes first), limited by limit pending
+// transactions. A zero gasBumpInterval disables the time-based check.
 //
 // It also returns eth_txes that are unconfirmed with no eth_tx_attempts
-func FindEthTxsRequiringGasBump(ctx context.Context, q pg.Q, lggr logger.Logger, address gethCommon.Address, blockNum, gasBumpThreshold, depth int64, chainID big.Int) (etxs []*EthTx, err error) {
+func FindEthTxsRequiringGasBump(ctx context.Context, q pg.Q, lggr logger.Logger, address gethCommon.Address, blockNum, gasBumpThreshold int64, gasBumpInterval time.Duration, depth int64, chainID big.Int) (etxs []*EthTx, err error) {
 	if gasBumpThreshold == 0 {
 		return
 	}
+	var createdBefore *time.Time
+	if gasBumpInterval > 0 {
+		t := time.Now().Add(-gasBumpInterval)
+		createdBefore = &t
+	}
 	qq := q.WithOpts(pg.WithParentCtx(ctx))
 	err = qq.Transaction(func(tx pg.Queryer) error {
 		stmt := `
 SELECT eth_txes.* FROM eth_txes
-LEFT JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id AND (broadcast_before_block_num > $4 OR broadcast_before_block_num IS NULL OR eth_tx_attempts.state != 'broadcast')
+LEFT JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id AND ((broadcast_before_block_num > $4 AND ($5::timestamptz IS NULL OR eth_tx_attempts.created_at > $5)) OR broadcast_before_block_num IS NULL OR eth_tx_attempts.state != 'broadcast')
 WHERE eth_txes.state = 'unconfirmed' AND eth_tx_attempts.id IS NULL AND eth_txes.from_address = $1 AND eth_txes.evm_chain_id = $2
 	AND (($3 = 0) OR (eth_txes.id IN (SELECT id FROM eth_txes WHERE state = 'unconfirmed' AND from_address = $1 ORDER BY nonce ASC LIMIT $3)))
 ORDER BY nonce ASC
 `
-		if err = tx.Select(&etxs, stmt, address, chainID.String(), depth, blockNum-gasBumpThreshold); err != nil {
+		if err = tx.Select(&etxs, stmt, address, chainID.String(), depth, blockNum-gasBumpThreshold, createdBefore); err != nil {
 			return errors.Wrap(err, "FindEthTxsRequiringGasBump failed to load eth_txes")
 		}
 		err = loadEthTxesAttempts(tx, etxs)
@@ -1118,6 +1236,21 @@ func (ec *EthConfirmer) attemptForRebroadcast(ctx context.Context, lggr logger.L
 		"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", etx.ID)
 }
 
+// blocksSinceFirstBroadcast returns the number of blocks since the earliest
+// attempt of etx was broadcast, or false if no attempt has been broadcast
+func blocksSinceFirstBroadcast(etx EthTx, blockHeight int64) (int64, bool) {
+	var earliest *int64
+	for _, a := range etx.EthTxAttempts {
+		if a.BroadcastBeforeBlockNum != nil && (earliest == nil || *a.BroadcastBeforeBlockNum < *earliest) {
+			earliest = a.BroadcastBeforeBlockNum
+		}
+	}
+	if earliest == nil {
+		return 0, false
+	}
+	return blockHeight - *earliest, true
+}
+
 func (ec *EthConfirmer) logFieldsPreviousAttempt(attempt EthTxAttempt) []interface{} {
 	etx := attempt.EthTx
 	return []interface{}{
@@ -1603,7 +1736,7 @@ func (ec *EthConfirmer) sendEmptyTransaction(ctx context.Context, fromAddress ge
 	if gasLimit == 0 {
 		gasLimit = ec.config.EvmGasLimitDefault()
 	}
-	tx, err := sendEmptyTransaction(ctx, ec.ethClient, ec.keystore, uint64(nonce), gasLimit, big.NewInt(int64(gasPriceWei)), fromAddress, &ec.chainID)
+	tx, err := sendEmptyTransaction(ctx, ec.ethClient, ec.keystore, uint64(nonce), gasLimit, big.NewInt(int64(gasPriceWei)), fromAddress, ec.signerChainID())
 	if err != nil {
 		return gethCommon.Hash{}, errors.Wrap(err, "(EthConfirmer).sendEmptyTransaction failed")
 	}
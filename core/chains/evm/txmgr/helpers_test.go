@@ -1,11 +1,24 @@
 package txmgr
 
-import evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+import (
+	"context"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+)
 
 func SetEthClientOnEthConfirmer(ethClient evmclient.Client, ethConfirmer *EthConfirmer) {
 	ethConfirmer.ethClient = ethClient
 }
 
+func DeliverHeadToEthConfirmer(ec *EthConfirmer, head *evmtypes.Head) {
+	ec.mb.Deliver(head)
+}
+
 func SetResumeCallbackOnEthBroadcaster(resumeCallback ResumeCallback, ethBroadcaster *EthBroadcaster) {
 	ethBroadcaster.resumeCallback = resumeCallback
 }
+
+func ExpireEthTxes(ctx context.Context, txm *Txm) error {
+	return txm.expireEthTxes(ctx)
+}
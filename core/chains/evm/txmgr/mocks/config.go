@@ -172,6 +172,92 @@ func (_m *Config) EthTxResendAfterThreshold() time.Duration {
 	return r0
 }
 
+// EvmAllowUnprotectedTxs provides a mock function with given fields:
+func (_m *Config) EvmAllowUnprotectedTxs() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmBlobGasPriceDefault provides a mock function with given fields:
+func (_m *Config) EvmBlobGasPriceDefault() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// EvmCallDataSizeLimit provides a mock function with given fields:
+func (_m *Config) EvmCallDataSizeLimit() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmConfigReadOnly provides a mock function with given fields:
+func (_m *Config) EvmConfigReadOnly() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmContractCreationGasLimit provides a mock function with given fields:
+func (_m *Config) EvmContractCreationGasLimit() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmDatabaseQueryTimeout provides a mock function with given fields:
+func (_m *Config) EvmDatabaseQueryTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EvmEIP1559DynamicFees provides a mock function with given fields:
 func (_m *Config) EvmEIP1559DynamicFees() bool {
 	ret := _m.Called()
@@ -186,6 +272,20 @@ func (_m *Config) EvmEIP1559DynamicFees() bool {
 	return r0
 }
 
+// EvmFeeHistoryBlocks provides a mock function with given fields:
+func (_m *Config) EvmFeeHistoryBlocks() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // EvmFinalityDepth provides a mock function with given fields:
 func (_m *Config) EvmFinalityDepth() uint32 {
 	ret := _m.Called()
@@ -200,6 +300,20 @@ func (_m *Config) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmGasBumpInterval provides a mock function with given fields:
+func (_m *Config) EvmGasBumpInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EvmGasBumpPercent provides a mock function with given fields:
 func (_m *Config) EvmGasBumpPercent() uint16 {
 	ret := _m.Called()
@@ -258,6 +372,76 @@ func (_m *Config) EvmGasBumpWei() *assets.Wei {
 	return r0
 }
 
+// EvmGasEstimatorBlockHistoryEWMAAlpha provides a mock function with given fields:
+func (_m *Config) EvmGasEstimatorBlockHistoryEWMAAlpha() float64 {
+	ret := _m.Called()
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// EvmGasEstimatorCheckRetries provides a mock function with given fields:
+func (_m *Config) EvmGasEstimatorCheckRetries() uint8 {
+	ret := _m.Called()
+
+	var r0 uint8
+	if rf, ok := ret.Get(0).(func() uint8); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint8)
+	}
+
+	return r0
+}
+
+// EvmGasEstimatorFallbackMode provides a mock function with given fields:
+func (_m *Config) EvmGasEstimatorFallbackMode() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EvmGasEstimatorMinSampleCount provides a mock function with given fields:
+func (_m *Config) EvmGasEstimatorMinSampleCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// EvmGasEstimatorTransactionPriceHistory provides a mock function with given fields:
+func (_m *Config) EvmGasEstimatorTransactionPriceHistory() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // EvmGasFeeCapDefault provides a mock function with given fields:
 func (_m *Config) EvmGasFeeCapDefault() *assets.Wei {
 	ret := _m.Called()
@@ -274,6 +458,36 @@ func (_m *Config) EvmGasFeeCapDefault() *assets.Wei {
 	return r0
 }
 
+// EvmGasFeeCapRounding provides a mock function with given fields:
+func (_m *Config) EvmGasFeeCapRounding() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasHistoryExcludeZeroPriceTxs provides a mock function with given fields:
+func (_m *Config) EvmGasHistoryExcludeZeroPriceTxs() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmGasLimitDefault provides a mock function with given fields:
 func (_m *Config) EvmGasLimitDefault() uint32 {
 	ret := _m.Called()
@@ -288,6 +502,20 @@ func (_m *Config) EvmGasLimitDefault() uint32 {
 	return r0
 }
 
+// EvmGasLimitIncrementOnFailure provides a mock function with given fields:
+func (_m *Config) EvmGasLimitIncrementOnFailure() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // EvmGasLimitMax provides a mock function with given fields:
 func (_m *Config) EvmGasLimitMax() uint32 {
 	ret := _m.Called()
@@ -316,6 +544,78 @@ func (_m *Config) EvmGasLimitMultiplier() float32 {
 	return r0
 }
 
+// EvmGasLimitTransfer provides a mock function with given fields:
+func (_m *Config) EvmGasLimitTransfer() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmGasNoBumpThreshold provides a mock function with given fields:
+func (_m *Config) EvmGasNoBumpThreshold() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// EvmGasOracleAddress provides a mock function with given fields:
+func (_m *Config) EvmGasOracleAddress() common.Address {
+	ret := _m.Called()
+
+	var r0 common.Address
+	if rf, ok := ret.Get(0).(func() common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasOraclePollInterval provides a mock function with given fields:
+func (_m *Config) EvmGasOraclePollInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmGasPriceCacheTTL provides a mock function with given fields:
+func (_m *Config) EvmGasPriceCacheTTL() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EvmGasPriceDefault provides a mock function with given fields:
 func (_m *Config) EvmGasPriceDefault() *assets.Wei {
 	ret := _m.Called()
@@ -332,6 +632,52 @@ func (_m *Config) EvmGasPriceDefault() *assets.Wei {
 	return r0
 }
 
+// EvmGasPriceFeedAddress provides a mock function with given fields:
+func (_m *Config) EvmGasPriceFeedAddress() common.Address {
+	ret := _m.Called()
+
+	var r0 common.Address
+	if rf, ok := ret.Get(0).(func() common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasPriceFeedEnabled provides a mock function with given fields:
+func (_m *Config) EvmGasPriceFeedEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmGasPriceFloor provides a mock function with given fields:
+func (_m *Config) EvmGasPriceFloor() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
 // EvmGasTipCapDefault provides a mock function with given fields:
 func (_m *Config) EvmGasTipCapDefault() *assets.Wei {
 	ret := _m.Called()
@@ -364,6 +710,36 @@ func (_m *Config) EvmGasTipCapMinimum() *assets.Wei {
 	return r0
 }
 
+// EvmMaxBlobGasPrice provides a mock function with given fields:
+func (_m *Config) EvmMaxBlobGasPrice() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// EvmMaxGasPriceWarningThresholdPercent provides a mock function with given fields:
+func (_m *Config) EvmMaxGasPriceWarningThresholdPercent() uint8 {
+	ret := _m.Called()
+
+	var r0 uint8
+	if rf, ok := ret.Get(0).(func() uint8); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint8)
+	}
+
+	return r0
+}
+
 // EvmMaxGasPriceWei provides a mock function with given fields:
 func (_m *Config) EvmMaxGasPriceWei() *assets.Wei {
 	ret := _m.Called()
@@ -408,6 +784,20 @@ func (_m *Config) EvmMaxQueuedTransactions() uint64 {
 	return r0
 }
 
+// EvmMaxRevertedTransactionsPerBlock provides a mock function with given fields:
+func (_m *Config) EvmMaxRevertedTransactionsPerBlock() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // EvmMinGasPriceWei provides a mock function with given fields:
 func (_m *Config) EvmMinGasPriceWei() *assets.Wei {
 	ret := _m.Called()
@@ -424,6 +814,34 @@ func (_m *Config) EvmMinGasPriceWei() *assets.Wei {
 	return r0
 }
 
+// EvmMinimumFeeMarket provides a mock function with given fields:
+func (_m *Config) EvmMinimumFeeMarket() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmNonceAutoFillGap provides a mock function with given fields:
+func (_m *Config) EvmNonceAutoFillGap() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmNonceAutoSync provides a mock function with given fields:
 func (_m *Config) EvmNonceAutoSync() bool {
 	ret := _m.Called()
@@ -452,6 +870,62 @@ func (_m *Config) EvmRPCDefaultBatchSize() uint32 {
 	return r0
 }
 
+// EvmReceiptPollingInterval provides a mock function with given fields:
+func (_m *Config) EvmReceiptPollingInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmSimulateTransactions provides a mock function with given fields:
+func (_m *Config) EvmSimulateTransactions() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmTxExpiry provides a mock function with given fields:
+func (_m *Config) EvmTxExpiry() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmTxPriorityQueue provides a mock function with given fields:
+func (_m *Config) EvmTxPriorityQueue() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmUseForwarders provides a mock function with given fields:
 func (_m *Config) EvmUseForwarders() bool {
 	ret := _m.Called()
@@ -141,6 +141,43 @@ func (_m *TxManager) Ready() error {
 	return r0
 }
 
+// Rebroadcast provides a mock function with given fields: ctx, hash
+func (_m *TxManager) Rebroadcast(ctx context.Context, hash common.Hash) (txmgr.EthTxAttempt, error) {
+	ret := _m.Called(ctx, hash)
+
+	var r0 txmgr.EthTxAttempt
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash) txmgr.EthTxAttempt); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		r0 = ret.Get(0).(txmgr.EthTxAttempt)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, common.Hash) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegisterExpiryCallback provides a mock function with given fields: fn
+func (_m *TxManager) RegisterExpiryCallback(fn txmgr.ExpiryCallback) func() {
+	ret := _m.Called(fn)
+
+	var r0 func()
+	if rf, ok := ret.Get(0).(func(txmgr.ExpiryCallback) func()); ok {
+		r0 = rf(fn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+
+	return r0
+}
+
 // RegisterResumeCallback provides a mock function with given fields: fn
 func (_m *TxManager) RegisterResumeCallback(fn txmgr.ResumeCallback) {
 	_m.Called(fn)
@@ -48,6 +48,10 @@ type EthTxMeta struct {
 
 	// Used for keepers
 	UpkeepID *string `json:"UpkeepID,omitempty"`
+	// EstimatedRevenue is the revenue this tx is expected to earn, used to
+	// prioritise it when EvmTxPriorityQueue is enabled. Keepers set it to the
+	// maximum LINK payment of the upkeep, in juels.
+	EstimatedRevenue *big.Int `json:"EstimatedRevenue,omitempty"`
 
 	// Used only for forwarded txs, tracks the original destination address.
 	// When this is set, it indicates tx is forwarded through To address.
@@ -163,6 +167,9 @@ type EthTx struct {
 	ToAddress      common.Address
 	EncodedPayload []byte
 	Value          assets.Eth
+	// ContractCreation is true if the transaction deploys a contract, in
+	// which case it is sent without a to address and ToAddress is unset
+	ContractCreation bool
 	// GasLimit on the EthTx is always the conceptual gas limit, which is not
 	// necessarily the same as the on-chain encoded value (i.e. Optimism)
 	GasLimit uint32
@@ -194,6 +201,15 @@ type EthTx struct {
 	TransmitChecker *datatypes.JSON
 }
 
+// txToAddress returns the to address of the transaction, which is nil for
+// contract creation
+func (e EthTx) txToAddress() *common.Address {
+	if e.ContractCreation {
+		return nil
+	}
+	return &e.ToAddress
+}
+
 func (e EthTx) GetError() error {
 	if e.Error.Valid {
 		return errors.New(e.Error.String)
@@ -69,9 +69,9 @@ type (
 )
 
 // NewNonceSyncer returns a new syncer
-func NewNonceSyncer(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, ethClient evmclient.Client, kst NonceSyncerKeyStore) *NonceSyncer {
+func NewNonceSyncer(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, ethClient evmclient.Client, kst NonceSyncerKeyStore, qopts ...pg.QOpt) *NonceSyncer {
 	lggr = lggr.Named("NonceSyncer")
-	q := pg.NewQ(db, lggr, cfg)
+	q := pg.NewQ(db, lggr, cfg, qopts...)
 	return &NonceSyncer{
 		q,
 		ethClient,
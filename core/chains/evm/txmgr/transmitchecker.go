@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -98,7 +99,7 @@ func (s *SimulateChecker) Check(
 	// See: https://github.com/ethereum/go-ethereum/blob/acdf9238fb03d79c9b1c20c2fa476a7e6f4ac2ac/ethclient/gethclient/gethclient.go#L193
 	callArg := map[string]interface{}{
 		"from": tx.FromAddress,
-		"to":   &tx.ToAddress,
+		"to":   tx.txToAddress(),
 		"gas":  hexutil.Uint64(a.ChainSpecificGasLimit),
 		// NOTE: Deliberately do not include gas prices. We never want to fatally error a
 		// transaction just because the wallet has insufficient eth.
@@ -115,7 +116,7 @@ func (s *SimulateChecker) Check(
 	if err != nil {
 		if jErr := evmclient.ExtractRPCErrorOrNil(err); jErr != nil {
 			l.Criticalw("Transaction reverted during simulation",
-				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "returnValue", b.String())
+				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "returnValue", b.String(), "revertReason", revertReason(jErr))
 			return errors.Errorf("transaction reverted during simulation: %s", jErr.String())
 		}
 		l.Warnw("Transaction simulation failed, will attempt to send anyway",
@@ -127,6 +128,29 @@ func (s *SimulateChecker) Check(
 	return nil
 }
 
+// revertReason decodes the Error(string) reason from the data of a reverted call.
+// Returns an empty string if the data does not contain a reason.
+func revertReason(jErr *evmclient.JsonError) string {
+	var data []byte
+	switch d := jErr.Data.(type) {
+	case string:
+		b, err := hexutil.Decode(d)
+		if err != nil {
+			return ""
+		}
+		data = b
+	case []byte:
+		data = d
+	default:
+		return ""
+	}
+	reason, err := abi.UnpackRevert(data)
+	if err != nil {
+		return ""
+	}
+	return reason
+}
+
 // VRFV1Checker is an implementation of TransmitChecker that checks whether a VRF V1 fulfillment
 // has already been fulfilled.
 type VRFV1Checker struct {
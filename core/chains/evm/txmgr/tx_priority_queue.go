@@ -0,0 +1,121 @@
+package txmgr
+
+import (
+	"container/heap"
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// TxPriorityQueue orders unstarted transactions by the revenue they are
+// expected to earn per unit of gas, highest first, so that the most valuable
+// transactions are given the next nonces of a key when block space is tight.
+// All transactions from a key are priced alike, so revenue per unit of gas
+// ranks them the same as revenue per unit of gas cost.
+//
+// Revenue estimates are read from EthTxMeta.EstimatedRevenue. Transactions
+// without an estimate come after those with one, and transactions which tie
+// keep the order in which they were added.
+//
+// So that transactions with little or no revenue are not starved, those
+// which have waited for longer than maxWait come before all others, in the
+// order in which they were added.
+type TxPriorityQueue struct {
+	items []*txPriorityItem
+	seq   int
+	// agedBefore is the creation time before which transactions are aged
+	agedBefore time.Time
+}
+
+type txPriorityItem struct {
+	etx *EthTx
+	// revenuePerGas is nil if the transaction has no revenue estimate
+	revenuePerGas *big.Rat
+	aged          bool
+	seq           int
+}
+
+var _ heap.Interface = &TxPriorityQueue{}
+
+// NewTxPriorityQueue returns a TxPriorityQueue containing etxs, which should
+// be given in the order they would be broadcast without prioritisation.
+// Transactions created more than maxWait ago are no longer prioritised by
+// revenue.
+func NewTxPriorityQueue(lggr logger.Logger, etxs []EthTx, maxWait time.Duration) *TxPriorityQueue {
+	pq := &TxPriorityQueue{items: make([]*txPriorityItem, 0, len(etxs)), agedBefore: time.Now().Add(-maxWait)}
+	for i := range etxs {
+		pq.items = append(pq.items, pq.newItem(lggr, &etxs[i]))
+	}
+	heap.Init(pq)
+	return pq
+}
+
+func (pq *TxPriorityQueue) newItem(lggr logger.Logger, etx *EthTx) *txPriorityItem {
+	item := &txPriorityItem{etx: etx, aged: etx.CreatedAt.Before(pq.agedBefore), seq: pq.seq}
+	pq.seq++
+	meta, err := etx.GetMeta()
+	if err != nil {
+		lggr.Warnw("Failed to get meta of the transaction, it will not be prioritised", "ethTxID", etx.ID, "err", err)
+		return item
+	}
+	if meta != nil && meta.EstimatedRevenue != nil && etx.GasLimit > 0 {
+		item.revenuePerGas = new(big.Rat).SetFrac(meta.EstimatedRevenue, new(big.Int).SetUint64(uint64(etx.GasLimit)))
+	}
+	return item
+}
+
+// AddTx adds etx to the queue
+func (pq *TxPriorityQueue) AddTx(lggr logger.Logger, etx *EthTx) {
+	heap.Push(pq, pq.newItem(lggr, etx))
+}
+
+// RemoveNextTx removes and returns the transaction with the highest
+// priority, or nil if the queue is empty
+func (pq *TxPriorityQueue) RemoveNextTx() *EthTx {
+	if pq.Len() == 0 {
+		return nil
+	}
+	return heap.Pop(pq).(*txPriorityItem).etx
+}
+
+func (pq *TxPriorityQueue) Len() int { return len(pq.items) }
+
+func (pq *TxPriorityQueue) Less(i, j int) bool {
+	if pq.items[i].aged || pq.items[j].aged {
+		if pq.items[i].aged != pq.items[j].aged {
+			return pq.items[i].aged
+		}
+		return pq.items[i].seq < pq.items[j].seq
+	}
+	a, b := pq.items[i].revenuePerGas, pq.items[j].revenuePerGas
+	switch {
+	case a != nil && b == nil:
+		return true
+	case a == nil && b != nil:
+		return false
+	case a != nil && b != nil:
+		if c := a.Cmp(b); c != 0 {
+			return c > 0
+		}
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+func (pq *TxPriorityQueue) Swap(i, j int) {
+	pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+}
+
+// Push implements heap.Interface, use AddTx instead
+func (pq *TxPriorityQueue) Push(x interface{}) {
+	pq.items = append(pq.items, x.(*txPriorityItem))
+}
+
+// Pop implements heap.Interface, use RemoveNextTx instead
+func (pq *TxPriorityQueue) Pop() interface{} {
+	n := len(pq.items)
+	item := pq.items[n-1]
+	pq.items[n-1] = nil
+	pq.items = pq.items[:n-1]
+	return item
+}
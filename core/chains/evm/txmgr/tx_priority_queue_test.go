@@ -0,0 +1,76 @@
+package txmgr_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg/datatypes"
+)
+
+func TestTxPriorityQueue(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	newTx := func(id int64, gasLimit uint32, revenue *big.Int) txmgr.EthTx {
+		etx := txmgr.EthTx{ID: id, GasLimit: gasLimit, CreatedAt: time.Now()}
+		if revenue != nil {
+			b, err := json.Marshal(txmgr.EthTxMeta{EstimatedRevenue: revenue})
+			require.NoError(t, err)
+			meta := datatypes.JSON(b)
+			etx.Meta = &meta
+		}
+		return etx
+	}
+	ids := func(pq *txmgr.TxPriorityQueue) (ids []int64) {
+		for etx := pq.RemoveNextTx(); etx != nil; etx = pq.RemoveNextTx() {
+			ids = append(ids, etx.ID)
+		}
+		return
+	}
+
+	t.Run("empty queue", func(t *testing.T) {
+		pq := txmgr.NewTxPriorityQueue(lggr, nil, time.Minute)
+		assert.Nil(t, pq.RemoveNextTx())
+	})
+
+	t.Run("orders by revenue per unit of gas", func(t *testing.T) {
+		pq := txmgr.NewTxPriorityQueue(lggr, []txmgr.EthTx{
+			newTx(1, 1000, big.NewInt(100)), // 0.1 per gas
+			newTx(2, 100, big.NewInt(50)),   // 0.5 per gas
+			newTx(3, 500, big.NewInt(100)),  // 0.2 per gas
+		}, time.Minute)
+		assert.Equal(t, []int64{2, 3, 1}, ids(pq))
+	})
+
+	t.Run("keeps the given order for ties and transactions without an estimate", func(t *testing.T) {
+		pq := txmgr.NewTxPriorityQueue(lggr, []txmgr.EthTx{
+			newTx(1, 1000, nil),
+			newTx(2, 1000, big.NewInt(100)),
+			newTx(3, 1000, nil),
+			newTx(4, 2000, big.NewInt(200)),
+		}, time.Minute)
+		pq.AddTx(lggr, &txmgr.EthTx{ID: 5, GasLimit: 1000, CreatedAt: time.Now()})
+		assert.Equal(t, []int64{2, 4, 1, 3, 5}, ids(pq))
+	})
+
+	t.Run("sends transactions which waited for longer than maxWait first", func(t *testing.T) {
+		aged := func(etx txmgr.EthTx) txmgr.EthTx {
+			etx.CreatedAt = time.Now().Add(-2 * time.Minute)
+			return etx
+		}
+		pq := txmgr.NewTxPriorityQueue(lggr, []txmgr.EthTx{
+			newTx(1, 1000, big.NewInt(100)),
+			aged(newTx(2, 1000, nil)),
+			newTx(3, 1000, big.NewInt(200)),
+			aged(newTx(4, 1000, big.NewInt(50))),
+		}, time.Minute)
+		assert.Equal(t, []int64{2, 4, 3, 1}, ids(pq))
+	})
+}
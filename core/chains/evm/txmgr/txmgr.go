@@ -37,22 +37,43 @@ import (
 //go:generate mockery --recursive --name Config --output ./mocks/ --case=underscore --structname Config --filename config.go
 type Config interface {
 	gas.Config
+	gas.BlobConfig
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
+	EvmTxExpiry() time.Duration
+	EvmReceiptPollingInterval() time.Duration
+	EvmMaxRevertedTransactionsPerBlock() uint16
+	EvmCallDataSizeLimit() uint32
 	EvmGasBumpThreshold() uint64
+	EvmGasNoBumpThreshold() uint64
+	EvmDatabaseQueryTimeout() time.Duration
+	EvmMinimumFeeMarket() bool
+	EvmGasBumpInterval() time.Duration
 	EvmGasBumpTxDepth() uint16
 	EvmGasLimitDefault() uint32
+	EvmGasLimitTransfer() uint32
+	EvmContractCreationGasLimit() uint32
+	EvmGasLimitIncrementOnFailure() uint32
 	EvmMaxInFlightTransactions() uint32
 	EvmMaxQueuedTransactions() uint64
 	EvmNonceAutoSync() bool
+	EvmNonceAutoFillGap() bool
 	EvmUseForwarders() bool
+	EvmSimulateTransactions() bool
+	EvmTxPriorityQueue() bool
+	EvmAllowUnprotectedTxs() bool
 	EvmRPCDefaultBatchSize() uint32
+	EvmConfigReadOnly() bool
 	KeySpecificMaxGasPriceWei(addr common.Address) *assets.Wei
 	TriggerFallbackDBPollInterval() time.Duration
 	LogSQL() bool
 }
 
+// ErrCallDataTooLarge is returned by CreateEthTransaction when the calldata
+// of a transaction exceeds EvmCallDataSizeLimit
+var ErrCallDataTooLarge = errors.New("calldata too large")
+
 // KeyStore encompasses the subset of keystore used by txmgr
 type KeyStore interface {
 	GetStatesForChain(chainID *big.Int) ([]ethkey.State, error)
@@ -60,6 +81,7 @@ type KeyStore interface {
 	SubscribeToKeyChanges() (ch chan struct{}, unsub func())
 	GetNextNonce(address common.Address, chainID *big.Int, qopts ...pg.QOpt) (int64, error)
 	IncrementNextNonce(address common.Address, chainID *big.Int, currentNonce int64, qopts ...pg.QOpt) error
+	Reset(address common.Address, chainID *big.Int, nonce int64, qopts ...pg.QOpt) error
 	CheckEnabled(address common.Address, chainID *big.Int) error
 }
 
@@ -71,6 +93,10 @@ var _ TxManager = &Txm{}
 // ResumeCallback is assumed to be idempotent
 type ResumeCallback func(id uuid.UUID, result interface{}, err error) error
 
+// ExpiryCallback is called with each transaction given up on because it was
+// not broadcast within EvmTxExpiry
+type ExpiryCallback func(etx EthTx)
+
 //go:generate mockery --recursive --name TxManager --output ./mocks/ --case=underscore --structname TxManager --filename tx_manager.go
 type TxManager interface {
 	httypes.HeadTrackable
@@ -80,7 +106,9 @@ type TxManager interface {
 	GetForwarderForEOA(eoa common.Address) (forwarder common.Address, err error)
 	GetGasEstimator() gas.Estimator
 	RegisterResumeCallback(fn ResumeCallback)
+	RegisterExpiryCallback(fn ExpiryCallback) (unregister func())
 	SendEther(chainID *big.Int, from, to common.Address, value assets.Eth, gasLimit uint32) (etx EthTx, err error)
+	Rebroadcast(ctx context.Context, hash common.Hash) (attempt EthTxAttempt, err error)
 	Reset(f func(), addr common.Address, abandon bool) error
 }
 
@@ -93,6 +121,10 @@ type reset struct {
 	done chan error
 }
 
+// expiryPollInterval is how often Txm checks for unconfirmed transactions
+// older than EvmTxExpiry, when it is enabled
+const expiryPollInterval = 1 * time.Minute
+
 type Txm struct {
 	utils.StartStopOnce
 
@@ -112,6 +144,10 @@ type Txm struct {
 	reset          chan reset
 	resumeCallback ResumeCallback
 
+	expiryCallbacksMu    sync.Mutex
+	expiryCallbacks      map[int]ExpiryCallback
+	nextExpiryCallbackID int
+
 	chStop   chan struct{}
 	chSubbed chan struct{}
 	wg       sync.WaitGroup
@@ -125,6 +161,24 @@ func (b *Txm) RegisterResumeCallback(fn ResumeCallback) {
 	b.resumeCallback = fn
 }
 
+// RegisterExpiryCallback registers fn to be called with each transaction
+// expired after EvmTxExpiry, until unregister is called
+func (b *Txm) RegisterExpiryCallback(fn ExpiryCallback) (unregister func()) {
+	b.expiryCallbacksMu.Lock()
+	defer b.expiryCallbacksMu.Unlock()
+	if b.expiryCallbacks == nil {
+		b.expiryCallbacks = make(map[int]ExpiryCallback)
+	}
+	id := b.nextExpiryCallbackID
+	b.nextExpiryCallbackID++
+	b.expiryCallbacks[id] = fn
+	return func() {
+		b.expiryCallbacksMu.Lock()
+		defer b.expiryCallbacksMu.Unlock()
+		delete(b.expiryCallbacks, id)
+	}
+}
+
 // NewTxm creates a new Txm with the given configuration.
 func NewTxm(db *sqlx.DB, ethClient evmclient.Client, cfg Config, keyStore KeyStore, eventBroadcaster pg.EventBroadcaster, lggr logger.Logger, checkerFactory TransmitCheckerFactory, logPoller logpoller.LogPoller) *Txm {
 	lggr = lggr.Named("Txm")
@@ -139,12 +193,12 @@ func NewTxm(db *sqlx.DB, ethClient evmclient.Client, cfg Config, keyStore KeySto
 		StartStopOnce:    utils.StartStopOnce{},
 		logger:           lggr,
 		db:               db,
-		q:                pg.NewQ(db, lggr, cfg),
+		q:                pg.NewQ(db, lggr, cfg, pg.WithDefaultQueryTimeout(cfg.EvmDatabaseQueryTimeout())),
 		ethClient:        ethClient,
 		config:           cfg,
 		keyStore:         keyStore,
 		eventBroadcaster: eventBroadcaster,
-		gasEstimator:     gas.NewEstimator(lggr, ethClient, cfg),
+		gasEstimator:     gas.NewEstimator(lggr, ethClient, cfg, cfg),
 		chainID:          *ethClient.ChainID(),
 		checkerFactory:   checkerFactory,
 		chHeads:          make(chan *evmtypes.Head),
@@ -188,10 +242,13 @@ func (b *Txm) Start(ctx context.Context) (merr error) {
 		}
 
 		if b.config.EvmNonceAutoSync() {
-			syncer := NewNonceSyncer(b.db, b.logger, b.config, b.ethClient, b.keyStore)
+			syncer := NewNonceSyncer(b.db, b.logger, b.config, b.ethClient, b.keyStore, pg.WithDefaultQueryTimeout(b.config.EvmDatabaseQueryTimeout()))
 			if err = syncer.SyncAll(ctx, keyStates); err != nil {
 				return errors.Wrap(err, "Txm: failed to sync with on-chain nonce")
 			}
+			if b.config.EvmNonceAutoFillGap() {
+				b.fillNonceGaps(ctx, keyStates)
+			}
 		}
 		var ms services.MultiStart
 		eb := NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.eventBroadcaster, keyStates, b.gasEstimator, b.resumeCallback, b.logger, b.checkerFactory)
@@ -258,6 +315,76 @@ func (b *Txm) abandon(addr common.Address) (err error) {
 	return errors.Wrapf(err, "abandon failed to update eth_txes for key %s", addr.Hex())
 }
 
+// expirableEthTxs restricts expiry to keeper upkeep transactions, which are
+// idempotent, so that their jobs can safely try again
+const expirableEthTxs = `meta->>'UpkeepID' IS NOT NULL`
+
+// hasExpiredEthTxes returns true if any expirable transaction on this chain
+// has not been broadcast within EvmTxExpiry of being created
+func (b *Txm) hasExpiredEthTxes(ctx context.Context) (expired bool, err error) {
+	threshold := time.Now().Add(-b.config.EvmTxExpiry())
+	err = b.q.WithOpts(pg.WithParentCtx(ctx)).Get(&expired, `SELECT EXISTS (
+	SELECT 1 FROM eth_txes WHERE state = 'unstarted' AND evm_chain_id = $1 AND created_at < $2 AND `+expirableEthTxs+`
+)`, b.chainID.String(), threshold)
+	return expired, errors.Wrap(err, "hasExpiredEthTxes failed to query eth_txes")
+}
+
+// expireEthTxes gives up on expirable transactions on this chain which have
+// not been broadcast within EvmTxExpiry of being created:
+//   - marks them fatally errored. Only unstarted transactions are expired,
+//     since they have not been assigned a nonce yet. An in_progress
+//     transaction may already have been sent, so its nonce must not be freed
+//   - resumes any pipeline runs waiting on them with an error
+//   - notifies the registered ExpiryCallbacks, so that their jobs can try again
+//
+// This must not be run while EthBroadcaster or EthConfirmer are running.
+func (b *Txm) expireEthTxes(ctx context.Context) error {
+	expiry := b.config.EvmTxExpiry()
+	threshold := time.Now().Add(-expiry)
+
+	var expired []EthTx
+	err := b.q.WithOpts(pg.WithParentCtx(ctx)).Select(&expired, `
+UPDATE eth_txes SET state = 'fatal_error', error = 'expired'
+WHERE state = 'unstarted' AND evm_chain_id = $1 AND created_at < $2 AND `+expirableEthTxs+`
+RETURNING *
+`, b.chainID.String(), threshold)
+	if err != nil {
+		return errors.Wrap(err, "expireEthTxes failed")
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	b.logger.Warnw(fmt.Sprintf("Expired %d transactions which were not broadcast within %s", len(expired), expiry), "expiry", expiry)
+
+	b.expiryCallbacksMu.Lock()
+	callbacks := make([]ExpiryCallback, 0, len(b.expiryCallbacks))
+	for _, fn := range b.expiryCallbacks {
+		callbacks = append(callbacks, fn)
+	}
+	b.expiryCallbacksMu.Unlock()
+	for _, etx := range expired {
+		for _, fn := range callbacks {
+			fn(etx)
+		}
+	}
+
+	if b.resumeCallback == nil {
+		return nil
+	}
+	for _, etx := range expired {
+		if !etx.PipelineTaskRunID.Valid {
+			continue
+		}
+		err = b.resumeCallback(etx.PipelineTaskRunID.UUID, nil, errors.Errorf("transaction expired after %s without being broadcast", expiry))
+		if errors.Is(err, sql.ErrNoRows) {
+			b.logger.Debugw("callback missing or already resumed", "etxID", etx.ID)
+		} else if err != nil {
+			return errors.Wrap(err, "failed to resume pipeline")
+		}
+	}
+	return nil
+}
+
 func (b *Txm) Close() (merr error) {
 	return b.StopOnce("Txm", func() error {
 		close(b.chStop)
@@ -297,6 +424,13 @@ func (b *Txm) runLoop(eb *EthBroadcaster, ec *EthConfirmer, keyStates []ethkey.S
 	var stopped bool
 	var stopOnce sync.Once
 
+	var expiryTick <-chan time.Time
+	if b.config.EvmTxExpiry() > 0 {
+		ticker := time.NewTicker(utils.WithJitter(expiryPollInterval))
+		defer ticker.Stop()
+		expiryTick = ticker.C
+	}
+
 	// execReset is defined as an inline function here because it closes over
 	// eb, ec and stopped
 	execReset := func(r *reset) {
@@ -386,6 +520,23 @@ func (b *Txm) runLoop(eb *EthBroadcaster, ec *EthConfirmer, keyStates []ethkey.S
 				continue
 			}
 			execReset(&reset)
+		case <-expiryTick:
+			// Same early exit as for reset, see above
+			if stopped {
+				continue
+			}
+			expired, err := b.hasExpiredEthTxes(ctx)
+			if err != nil {
+				b.logger.Errorw("Failed to check for expired transactions", "err", err)
+				continue
+			} else if !expired {
+				continue
+			}
+			execReset(&reset{func() {
+				if err := b.expireEthTxes(ctx); err != nil {
+					b.logger.Errorw("Failed to expire transactions", "err", err)
+				}
+			}, make(chan error)})
 		case <-b.chStop:
 			// close and exit
 			//
@@ -456,6 +607,9 @@ type NewTx struct {
 	GasLimit         uint32
 	Meta             *EthTxMeta
 	ForwarderAddress common.Address
+	// ContractCreation makes the transaction deploy a contract with
+	// EncodedPayload as its init code. ToAddress must be unset.
+	ContractCreation bool
 
 	// Pipeline variables - if you aren't calling this from ethtx task within
 	// the pipeline, you don't need these variables
@@ -470,13 +624,20 @@ type NewTx struct {
 
 // CreateEthTransaction inserts a new transaction
 func (b *Txm) CreateEthTransaction(newTx NewTx, qs ...pg.QOpt) (etx EthTx, err error) {
+	if err = b.checkReadOnly(); err != nil {
+		return etx, err
+	}
 	if err = b.checkEnabled(newTx.FromAddress); err != nil {
 		return etx, err
 	}
 
+	if newTx.ContractCreation && newTx.ToAddress != (common.Address{}) {
+		return etx, errors.Errorf("contract creation transaction cannot have a to address, got %s", newTx.ToAddress.Hex())
+	}
+
 	q := b.q.WithOpts(qs...)
 
-	if b.config.EvmUseForwarders() && (newTx.ForwarderAddress != common.Address{}) {
+	if b.config.EvmUseForwarders() && (newTx.ForwarderAddress != common.Address{}) && !newTx.ContractCreation {
 		fwdPayload, fwdErr := b.fwdMgr.GetForwardedPayload(newTx.ToAddress, newTx.EncodedPayload)
 		if fwdErr == nil {
 			// Handling meta not set at caller.
@@ -494,6 +655,20 @@ func (b *Txm) CreateEthTransaction(newTx NewTx, qs ...pg.QOpt) (etx EthTx, err e
 		}
 	}
 
+	if err = b.checkCallDataSize(newTx.EncodedPayload); err != nil {
+		return etx, err
+	}
+
+	// A contract creation transaction needs far more gas than the job's gas
+	// limit allows for. A transaction without calldata is a plain transfer,
+	// which has a fixed cost, so it is used when the caller did not set a gas
+	// limit.
+	if newTx.ContractCreation {
+		newTx.GasLimit = b.config.EvmContractCreationGasLimit()
+	} else if len(newTx.EncodedPayload) == 0 && newTx.GasLimit == 0 {
+		newTx.GasLimit = b.config.EvmGasLimitTransfer()
+	}
+
 	err = CheckEthTxQueueCapacity(q, newTx.FromAddress, b.config.EvmMaxQueuedTransactions(), b.chainID)
 	if err != nil {
 		return etx, errors.Wrap(err, "Txm#CreateEthTransaction")
@@ -513,12 +688,12 @@ func (b *Txm) CreateEthTransaction(newTx NewTx, qs ...pg.QOpt) (etx EthTx, err e
 			}
 		}
 		err := tx.Get(&etx, `
-INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker)
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, contract_creation)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12
 )
 RETURNING "eth_txes".*
-`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Checker)
+`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Checker, newTx.ContractCreation)
 		if err != nil {
 			return errors.Wrap(err, "Txm#CreateEthTransaction failed to insert eth_tx")
 		}
@@ -549,6 +724,24 @@ func (b *Txm) checkEnabled(addr common.Address) error {
 	return errors.Wrapf(err, "cannot send transaction from %s on chain ID %s", addr.Hex(), b.chainID.String())
 }
 
+func (b *Txm) checkReadOnly() error {
+	if b.config.EvmConfigReadOnly() {
+		return errors.Errorf("cannot send transaction on chain ID %s: chain is read-only", b.chainID.String())
+	}
+	return nil
+}
+
+// checkCallDataSize returns ErrCallDataTooLarge if payload exceeds
+// EvmCallDataSizeLimit, since the sequencers of some chains reject such
+// transactions outright
+func (b *Txm) checkCallDataSize(payload []byte) error {
+	limit := b.config.EvmCallDataSizeLimit()
+	if limit == 0 || len(payload) <= int(limit) {
+		return nil
+	}
+	return errors.Wrapf(ErrCallDataTooLarge, "cannot send transaction on chain ID %s: calldata is %d bytes, which exceeds the limit of %d bytes", b.chainID.String(), len(payload), limit)
+}
+
 // GetGasEstimator returns the gas estimator, mostly useful for tests
 func (b *Txm) GetGasEstimator() gas.Estimator {
 	return b.gasEstimator
@@ -559,6 +752,9 @@ func (b *Txm) SendEther(chainID *big.Int, from, to common.Address, value assets.
 	if to == utils.ZeroAddress {
 		return etx, errors.New("cannot send ether to zero address")
 	}
+	if err = b.checkReadOnly(); err != nil {
+		return etx, err
+	}
 	etx = EthTx{
 		FromAddress:    from,
 		ToAddress:      to,
@@ -575,6 +771,245 @@ func (b *Txm) SendEther(chainID *big.Int, from, to common.Address, value assets.
 	return etx, errors.Wrap(err, "SendEther failed to insert eth_tx")
 }
 
+// Rebroadcast replaces the unconfirmed transaction that was sent with the
+// given hash. A new attempt with the same nonce and payload is signed by the
+// same key and priced as the EthConfirmer would bump the previous attempt.
+// Like a gas bump in the EthConfirmer, the attempt is saved as in_progress
+// before it is sent, so that it is never sent without being tracked, and only
+// one attempt per eth_tx can be in_progress at a time. This is intended for
+// manual recovery of stuck transactions.
+func (b *Txm) Rebroadcast(ctx context.Context, hash common.Hash) (attempt EthTxAttempt, err error) {
+	if err = b.checkReadOnly(); err != nil {
+		return attempt, err
+	}
+	q := b.q.WithOpts(pg.WithParentCtx(ctx))
+	var etx EthTx
+	err = q.Get(&etx, `
+SELECT eth_txes.* FROM eth_txes
+INNER JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id
+WHERE eth_tx_attempts.hash = $1 AND eth_txes.evm_chain_id = $2
+`, hash, b.chainID.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return attempt, errors.Errorf("no transaction found with hash %s on chain ID %s", hash.Hex(), b.chainID.String())
+	} else if err != nil {
+		return attempt, errors.Wrap(err, "Rebroadcast failed to load eth_tx")
+	}
+	if etx.State != EthTxUnconfirmed || etx.Nonce == nil {
+		return attempt, errors.Errorf("cannot rebroadcast transaction %s in state %s, only unconfirmed transactions can be rebroadcast", hash.Hex(), etx.State)
+	}
+	if err = b.checkEnabled(etx.FromAddress); err != nil {
+		return attempt, err
+	}
+
+	if err = loadEthTxAttempts(q, &etx); err != nil {
+		return attempt, errors.Wrap(err, "Rebroadcast failed to load eth_tx_attempts")
+	}
+	if len(etx.EthTxAttempts) == 0 {
+		return attempt, errors.Errorf("invariant violation: eth_tx %d has no attempts", etx.ID)
+	}
+
+	// A replacement must be priced above the previous attempt or the node
+	// rejects it as underpriced, so bump the highest priced attempt. The
+	// estimators bump to the current estimate instead if that is higher.
+	priorAttempts := make([]gas.PriorAttempt, len(etx.EthTxAttempts))
+	for i, a := range etx.EthTxAttempts {
+		priorAttempts[i] = a
+	}
+	previousAttempt := etx.EthTxAttempts[0]
+	keySpecificMaxGasPriceWei := b.config.KeySpecificMaxGasPriceWei(etx.FromAddress)
+	ks := NewChainKeyStore(b.chainID, b.config, b.keyStore)
+	switch previousAttempt.TxType {
+	case 0x0: // Legacy
+		gasPrice, gasLimit, berr := b.gasEstimator.BumpLegacyGas(ctx, previousAttempt.GasPrice, etx.GasLimit, keySpecificMaxGasPriceWei, priorAttempts)
+		if berr != nil {
+			return attempt, errors.Wrap(berr, "failed to bump gas")
+		}
+		attempt, err = ks.NewLegacyAttempt(etx, gasPrice, gasLimit)
+	case 0x2: // EIP1559
+		fee, gasLimit, berr := b.gasEstimator.BumpDynamicFee(ctx, previousAttempt.DynamicFee(), etx.GasLimit, keySpecificMaxGasPriceWei, priorAttempts)
+		if berr != nil {
+			return attempt, errors.Wrap(berr, "failed to bump dynamic gas fee")
+		}
+		attempt, err = ks.NewDynamicFeeAttempt(etx, fee, gasLimit)
+	default:
+		err = errors.Errorf("invariant violation: Attempt %v had unrecognised transaction type %v", previousAttempt.ID, previousAttempt.TxType)
+	}
+	if err != nil {
+		return attempt, errors.Wrap(err, "Rebroadcast failed to create new attempt")
+	}
+
+	if err = saveRebroadcastAttempt(q, &attempt); err != nil {
+		return attempt, err
+	}
+
+	lggr := b.logger.With("ethTxID", etx.ID, "nonce", *etx.Nonce, "previousHash", hash, "hash", attempt.Hash)
+	now := time.Now()
+	sendErr := sendTransaction(ctx, b.ethClient, attempt, etx, lggr)
+	if sendErr.IsTemporarilyUnderpriced() {
+		// As in the EthConfirmer, the node may still accept it once mempool
+		// pressure drops, so treat it as sent
+		lggr.Debugw("Transaction temporarily underpriced", "err", sendErr.Error())
+		sendErr = nil
+	}
+	switch {
+	case sendErr == nil:
+		lggr.Infow("Rebroadcast transaction", "gasPrice", attempt.GasPrice, "gasTipCap", attempt.GasTipCap, "gasFeeCap", attempt.GasFeeCap)
+		return attempt, saveSentAttempt(q, lggr, &attempt, now)
+	case sendErr.IsNonceTooLowError() || sendErr.IsTransactionAlreadyMined():
+		// A transaction with this nonce was already mined, the EthConfirmer
+		// fetches the receipt on the next head
+		if err = saveConfirmedMissingReceiptAttempt(q, lggr, &attempt, now); err != nil {
+			return attempt, err
+		}
+		return attempt, errors.Errorf("transaction %s was not rebroadcast, nonce %d has already been used on-chain", hash.Hex(), *etx.Nonce)
+	case sendErr.IsInsufficientEth():
+		// The EthConfirmer resends it at the same price once the key is funded
+		if err = saveInsufficientEthAttempt(q, lggr, &attempt, now); err != nil {
+			return attempt, err
+		}
+		return attempt, errors.Wrapf(sendErr, "failed to rebroadcast transaction %s, wallet %s is out of funds", hash.Hex(), etx.FromAddress.Hex())
+	case sendErr.Fatal() || sendErr.IsTerminallyUnderpriced() || sendErr.IsReplacementUnderpriced() || sendErr.IsTxFeeExceedsCap():
+		// The node rejected the attempt, so it cannot be on-chain
+		if err = deleteInProgressAttempt(q, attempt); err != nil {
+			return attempt, err
+		}
+		return attempt, errors.Wrapf(sendErr, "failed to rebroadcast transaction %s", hash.Hex())
+	default:
+		// The node may have it in the mempool, so the attempt is left
+		// in_progress for the EthConfirmer to resend on the next head
+		return attempt, errors.Wrapf(sendErr, "failed to rebroadcast transaction %s, it will be retried by the EthConfirmer", hash.Hex())
+	}
+}
+
+// saveRebroadcastAttempt inserts the in_progress attempt created by
+// Rebroadcast, provided that its eth_tx is still unconfirmed and is not being
+// bumped by the EthConfirmer
+func saveRebroadcastAttempt(q pg.Q, attempt *EthTxAttempt) error {
+	attempt.State = EthTxAttemptInProgress
+	err := q.Transaction(func(tx pg.Queryer) error {
+		var state EthTxState
+		if err := tx.Get(&state, `SELECT state FROM eth_txes WHERE id = $1 FOR UPDATE`, attempt.EthTxID); err != nil {
+			return errors.Wrap(err, "failed to lock eth_tx")
+		}
+		if state != EthTxUnconfirmed {
+			return errors.Errorf("cannot rebroadcast eth_tx %d in state %s, only unconfirmed transactions can be rebroadcast", attempt.EthTxID, state)
+		}
+		var inProgress bool
+		if err := tx.Get(&inProgress, `SELECT EXISTS(SELECT 1 FROM eth_tx_attempts WHERE eth_tx_id = $1 AND state = 'in_progress')`, attempt.EthTxID); err != nil {
+			return errors.Wrap(err, "failed to check for in_progress attempts")
+		}
+		if inProgress {
+			return errors.Errorf("cannot rebroadcast eth_tx %d, another attempt is already being sent", attempt.EthTxID)
+		}
+		query, args, err := tx.BindNamed(insertIntoEthTxAttemptsQuery, attempt)
+		if err != nil {
+			return errors.Wrap(err, "failed to BindNamed")
+		}
+		return errors.Wrap(tx.Get(attempt, query, args...), "failed to insert into eth_tx_attempts")
+	})
+	return errors.Wrap(err, "Rebroadcast failed to save attempt")
+}
+
+// fillNonceGaps sends a zero-value self-transfer for each enabled key whose
+// next on-chain nonce belongs to a transaction we have lost track of. Without
+// this every later transaction from that key would be stuck.
+//
+// This should only be called on startup after the nonces have been synced.
+func (b *Txm) fillNonceGaps(ctx context.Context, keyStates []ethkey.State) {
+	for _, keyState := range keyStates {
+		if keyState.Disabled {
+			continue
+		}
+		address := keyState.Address.Address()
+		if err := b.fillNonceGap(ctx, address); err != nil {
+			b.logger.Errorw("Failed to fill nonce gap", "address", address, "err", err)
+		}
+	}
+}
+
+func (b *Txm) fillNonceGap(ctx context.Context, address common.Address) error {
+	chainNonce, err := b.ethClient.PendingNonceAt(ctx, address)
+	if err != nil {
+		return errors.Wrap(err, "failed to get pending nonce")
+	}
+	localNonce, err := b.keyStore.GetNextNonce(address, &b.chainID, pg.WithParentCtx(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to get next nonce")
+	}
+	if localNonce <= int64(chainNonce) {
+		return nil
+	}
+
+	// If we still own a transaction with this nonce, the EthConfirmer will
+	// resend it and there is no gap to fill
+	q := b.q.WithOpts(pg.WithParentCtx(ctx))
+	var exists bool
+	err = q.Get(&exists, `SELECT EXISTS(SELECT 1 FROM eth_txes WHERE from_address = $1 AND evm_chain_id = $2 AND nonce = $3 AND state <> 'fatal_error')`, address, b.chainID.String(), chainNonce)
+	if err != nil {
+		return errors.Wrap(err, "failed to query for eth_tx")
+	}
+	if exists {
+		return nil
+	}
+
+	nonce := int64(chainNonce)
+	now := time.Now()
+	etx := EthTx{
+		Nonce:              &nonce,
+		FromAddress:        address,
+		ToAddress:          address,
+		EncodedPayload:     []byte{},
+		Value:              assets.NewEthValue(0),
+		GasLimit:           b.config.EvmGasLimitTransfer(),
+		BroadcastAt:        &now,
+		InitialBroadcastAt: &now,
+		CreatedAt:          now,
+		State:              EthTxUnconfirmed,
+		EVMChainID:         *utils.NewBig(&b.chainID),
+	}
+
+	// The gas estimator has not been started yet, the EthConfirmer will bump
+	// the default price if necessary
+	estimator := gas.NewFixedPriceEstimator(b.config, b.logger)
+	keySpecificMaxGasPriceWei := b.config.KeySpecificMaxGasPriceWei(address)
+	ks := NewChainKeyStore(b.chainID, b.config, b.keyStore)
+	var attempt EthTxAttempt
+	if b.config.EvmEIP1559DynamicFees() {
+		fee, gasLimit, ferr := estimator.GetDynamicFee(ctx, etx.GasLimit, keySpecificMaxGasPriceWei)
+		if ferr != nil {
+			return errors.Wrap(ferr, "failed to get dynamic gas fee")
+		}
+		attempt, err = ks.NewDynamicFeeAttempt(etx, fee, gasLimit)
+	} else {
+		gasPrice, gasLimit, gerr := estimator.GetLegacyGas(ctx, etx.EncodedPayload, etx.GasLimit, keySpecificMaxGasPriceWei)
+		if gerr != nil {
+			return errors.Wrap(gerr, "failed to estimate gas")
+		}
+		attempt, err = ks.NewLegacyAttempt(etx, gasPrice, gasLimit)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to create attempt")
+	}
+
+	lggr := b.logger.With("address", address, "nonce", nonce, "localNonce", localNonce, "hash", attempt.Hash)
+	if sendErr := sendTransaction(ctx, b.ethClient, attempt, etx, lggr); sendErr != nil && !sendErr.IsTransactionAlreadyInMempool() {
+		return errors.Wrapf(sendErr, "failed to send transaction with nonce %d", nonce)
+	}
+	lggr.Warnw(fmt.Sprintf("Filled nonce gap for address %s with a zero-value self-transfer at nonce %d", address.Hex(), nonce),
+		"gasPrice", attempt.GasPrice, "gasTipCap", attempt.GasTipCap, "gasFeeCap", attempt.GasFeeCap)
+
+	return q.Transaction(func(tx pg.Queryer) error {
+		if err := pg.PrepareQueryRowx(tx, `INSERT INTO eth_txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, broadcast_at, initial_broadcast_at, created_at, state, evm_chain_id) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :broadcast_at, :initial_broadcast_at, :created_at, :state, :evm_chain_id
+) RETURNING *`, &etx, &etx); err != nil {
+			return errors.Wrap(err, "failed to insert into eth_txes")
+		}
+		attempt.EthTxID = etx.ID
+		attempt.State = EthTxAttemptBroadcast
+		return errors.Wrap(pg.PrepareQueryRowx(tx, insertIntoEthTxAttemptsQuery, &attempt, &attempt), "failed to insert into eth_tx_attempts")
+	})
+}
+
 type ChainKeyStore struct {
 	chainID  big.Int
 	config   Config
@@ -586,7 +1021,7 @@ func NewChainKeyStore(chainID big.Int, config Config, keystore KeyStore) ChainKe
 }
 
 func (c *ChainKeyStore) SignTx(address common.Address, tx *gethTypes.Transaction) (common.Hash, []byte, error) {
-	signedTx, err := c.keystore.SignTx(address, tx, &c.chainID)
+	signedTx, err := c.keystore.SignTx(address, tx, c.signerChainID())
 	if err != nil {
 		return common.Hash{}, nil, errors.Wrap(err, "SignTx failed")
 	}
@@ -597,6 +1032,16 @@ func (c *ChainKeyStore) SignTx(address common.Address, tx *gethTypes.Transaction
 	return signedTx.Hash(), rlp.Bytes(), nil
 }
 
+// signerChainID returns the chain ID to sign transactions with, or nil if
+// EvmAllowUnprotectedTxs is set, which makes the keystore use the pre-EIP-155
+// Homestead signer
+func (c *ChainKeyStore) signerChainID() *big.Int {
+	if c.config.EvmAllowUnprotectedTxs() {
+		return nil
+	}
+	return &c.chainID
+}
+
 // send broadcasts the transaction to the ethereum network, writes any relevant
 // data onto the attempt and returns an error (or nil) depending on the status
 func sendTransaction(ctx context.Context, ethClient evmclient.Client, a EthTxAttempt, e EthTx, logger logger.Logger) *evmclient.SendError {
@@ -737,7 +1182,15 @@ func (n *NullTxManager) Reset(f func(), addr common.Address, abandon bool) error
 func (n *NullTxManager) SendEther(chainID *big.Int, from, to common.Address, value assets.Eth, gasLimit uint32) (etx EthTx, err error) {
 	return etx, errors.New(n.ErrMsg)
 }
+
+// Rebroadcast does nothing, null functionality
+func (n *NullTxManager) Rebroadcast(ctx context.Context, hash common.Hash) (attempt EthTxAttempt, err error) {
+	return attempt, errors.New(n.ErrMsg)
+}
 func (n *NullTxManager) Healthy() error                           { return nil }
 func (n *NullTxManager) Ready() error                             { return nil }
 func (n *NullTxManager) GetGasEstimator() gas.Estimator           { return nil }
 func (n *NullTxManager) RegisterResumeCallback(fn ResumeCallback) {}
+func (n *NullTxManager) RegisterExpiryCallback(fn ExpiryCallback) (unregister func()) {
+	return func() {}
+}
@@ -11,8 +11,10 @@ import (
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -21,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/forwarders"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -34,6 +37,7 @@ import (
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	pgmocks "github.com/smartcontractkit/chainlink/core/services/pg/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -53,7 +57,7 @@ func TestTxm_SendEther_DoesNotSendToZero(t *testing.T) {
 	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
 	lggr := logger.TestLogger(t)
 	checkerFactory := &testCheckerFactory{}
-	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)), ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000)
+	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)), ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000, 0, 0, false, nil)
 	txm := txmgr.NewTxm(db, ethClient, config, nil, nil, lggr, checkerFactory, lp)
 
 	_, err := txm.SendEther(big.NewInt(0), from, to, *value, 21000)
@@ -214,11 +218,12 @@ func TestTxm_CreateEthTransaction(t *testing.T) {
 	config.On("EthTxReaperThreshold").Return(time.Duration(0))
 	config.On("GasEstimatorMode").Return("FixedPrice")
 	config.On("LogSQL").Return(false)
+	config.On("EvmCallDataSizeLimit").Return(uint32(0))
 	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
 
 	lggr := logger.TestLogger(t)
 	checkerFactory := &testCheckerFactory{}
-	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)), ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000)
+	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)), ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000, 0, 0, false, nil)
 	txm := txmgr.NewTxm(db, ethClient, config, kst.Eth(), nil, lggr, checkerFactory, lp)
 
 	t.Run("with queue under capacity inserts eth_tx", func(t *testing.T) {
@@ -440,6 +445,86 @@ func TestTxm_CreateEthTransaction(t *testing.T) {
 
 		config.AssertExpectations(t)
 	})
+
+	t.Run("uses the transfer gas limit for a transaction without calldata or a gas limit", func(t *testing.T) {
+		pgtest.MustExec(t, db, `DELETE FROM eth_txes`)
+		config.On("EvmMaxQueuedTransactions").Return(uint64(1)).Once()
+		config.On("EvmGasLimitTransfer").Return(uint32(21000)).Once()
+
+		etx, err := txm.CreateEthTransaction(txmgr.NewTx{
+			FromAddress: fromAddress,
+			ToAddress:   toAddress,
+			Strategy:    txmgr.NewSendEveryStrategy(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(21000), etx.GasLimit)
+
+		config.AssertExpectations(t)
+	})
+
+	t.Run("keeps the caller's gas limit for a transaction without calldata", func(t *testing.T) {
+		pgtest.MustExec(t, db, `DELETE FROM eth_txes`)
+		config.On("EvmMaxQueuedTransactions").Return(uint64(1)).Once()
+
+		etx, err := txm.CreateEthTransaction(txmgr.NewTx{
+			FromAddress: fromAddress,
+			ToAddress:   toAddress,
+			GasLimit:    gasLimit,
+			Strategy:    txmgr.NewSendEveryStrategy(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, gasLimit, etx.GasLimit)
+
+		config.AssertExpectations(t)
+	})
+
+	t.Run("uses the contract creation gas limit for a contract creation transaction", func(t *testing.T) {
+		pgtest.MustExec(t, db, `DELETE FROM eth_txes`)
+		config.On("EvmMaxQueuedTransactions").Return(uint64(1)).Once()
+		config.On("EvmContractCreationGasLimit").Return(uint32(5_000_000)).Once()
+
+		etx, err := txm.CreateEthTransaction(txmgr.NewTx{
+			FromAddress:      fromAddress,
+			EncodedPayload:   payload,
+			GasLimit:         gasLimit,
+			ContractCreation: true,
+			Strategy:         txmgr.NewSendEveryStrategy(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(5_000_000), etx.GasLimit)
+		assert.True(t, etx.ContractCreation)
+
+		config.AssertExpectations(t)
+	})
+
+	t.Run("treats a transaction to the zero address as a call", func(t *testing.T) {
+		pgtest.MustExec(t, db, `DELETE FROM eth_txes`)
+		config.On("EvmMaxQueuedTransactions").Return(uint64(1)).Once()
+
+		etx, err := txm.CreateEthTransaction(txmgr.NewTx{
+			FromAddress:    fromAddress,
+			EncodedPayload: payload,
+			GasLimit:       gasLimit,
+			Strategy:       txmgr.NewSendEveryStrategy(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, gasLimit, etx.GasLimit)
+		assert.False(t, etx.ContractCreation)
+
+		config.AssertExpectations(t)
+	})
+
+	t.Run("rejects a contract creation transaction with a to address", func(t *testing.T) {
+		_, err := txm.CreateEthTransaction(txmgr.NewTx{
+			FromAddress:      fromAddress,
+			ToAddress:        testutils.NewAddress(),
+			EncodedPayload:   payload,
+			GasLimit:         gasLimit,
+			ContractCreation: true,
+			Strategy:         txmgr.NewSendEveryStrategy(),
+		})
+		require.ErrorContains(t, err, "contract creation transaction cannot have a to address")
+	})
 }
 
 func newMockTxStrategy(t *testing.T) *txmmocks.TxStrategy {
@@ -454,6 +539,8 @@ func newMockConfig(t *testing.T) *txmmocks.Config {
 	cfg.On("EvmMaxInFlightTransactions").Return(uint32(42)).Maybe()
 	cfg.On("EvmMaxQueuedTransactions").Return(uint64(42)).Maybe().Once()
 	cfg.On("EvmNonceAutoSync").Return(true).Maybe()
+	cfg.On("EvmNonceAutoFillGap").Return(false).Maybe()
+	cfg.On("EvmDatabaseQueryTimeout").Return(pg.DefaultQueryTimeout).Maybe()
 	cfg.On("EvmGasLimitDefault").Return(uint32(42)).Maybe().Once()
 	cfg.On("BlockHistoryEstimatorBatchSize").Return(uint32(42)).Maybe().Once()
 	cfg.On("BlockHistoryEstimatorBlockDelay").Return(uint16(42)).Maybe().Once()
@@ -463,6 +550,10 @@ func newMockConfig(t *testing.T) *txmmocks.Config {
 	cfg.On("EvmEIP1559DynamicFees").Return(false).Maybe().Once()
 	cfg.On("EvmGasBumpPercent").Return(uint16(42)).Maybe().Once()
 	cfg.On("EvmGasBumpThreshold").Return(uint64(42)).Maybe()
+	cfg.On("EvmGasBumpInterval").Return(time.Duration(0)).Maybe()
+	cfg.On("EvmTxExpiry").Return(time.Duration(0)).Maybe()
+	cfg.On("EvmReceiptPollingInterval").Return(time.Duration(0)).Maybe()
+	cfg.On("EvmMaxRevertedTransactionsPerBlock").Return(uint16(0)).Maybe()
 	cfg.On("EvmGasBumpWei").Return(assets.NewWeiI(42)).Maybe().Once()
 	cfg.On("EvmGasFeeCapDefault").Return(assets.NewWeiI(42)).Maybe().Once()
 	cfg.On("EvmGasLimitMultiplier").Return(float32(42)).Maybe().Once()
@@ -471,12 +562,68 @@ func newMockConfig(t *testing.T) *txmmocks.Config {
 	cfg.On("EvmGasTipCapMinimum").Return(assets.NewWeiI(42)).Maybe().Once()
 	cfg.On("EvmMaxGasPriceWei").Return(assets.NewWeiI(42)).Maybe().Once()
 	cfg.On("EvmMinGasPriceWei").Return(assets.NewWeiI(42)).Maybe().Once()
+	cfg.On("EvmGasPriceFloor").Return(assets.NewWeiI(0)).Maybe()
+	cfg.On("EvmGasFeeCapRounding").Return(nil).Maybe()
+	cfg.On("EvmMaxGasPriceWarningThresholdPercent").Return(uint8(0)).Maybe()
+	cfg.On("EvmGasPriceCacheTTL").Return(time.Duration(0)).Maybe()
 	cfg.On("EvmUseForwarders").Return(true).Maybe()
+	cfg.On("EvmGasEstimatorFallbackMode").Return("FixedPrice").Maybe()
+	cfg.On("EvmGasEstimatorCheckRetries").Return(uint8(0)).Maybe()
+	cfg.On("EvmGasPriceFeedEnabled").Return(false).Maybe()
+	cfg.On("EvmMinimumFeeMarket").Return(false).Maybe()
+	cfg.On("EvmConfigReadOnly").Return(false).Maybe()
+	cfg.On("EvmTxPriorityQueue").Return(false).Maybe()
+	cfg.On("EvmAllowUnprotectedTxs").Return(false).Maybe()
 	cfg.On("LogSQL").Maybe().Return(false)
 
 	return cfg
 }
 
+func TestTxm_CreateEthTransaction_CallDataSizeLimit(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	kst := cltest.NewKeyStore(t, db, cfg)
+	_, fromAddress := cltest.MustInsertRandomKey(t, kst.Eth(), 0)
+
+	config := newMockConfig(t)
+	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
+	config.On("EthTxReaperThreshold").Return(time.Duration(0))
+	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("LogSQL").Return(false)
+	config.On("EvmCallDataSizeLimit").Return(uint32(3))
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	lggr := logger.TestLogger(t)
+	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)), ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000, 0, 0, false, nil)
+	txm := txmgr.NewTxm(db, ethClient, config, kst.Eth(), nil, lggr, &testCheckerFactory{}, lp)
+
+	t.Run("accepts calldata up to the limit", func(t *testing.T) {
+		config.On("EvmMaxQueuedTransactions").Return(uint64(1)).Once()
+		_, err := txm.CreateEthTransaction(txmgr.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			GasLimit:       1000,
+			Strategy:       txmgr.SendEveryStrategy{},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects calldata over the limit", func(t *testing.T) {
+		_, err := txm.CreateEthTransaction(txmgr.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3, 4},
+			GasLimit:       1000,
+			Strategy:       txmgr.SendEveryStrategy{},
+		})
+		require.ErrorIs(t, err, txmgr.ErrCallDataTooLarge)
+		assert.Contains(t, err.Error(), "calldata is 4 bytes, which exceeds the limit of 3 bytes")
+		cltest.AssertCount(t, db, "eth_txes", 1)
+	})
+}
+
 func TestTxm_CreateEthTransaction_OutOfEth(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := configtest.NewGeneralConfig(t, nil)
@@ -495,10 +642,11 @@ func TestTxm_CreateEthTransaction_OutOfEth(t *testing.T) {
 	config.On("EthTxReaperThreshold").Return(time.Duration(0))
 	config.On("GasEstimatorMode").Return("FixedPrice")
 	config.On("LogSQL").Return(false)
+	config.On("EvmCallDataSizeLimit").Return(uint32(0))
 
 	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
 	lggr := logger.TestLogger(t)
-	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)), ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000)
+	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)), ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000, 0, 0, false, nil)
 	kst := cltest.NewKeyStore(t, db, cfg)
 	txm := txmgr.NewTxm(db, ethClient, config, kst.Eth(), nil, lggr, &testCheckerFactory{}, lp)
 
@@ -570,59 +718,76 @@ func TestTxm_CreateEthTransaction_OutOfEth(t *testing.T) {
 	})
 }
 
-func TestTxm_Lifecycle(t *testing.T) {
-	db := pgtest.NewSqlxDB(t)
-
-	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
-	config := newMockConfig(t)
-	kst := ksmocks.NewEth(t)
-	eventBroadcaster := pgmocks.NewEventBroadcaster(t)
-
-	config.On("EthTxResendAfterThreshold").Return(1 * time.Hour)
-	config.On("EthTxReaperThreshold").Return(1 * time.Hour)
-	config.On("EthTxReaperInterval").Return(1 * time.Hour)
-	config.On("EvmMaxInFlightTransactions").Return(uint32(42))
-	config.On("EvmFinalityDepth").Maybe().Return(uint32(42))
-	config.On("GasEstimatorMode").Return("FixedPrice")
-	config.On("LogSQL").Return(false).Maybe()
-	config.On("EvmRPCDefaultBatchSize").Return(uint32(4)).Maybe()
-	kst.On("GetStatesForChain", &cltest.FixtureChainID).Return([]ethkey.State{}, nil).Once()
-
-	keyChangeCh := make(chan struct{})
-	unsub := cltest.NewAwaiter()
-	kst.On("SubscribeToKeyChanges").Return(keyChangeCh, unsub.ItHappened)
-	lggr := logger.TestLogger(t)
-	checkerFactory := &testCheckerFactory{}
-
-	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)), ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000)
-	txm := txmgr.NewTxm(db, ethClient, config, kst, eventBroadcaster, lggr, checkerFactory, lp)
-
-	head := cltest.Head(42)
-	// It should not hang or panic
-	txm.OnNewLongestChain(testutils.Context(t), head)
-
-	sub := pgmocks.NewSubscription(t)
-	sub.On("Events").Return(make(<-chan pg.Event))
-	eventBroadcaster.On("Subscribe", "insert_on_eth_txes", "").Return(sub, nil)
-	config.On("EvmGasBumpThreshold").Return(uint64(1))
-
-	require.NoError(t, txm.Start(testutils.Context(t)))
-
-	ctx, cancel := context.WithTimeout(testutils.Context(t), 5*time.Second)
-	t.Cleanup(cancel)
-	txm.OnNewLongestChain(ctx, head)
-	require.NoError(t, ctx.Err())
-
-	keyState := cltest.MustGenerateRandomKeyState(t)
+// nonceAutoFillGapConfig enables EvmNonceAutoFillGap, which newMockConfig
+// stubs to false
+type nonceAutoFillGapConfig struct {
+	*txmmocks.Config
+}
 
-	kst.On("GetStatesForChain", &cltest.FixtureChainID).Return([]ethkey.State{keyState}, nil).Once()
-	sub.On("Close").Return()
-	ethClient.On("PendingNonceAt", mock.AnythingOfType("*context.cancelCtx"), keyState.Address.Address()).Return(uint64(0), nil).Maybe()
-	config.On("TriggerFallbackDBPollInterval").Return(1 * time.Hour).Maybe()
-	keyChangeCh <- struct{}{}
+func (nonceAutoFillGapConfig) EvmNonceAutoFillGap() bool { return true }
 
-	require.NoError(t, txm.Close())
-	unsub.AwaitOrFail(t, 1*time.Second)
+func TestTxm_Lifecycle(t *testing.T) {
+	for _, autoFillGap := range []bool{false, true} {
+		autoFillGap := autoFillGap
+		t.Run(fmt.Sprintf("EvmNonceAutoFillGap=%t", autoFillGap), func(t *testing.T) {
+			db := pgtest.NewSqlxDB(t)
+
+			ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+			config := newMockConfig(t)
+			kst := ksmocks.NewEth(t)
+			eventBroadcaster := pgmocks.NewEventBroadcaster(t)
+
+			config.On("EthTxResendAfterThreshold").Return(1 * time.Hour)
+			config.On("EthTxReaperThreshold").Return(1 * time.Hour)
+			config.On("EthTxReaperInterval").Return(1 * time.Hour)
+			config.On("EvmMaxInFlightTransactions").Return(uint32(42))
+			config.On("EvmFinalityDepth").Maybe().Return(uint32(42))
+			config.On("GasEstimatorMode").Return("FixedPrice")
+			config.On("LogSQL").Return(false).Maybe()
+			config.On("EvmRPCDefaultBatchSize").Return(uint32(4)).Maybe()
+			kst.On("GetStatesForChain", &cltest.FixtureChainID).Return([]ethkey.State{}, nil).Once()
+
+			keyChangeCh := make(chan struct{})
+			unsub := cltest.NewAwaiter()
+			kst.On("SubscribeToKeyChanges").Return(keyChangeCh, unsub.ItHappened)
+			lggr := logger.TestLogger(t)
+			checkerFactory := &testCheckerFactory{}
+
+			lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)), ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000, 0, 0, false, nil)
+			var cfg txmgr.Config = config
+			if autoFillGap {
+				cfg = nonceAutoFillGapConfig{config}
+			}
+			txm := txmgr.NewTxm(db, ethClient, cfg, kst, eventBroadcaster, lggr, checkerFactory, lp)
+
+			head := cltest.Head(42)
+			// It should not hang or panic
+			txm.OnNewLongestChain(testutils.Context(t), head)
+
+			sub := pgmocks.NewSubscription(t)
+			sub.On("Events").Return(make(<-chan pg.Event))
+			eventBroadcaster.On("Subscribe", "insert_on_eth_txes", "").Return(sub, nil)
+			config.On("EvmGasBumpThreshold").Return(uint64(1))
+
+			require.NoError(t, txm.Start(testutils.Context(t)))
+
+			ctx, cancel := context.WithTimeout(testutils.Context(t), 5*time.Second)
+			t.Cleanup(cancel)
+			txm.OnNewLongestChain(ctx, head)
+			require.NoError(t, ctx.Err())
+
+			keyState := cltest.MustGenerateRandomKeyState(t)
+
+			kst.On("GetStatesForChain", &cltest.FixtureChainID).Return([]ethkey.State{keyState}, nil).Once()
+			sub.On("Close").Return()
+			ethClient.On("PendingNonceAt", mock.AnythingOfType("*context.cancelCtx"), keyState.Address.Address()).Return(uint64(0), nil).Maybe()
+			config.On("TriggerFallbackDBPollInterval").Return(1 * time.Hour).Maybe()
+			keyChangeCh <- struct{}{}
+
+			require.NoError(t, txm.Close())
+			unsub.AwaitOrFail(t, 1*time.Second)
+		})
+	}
 }
 
 func TestTxm_SignTx(t *testing.T) {
@@ -642,6 +807,7 @@ func TestTxm_SignTx(t *testing.T) {
 	t.Run("returns correct hash for non-okex chains", func(t *testing.T) {
 		chainID := big.NewInt(1)
 		cfg := txmmocks.NewConfig(t)
+		cfg.On("EvmAllowUnprotectedTxs").Return(false)
 		kst := ksmocks.NewEth(t)
 		kst.On("SignTx", to, tx, chainID).Return(tx, nil).Once()
 		cks := txmgr.NewChainKeyStore(*chainID, cfg, kst)
@@ -654,6 +820,7 @@ func TestTxm_SignTx(t *testing.T) {
 	t.Run("returns correct hash for okex chains", func(t *testing.T) {
 		chainID := big.NewInt(1)
 		cfg := txmmocks.NewConfig(t)
+		cfg.On("EvmAllowUnprotectedTxs").Return(false)
 		kst := ksmocks.NewEth(t)
 		kst.On("SignTx", to, tx, chainID).Return(tx, nil).Once()
 		cks := txmgr.NewChainKeyStore(*chainID, cfg, kst)
@@ -662,6 +829,17 @@ func TestTxm_SignTx(t *testing.T) {
 		require.NotNil(t, rawBytes)
 		require.Equal(t, "0xdd68f554373fdea7ec6713a6e437e7646465d553a6aa0b43233093366cc87ef0", hash.Hex())
 	})
+	t.Run("signs without a chain ID if unprotected transactions are allowed", func(t *testing.T) {
+		chainID := big.NewInt(1)
+		cfg := txmmocks.NewConfig(t)
+		cfg.On("EvmAllowUnprotectedTxs").Return(true)
+		kst := ksmocks.NewEth(t)
+		kst.On("SignTx", to, tx, (*big.Int)(nil)).Return(tx, nil).Once()
+		cks := txmgr.NewChainKeyStore(*chainID, cfg, kst)
+		_, rawBytes, err := cks.SignTx(addr, tx)
+		require.NoError(t, err)
+		require.NotNil(t, rawBytes)
+	})
 }
 
 type fnMock struct{ called atomic.Bool }
@@ -761,6 +939,90 @@ func TestTxm_Reset(t *testing.T) {
 	})
 }
 
+func TestTxm_ExpireEthTxes(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	gcfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.Expiry = models.MustNewDuration(time.Hour)
+	})
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+	kst := cltest.NewKeyStore(t, db, cfg)
+
+	_, addr := cltest.MustInsertRandomKey(t, kst.Eth(), 5)
+	_, addr2 := cltest.MustInsertRandomKey(t, kst.Eth(), 1)
+	borm := cltest.NewTxmORM(t, db, cfg)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	txm := txmgr.NewTxm(db, ethClient, cfg, kst.Eth(), nil, logger.TestLogger(t), nil, nil)
+
+	upkeepMeta := `{"JobID": 1, "UpkeepID": "UPx0000000000000000000000000000000000000000000000000000000000000001"}`
+	old := time.Now().Add(-2 * time.Hour)
+	// an expired keeper transaction which has not been picked up for broadcasting yet
+	etx1 := cltest.MustInsertUnstartedEthTx(t, borm, addr)
+	// an expired keeper transaction whose attempt may already have been sent
+	etx2 := cltest.MustInsertInProgressEthTxWithAttempt(t, borm, 3, addr)
+	// an expired keeper transaction which has already been broadcast
+	etx3 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 2, addr)
+	// a recent keeper transaction
+	etx4 := cltest.MustInsertUnstartedEthTx(t, borm, addr2)
+	// an expired transaction which is not a keeper one
+	etx5 := cltest.MustInsertUnstartedEthTx(t, borm, addr2)
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET meta = $1 WHERE id = ANY($2)`, upkeepMeta, pq.Array([]int64{etx1.ID, etx2.ID, etx3.ID, etx4.ID}))
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET created_at = $1 WHERE id = ANY($2)`, old, pq.Array([]int64{etx1.ID, etx2.ID, etx3.ID, etx5.ID}))
+
+	runID := uuid.NewV4()
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET pipeline_task_run_id = $1 WHERE id = $2`, runID, etx1.ID)
+	var resumed []uuid.UUID
+	txm.RegisterResumeCallback(func(id uuid.UUID, result interface{}, err error) error {
+		assert.EqualError(t, err, "transaction expired after 1h0m0s without being broadcast")
+		resumed = append(resumed, id)
+		return nil
+	})
+	var notified []int64
+	txm.RegisterExpiryCallback(func(etx txmgr.EthTx) {
+		notified = append(notified, etx.ID)
+	})
+	unregister := txm.RegisterExpiryCallback(func(etx txmgr.EthTx) {
+		t.Errorf("unregistered callback called for %d", etx.ID)
+	})
+	unregister()
+
+	require.NoError(t, txmgr.ExpireEthTxes(testutils.Context(t), txm))
+
+	etx, err := borm.FindEthTxWithAttempts(etx1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgr.EthTxFatalError, etx.State)
+	assert.Equal(t, "expired", etx.Error.String)
+
+	// the in_progress transaction keeps its nonce and attempt
+	etx, err = borm.FindEthTxWithAttempts(etx2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgr.EthTxInProgress, etx.State)
+	require.NotNil(t, etx.Nonce)
+	assert.Equal(t, int64(3), *etx.Nonce)
+	require.Len(t, etx.EthTxAttempts, 1)
+	assert.Equal(t, txmgr.EthTxAttemptInProgress, etx.EthTxAttempts[0].State)
+
+	for id, state := range map[int64]txmgr.EthTxState{
+		etx3.ID: txmgr.EthTxUnconfirmed,
+		etx4.ID: txmgr.EthTxUnstarted,
+		etx5.ID: txmgr.EthTxUnstarted,
+	} {
+		etx, err := borm.FindEthTxWithAttempts(id)
+		require.NoError(t, err)
+		assert.Equal(t, state, etx.State)
+	}
+
+	// the nonce of the key is left alone
+	nonce, err := kst.Eth().GetNextNonce(addr, &cltest.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), nonce)
+
+	assert.Equal(t, []uuid.UUID{runID}, resumed)
+	assert.Equal(t, []int64{etx1.ID}, notified)
+}
+
 func TestTxmgr_AssignsNonceOnStart(t *testing.T) {
 	var err error
 	db := pgtest.NewSqlxDB(t)
@@ -835,3 +1097,219 @@ func TestTxmgr_AssignsNonceOnStart(t *testing.T) {
 		assert.Equal(t, int64(0), nonce)
 	})
 }
+
+func TestTxm_FillsNonceGapOnStart(t *testing.T) {
+	t.Parallel()
+
+	eventBroadcaster := pgmocks.NewEventBroadcaster(t)
+	sub := pgmocks.NewSubscription(t)
+	sub.On("Events").Return(make(<-chan pg.Event))
+	sub.On("Close")
+	eventBroadcaster.On("Subscribe", "insert_on_eth_txes", "").Return(sub, nil)
+
+	setup := func(t *testing.T) (*sqlx.DB, txmgr.ORM, *evmmocks.Client, *txmgr.Txm, gethCommon.Address) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.EVM[0].NonceAutoSync = ptr(true)
+			c.EVM[0].NonceAutoFillGap = ptr(true)
+			c.EVM[0].GasEstimator.Mode = ptr("FixedPrice")
+			c.EVM[0].GasEstimator.PriceDefault = assets.GWei(30)
+			c.EVM[0].Transactions.ResendAfterThreshold = models.MustNewDuration(0)
+		})
+		evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+		kst := cltest.NewKeyStore(t, db, cfg).Eth()
+		borm := cltest.NewTxmORM(t, db, cfg)
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, kst, 5)
+
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, nil)
+		ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(3), nil)
+
+		txm := txmgr.NewTxm(db, ethClient, evmcfg, kst, eventBroadcaster, logger.TestLogger(t), &testCheckerFactory{}, nil)
+		return db, borm, ethClient, txm, fromAddress
+	}
+
+	t.Run("sends a zero-value self-transfer at the gap nonce", func(t *testing.T) {
+		db, borm, ethClient, txm, fromAddress := setup(t)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 4, fromAddress)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethtypes.Transaction) bool {
+			return tx.Nonce() == 3 && *tx.To() == fromAddress && tx.Value().Sign() == 0 && tx.GasPrice().Cmp(assets.GWei(30).ToInt()) == 0
+		})).Return(nil).Once()
+
+		require.NoError(t, txm.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, txm.Close()) })
+
+		cltest.AssertCount(t, db, "eth_txes", 2)
+		var etx txmgr.EthTx
+		require.NoError(t, db.Get(&etx, `SELECT * FROM eth_txes WHERE nonce = 3`))
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+		assert.Equal(t, fromAddress, etx.ToAddress)
+		require.Len(t, etx.EthTxAttempts, 1)
+		assert.Equal(t, txmgr.EthTxAttemptBroadcast, etx.EthTxAttempts[0].State)
+	})
+
+	t.Run("does nothing if the transaction at the gap nonce is known", func(t *testing.T) {
+		db, borm, _, txm, fromAddress := setup(t)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 3, fromAddress)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 4, fromAddress)
+
+		require.NoError(t, txm.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, txm.Close()) })
+
+		cltest.AssertCount(t, db, "eth_txes", 2)
+	})
+}
+
+func TestTxm_Rebroadcast(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	gcfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].GasEstimator.Mode = ptr("FixedPrice")
+		c.EVM[0].GasEstimator.PriceDefault = assets.GWei(30)
+	})
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+	kst := cltest.NewKeyStore(t, db, cfg)
+	borm := cltest.NewTxmORM(t, db, cfg)
+	_, fromAddress := cltest.MustInsertRandomKey(t, kst.Eth(), 0)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	txm := txmgr.NewTxm(db, ethClient, cfg, kst.Eth(), nil, logger.TestLogger(t), nil, nil)
+
+	t.Run("returns error if the transaction does not exist", func(t *testing.T) {
+		hash := utils.NewHash()
+		_, err := txm.Rebroadcast(testutils.Context(t), hash)
+		require.EqualError(t, err, fmt.Sprintf("no transaction found with hash %s on chain ID %s", hash.Hex(), cltest.FixtureChainID.String()))
+	})
+
+	t.Run("returns error if the transaction is already confirmed", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 0, 1, fromAddress)
+		hash := etx.EthTxAttempts[0].Hash
+		_, err := txm.Rebroadcast(testutils.Context(t), hash)
+		require.EqualError(t, err, fmt.Sprintf("cannot rebroadcast transaction %s in state confirmed, only unconfirmed transactions can be rebroadcast", hash.Hex()))
+	})
+
+	t.Run("sends and saves a replacement attempt with the same nonce and payload", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, fromAddress)
+		previousHash := etx.EthTxAttempts[0].Hash
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethtypes.Transaction) bool {
+			return tx.Nonce() == uint64(1) &&
+				tx.GasPrice().Cmp(assets.GWei(30).ToInt()) == 0 &&
+				assert.Equal(t, etx.EncodedPayload, tx.Data()) &&
+				assert.Equal(t, etx.ToAddress, *tx.To())
+		})).Return(nil).Once()
+
+		attempt, err := txm.Rebroadcast(testutils.Context(t), previousHash)
+		require.NoError(t, err)
+		assert.NotEqual(t, previousHash, attempt.Hash)
+		assert.Equal(t, txmgr.EthTxAttemptBroadcast, attempt.State)
+		assert.Equal(t, etx.ID, attempt.EthTxID)
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 2)
+		assert.Equal(t, attempt.Hash, etx.EthTxAttempts[0].Hash)
+		assert.Equal(t, assets.GWei(30), etx.EthTxAttempts[0].GasPrice)
+	})
+
+	t.Run("bumps the gas price of the previous attempt if it is above the current estimate", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 3, fromAddress)
+		previous := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+		previous.GasPrice = assets.GWei(40)
+		previous.State = txmgr.EthTxAttemptBroadcast
+		require.NoError(t, borm.InsertEthTxAttempt(&previous))
+
+		// 40 gwei bumped by the default 20%
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethtypes.Transaction) bool {
+			return tx.Nonce() == uint64(3) && tx.GasPrice().Cmp(assets.GWei(48).ToInt()) == 0
+		})).Return(nil).Once()
+
+		attempt, err := txm.Rebroadcast(testutils.Context(t), previous.Hash)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(48), attempt.GasPrice)
+	})
+
+	t.Run("deletes the attempt if the node rejects it", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 2, fromAddress)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(errors.New("replacement transaction underpriced")).Once()
+
+		_, err := txm.Rebroadcast(testutils.Context(t), etx.EthTxAttempts[0].Hash)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "replacement transaction underpriced")
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Len(t, etx.EthTxAttempts, 1)
+	})
+
+	t.Run("saves the attempt if the node already knows it", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 4, fromAddress)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethtypes.Transaction) bool {
+			return tx.Nonce() == uint64(4)
+		})).Return(errors.New("already known")).Once()
+
+		attempt, err := txm.Rebroadcast(testutils.Context(t), etx.EthTxAttempts[0].Hash)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxAttemptBroadcast, attempt.State)
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 2)
+		assert.Equal(t, txmgr.EthTxAttemptBroadcast, etx.EthTxAttempts[0].State)
+	})
+
+	t.Run("marks the transaction confirmed_missing_receipt if the nonce has been used", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 5, fromAddress)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethtypes.Transaction) bool {
+			return tx.Nonce() == uint64(5)
+		})).Return(errors.New("nonce too low")).Once()
+
+		_, err := txm.Rebroadcast(testutils.Context(t), etx.EthTxAttempts[0].Hash)
+		require.EqualError(t, err, fmt.Sprintf("transaction %s was not rebroadcast, nonce 5 has already been used on-chain", etx.EthTxAttempts[0].Hash.Hex()))
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxConfirmedMissingReceipt, etx.State)
+		require.Len(t, etx.EthTxAttempts, 2)
+		assert.Equal(t, txmgr.EthTxAttemptBroadcast, etx.EthTxAttempts[0].State)
+	})
+
+	t.Run("leaves the attempt in_progress for the EthConfirmer if sending fails for an unknown reason", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 6, fromAddress)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethtypes.Transaction) bool {
+			return tx.Nonce() == uint64(6)
+		})).Return(errors.New("context deadline exceeded")).Once()
+
+		_, err := txm.Rebroadcast(testutils.Context(t), etx.EthTxAttempts[0].Hash)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "it will be retried by the EthConfirmer")
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 2)
+		assert.Equal(t, txmgr.EthTxAttemptInProgress, etx.EthTxAttempts[0].State)
+	})
+
+	t.Run("returns error if another attempt is already in progress", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 7, fromAddress)
+		inProgress := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+		inProgress.GasPrice = assets.GWei(40)
+		inProgress.State = txmgr.EthTxAttemptInProgress
+		require.NoError(t, borm.InsertEthTxAttempt(&inProgress))
+
+		_, err := txm.Rebroadcast(testutils.Context(t), etx.EthTxAttempts[0].Hash)
+		require.EqualError(t, err, fmt.Sprintf("Rebroadcast failed to save attempt: cannot rebroadcast eth_tx %d, another attempt is already being sent", etx.ID))
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Len(t, etx.EthTxAttempts, 2)
+	})
+}
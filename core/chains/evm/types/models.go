@@ -86,6 +86,18 @@ func (h *Head) HashAtHeight(blockNum int64) common.Hash {
 	return common.Hash{}
 }
 
+// HeadAtHeight returns the head at the given height, if it is in the chain.
+// If not in chain, returns nil
+func (h *Head) HeadAtHeight(blockNum int64) *Head {
+	for h != nil {
+		if h.Number == blockNum {
+			return h
+		}
+		h = h.Parent
+	}
+	return nil
+}
+
 // ChainLength returns the length of the chain followed by recursively looking up parents
 func (h *Head) ChainLength() uint32 {
 	if h == nil {
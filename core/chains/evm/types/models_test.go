@@ -223,6 +223,24 @@ func TestHead_EarliestInChain(t *testing.T) {
 	assert.Equal(t, int64(1), head.EarliestInChain().Number)
 }
 
+func TestHead_HeadAtHeight(t *testing.T) {
+	head := evmtypes.Head{
+		Number: 3,
+		Parent: &evmtypes.Head{
+			Number: 2,
+			Parent: &evmtypes.Head{
+				Number: 1,
+			},
+		},
+	}
+
+	assert.Same(t, &head, head.HeadAtHeight(3))
+	assert.Same(t, head.Parent, head.HeadAtHeight(2))
+	assert.Equal(t, int64(1), head.HeadAtHeight(1).Number)
+	assert.Nil(t, head.HeadAtHeight(0))
+	assert.Nil(t, head.HeadAtHeight(4))
+}
+
 func TestHead_IsInChain(t *testing.T) {
 	hash1 := utils.NewHash()
 	hash2 := utils.NewHash()
@@ -2,14 +2,20 @@ package types
 
 import (
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"hash/fnv"
 	"math/big"
+	"reflect"
+	"sort"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"golang.org/x/exp/maps"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -32,6 +38,7 @@ type NewNode struct {
 type ChainConfigORM interface {
 	StoreString(chainID utils.Big, key, val string) error
 	Clear(chainID utils.Big, key string) error
+	GetAuditLog(chainID utils.Big, key string) ([]ConfigAuditLogEntry, error)
 }
 
 type ORM interface {
@@ -62,6 +69,8 @@ type ChainCfg struct {
 	BlockHistoryEstimatorBlockHistorySize          null.Int
 	BlockHistoryEstimatorEIP1559FeeCapBufferBlocks null.Int
 	ChainType                                      null.String
+	ChainAlias                                     null.String
+	EvmChainLocked                                 null.Bool
 	EthTxReaperThreshold                           *models.Duration
 	EthTxResendAfterThreshold                      *models.Duration
 	EvmEIP1559DynamicFees                          null.Bool
@@ -79,6 +88,8 @@ type ChainCfg struct {
 	EvmGasLimitFMJobType                           null.Int
 	EvmGasLimitKeeperJobType                       null.Int
 	EvmGasPriceDefault                             *assets.Wei
+	EvmGasPriceAutoAdjust                          null.Bool
+	EvmGasPriceAutoAdjustInterval                  *models.Duration
 	EvmGasTipCapDefault                            *assets.Wei
 	EvmGasTipCapMinimum                            *assets.Wei
 	EvmHeadTrackerHistoryDepth                     null.Int
@@ -88,16 +99,20 @@ type ChainCfg struct {
 	EvmLogPollInterval                             *models.Duration
 	EvmLogKeepBlocksDepth                          null.Int
 	EvmMaxGasPriceWei                              *assets.Wei
+	EvmMaxGasPriceExemptAddresses                  []common.Address
 	EvmNonceAutoSync                               null.Bool
 	EvmUseForwarders                               null.Bool
+	EvmL2SequencerHTTPURL                          null.String
 	EvmRPCDefaultBatchSize                         null.Int
 	FlagsContractAddress                           null.String
+	EvmFeatureFlags                                FeatureFlags
 	GasEstimatorMode                               null.String
 	KeySpecific                                    map[string]ChainCfg
 	LinkContractAddress                            null.String
 	OperatorFactoryAddress                         null.String
 	MinIncomingConfirmations                       null.Int
 	MinimumContractPayment                         *assets.Link
+	OCRKeyBundleID                                 null.String
 	OCRObservationTimeout                          *models.Duration
 	NodeNoNewHeadsThreshold                        *models.Duration
 }
@@ -115,8 +130,65 @@ func (c *ChainCfg) Value() (driver.Value, error) {
 	return json.Marshal(c)
 }
 
+// FeatureFlags maps feature names to the fraction, from 0.0 to 1.0, of
+// subjects (such as jobs) for which the feature is enabled.
+type FeatureFlags map[string]float64
+
+// Enabled returns true if the feature called name is enabled for the subject
+// identified by seed. The decision is deterministic, so a subject keeps its
+// feature set across restarts, and features with the same percentage are not
+// enabled for the same subjects. Unknown features are disabled.
+func (f FeatureFlags) Enabled(name string, seed uint64) bool {
+	percentage, ok := f[name]
+	if !ok {
+		return false
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	_ = binary.Write(h, binary.BigEndian, seed)
+	return float64(h.Sum64()%100) < percentage*100
+}
+
+// Validate returns an error for every percentage outside of [0, 1], including NaN.
+func (f FeatureFlags) Validate() (err error) {
+	names := maps.Keys(f)
+	sort.Strings(names)
+	for _, name := range names {
+		if p := f[name]; !(p >= 0 && p <= 1) {
+			err = multierr.Append(err, errors.Errorf("feature flag %s: percentage %v must be between 0 and 1", name, p))
+		}
+	}
+	return
+}
+
+// ConfigChange is a single field which differs between two ChainCfgs.
+type ConfigChange struct {
+	Field    string
+	OldValue interface{}
+	NewValue interface{}
+}
+
+// Diff compares every exported field of a and b, and returns those which differ in declaration order.
+func Diff(a, b ChainCfg) (changes []ConfigChange) {
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	t := av.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		o, n := av.Field(i).Interface(), bv.Field(i).Interface()
+		if !reflect.DeepEqual(o, n) {
+			changes = append(changes, ConfigChange{Field: f.Name, OldValue: o, NewValue: n})
+		}
+	}
+	return
+}
+
 type DBChain = chains.DBChain[utils.Big, *ChainCfg]
 
+type ConfigAuditLogEntry = chains.ConfigAuditLogEntry[utils.Big]
+
 // https://app.shortcut.com/chainlinklabs/story/33622/remove-legacy-config
 type Node struct {
 	ID         int32
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"math"
 	"math/big"
 	"math/rand"
 	"testing"
@@ -9,6 +10,7 @@ import (
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
@@ -175,3 +177,64 @@ func TestLog_MarshalUnmarshalJson(t *testing.T) {
 
 	assert.Equal(t, log, parsedLog)
 }
+
+func TestDiff(t *testing.T) {
+	a := types.ChainCfg{
+		EvmFinalityDepth:  null.IntFrom(42),
+		EvmMaxGasPriceWei: assets.NewWeiI(100),
+		ChainType:         null.StringFrom("optimism"),
+	}
+
+	t.Run("equal", func(t *testing.T) {
+		b := types.ChainCfg{
+			EvmFinalityDepth:  null.IntFrom(42),
+			EvmMaxGasPriceWei: assets.NewWeiI(100),
+			ChainType:         null.StringFrom("optimism"),
+		}
+		assert.Empty(t, types.Diff(a, b))
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		b := a
+		b.EvmFinalityDepth = null.IntFrom(7)
+		b.EvmMaxGasPriceWei = assets.NewWeiI(200)
+		b.ChainType = null.String{}
+		b.EvmNonceAutoSync = null.BoolFrom(true)
+
+		assert.Equal(t, []types.ConfigChange{
+			{Field: "ChainType", OldValue: null.StringFrom("optimism"), NewValue: null.String{}},
+			{Field: "EvmFinalityDepth", OldValue: null.IntFrom(42), NewValue: null.IntFrom(7)},
+			{Field: "EvmMaxGasPriceWei", OldValue: assets.NewWeiI(100), NewValue: assets.NewWeiI(200)},
+			{Field: "EvmNonceAutoSync", OldValue: null.Bool{}, NewValue: null.BoolFrom(true)},
+		}, types.Diff(a, b))
+	})
+}
+
+func TestFeatureFlags_Enabled(t *testing.T) {
+	t.Parallel()
+
+	flags := types.FeatureFlags{"none": 0, "half": 0.5, "all": 1}
+
+	var half int
+	for seed := uint64(0); seed < 1000; seed++ {
+		assert.False(t, flags.Enabled("none", seed))
+		assert.False(t, flags.Enabled("unknown", seed))
+		assert.True(t, flags.Enabled("all", seed))
+		enabled := flags.Enabled("half", seed)
+		assert.Equal(t, enabled, flags.Enabled("half", seed), "must be deterministic")
+		if enabled {
+			half++
+		}
+	}
+	assert.InDelta(t, 500, half, 100)
+
+	assert.False(t, types.FeatureFlags(nil).Enabled("all", 1))
+}
+
+func TestFeatureFlags_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, types.FeatureFlags{"a": 0, "b": 0.25, "c": 1}.Validate())
+	assert.EqualError(t, types.FeatureFlags{"a": 1.5, "b": 0.5, "c": -0.1, "d": math.NaN()}.Validate(),
+		"feature flag a: percentage 1.5 must be between 0 and 1; feature flag c: percentage -0.1 must be between 0 and 1; feature flag d: percentage NaN must be between 0 and 1")
+}
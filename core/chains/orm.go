@@ -8,6 +8,7 @@ import (
 
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 )
@@ -39,6 +40,7 @@ type ORM[I ID, C Config, N Node] interface {
 
 	StoreString(chainID I, key, val string) error
 	Clear(chainID I, key string) error
+	GetAuditLog(chainID I, key string) ([]ConfigAuditLogEntry[I], error)
 
 	// SetupNodes is a shim to help with configuring multiple nodes via ENV.
 	// All existing nodes are dropped, and any missing chains are automatically created.
@@ -91,6 +93,45 @@ type DBChain[I ID, C Config] struct {
 	Enabled   bool
 }
 
+// Status returns the ChainStatus of the chain.
+func (c DBChain[I, C]) Status() ChainStatus {
+	if c.Enabled {
+		return ChainStatusEnabled
+	}
+	return ChainStatusDisabled
+}
+
+// ChainStatus is the status of a chain.
+type ChainStatus string
+
+const (
+	ChainStatusEnabled  ChainStatus = "enabled"
+	ChainStatusDisabled ChainStatus = "disabled"
+	ChainStatusPaused   ChainStatus = "paused"
+)
+
+// UnmarshalText parses s, and returns an error if it is not a known ChainStatus.
+func (s *ChainStatus) UnmarshalText(text []byte) error {
+	switch cs := ChainStatus(text); cs {
+	case ChainStatusEnabled, ChainStatusDisabled, ChainStatusPaused:
+		*s = cs
+		return nil
+	}
+	return errors.Errorf("invalid chain status: %q", text)
+}
+
+// ConfigAuditLogEntry records a single change to a config value of a chain.
+// A null OldValue means the key was unset, and a null NewValue means it was
+// cleared.
+type ConfigAuditLogEntry[I ID] struct {
+	ID        int64
+	ChainID   I
+	Key       string
+	OldValue  null.String
+	NewValue  null.String
+	ChangedAt time.Time
+}
+
 // chainsORM is a generic ORM for chains.
 type chainsORM[I ID, C Config] struct {
 	q      pg.Q
@@ -149,38 +190,59 @@ func (o *chainsORM[I, C]) UpdateChain(id I, enabled bool, config C, qopts ...pg.
 	return
 }
 
-// StoreString saves a string value into the config for the given chain and key
+// StoreString saves a string value into the config for the given chain and key,
+// and records the change in the config audit log
 func (o *chainsORM[I, C]) StoreString(chainID I, name, val string) error {
-	s := fmt.Sprintf(`UPDATE %s_chains SET cfg = cfg || jsonb_build_object($1::text, $2::text) WHERE id = $3`, o.prefix)
-	res, err := o.q.Exec(s, name, val, chainID)
-	if err != nil {
-		return errors.Wrapf(err, "failed to store chain config for chain ID %v", chainID)
-	}
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		return err
-	}
-	if rowsAffected == 0 {
-		return errors.Wrapf(sql.ErrNoRows, "no chain found with ID %v", chainID)
-	}
-	return nil
+	return o.q.Transaction(func(q pg.Queryer) error {
+		if err := o.auditConfigChange(q, chainID, name, null.StringFrom(val)); err != nil {
+			return err
+		}
+		s := fmt.Sprintf(`UPDATE %s_chains SET cfg = cfg || jsonb_build_object($1::text, $2::text) WHERE id = $3`, o.prefix)
+		if _, err := q.Exec(s, name, val, chainID); err != nil {
+			return errors.Wrapf(err, "failed to store chain config for chain ID %v", chainID)
+		}
+		return nil
+	})
 }
 
-// Clear deletes a config value for the given chain and key
+// Clear deletes a config value for the given chain and key, and records the
+// change in the config audit log
 func (o *chainsORM[I, C]) Clear(chainID I, name string) error {
-	s := fmt.Sprintf(`UPDATE %s_chains SET cfg = cfg - $1 WHERE id = $2`, o.prefix)
-	res, err := o.q.Exec(s, name, chainID)
-	if err != nil {
-		return errors.Wrapf(err, "failed to clear chain config for chain ID %v", chainID)
-	}
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		return err
-	}
-	if rowsAffected == 0 {
+	return o.q.Transaction(func(q pg.Queryer) error {
+		if err := o.auditConfigChange(q, chainID, name, null.String{}); err != nil {
+			return err
+		}
+		s := fmt.Sprintf(`UPDATE %s_chains SET cfg = cfg - $1 WHERE id = $2`, o.prefix)
+		if _, err := q.Exec(s, name, chainID); err != nil {
+			return errors.Wrapf(err, "failed to clear chain config for chain ID %v", chainID)
+		}
+		return nil
+	})
+}
+
+// auditConfigChange locks the chain and writes an audit log row for changing
+// the config value of key to newValue. It must be called before the change is
+// made, within the same transaction.
+func (o *chainsORM[I, C]) auditConfigChange(q pg.Queryer, chainID I, key string, newValue null.String) error {
+	var oldValue null.String
+	err := q.Get(&oldValue, fmt.Sprintf(`SELECT cfg->>$1 FROM %s_chains WHERE id = $2 FOR UPDATE`, o.prefix), key, chainID)
+	if errors.Is(err, sql.ErrNoRows) {
 		return errors.Wrapf(sql.ErrNoRows, "no chain found with ID %v", chainID)
+	} else if err != nil {
+		return errors.Wrapf(err, "failed to load chain config for chain ID %v", chainID)
 	}
-	return nil
+	s := fmt.Sprintf(`INSERT INTO %[1]s_chain_config_audit_log (%[1]s_chain_id, key, old_value, new_value, changed_at) VALUES ($1, $2, $3, $4, now())`, o.prefix)
+	_, err = q.Exec(s, chainID, key, oldValue, newValue)
+	return errors.Wrapf(err, "failed to write config audit log for chain ID %v", chainID)
+}
+
+// GetAuditLog returns the recorded changes to the config value for the given
+// chain and key, oldest first
+func (o *chainsORM[I, C]) GetAuditLog(chainID I, key string) (entries []ConfigAuditLogEntry[I], err error) {
+	s := fmt.Sprintf(`SELECT id, %[1]s_chain_id AS chain_id, key, old_value, new_value, changed_at FROM %[1]s_chain_config_audit_log
+WHERE %[1]s_chain_id = $1 AND key = $2 ORDER BY changed_at, id`, o.prefix)
+	err = o.q.Select(&entries, s, chainID, key)
+	return entries, errors.Wrapf(err, "failed to load config audit log for chain ID %v", chainID)
 }
 
 func (o *chainsORM[I, C]) DeleteChain(id I, qopts ...pg.QOpt) error {
@@ -86,6 +86,11 @@ func (o *chainsORMImmut[I, C]) Clear(chainID I, name string) error {
 	return v2.ErrUnsupported
 }
 
+// GetAuditLog returns the recorded changes to the config value for the given chain and key
+func (o *chainsORMImmut[I, C]) GetAuditLog(chainID I, key string) ([]ConfigAuditLogEntry[I], error) {
+	return nil, v2.ErrUnsupported
+}
+
 func (o *chainsORMImmut[I, C]) DeleteChain(id I, _ ...pg.QOpt) error {
 	return v2.ErrUnsupported
 }
@@ -3,9 +3,12 @@ package chains_test
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"testing"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains"
@@ -44,3 +47,20 @@ func ExampleNewORM() {
 
 	// Output:
 }
+
+func TestChainStatus_UnmarshalText(t *testing.T) {
+	for _, s := range []chains.ChainStatus{chains.ChainStatusEnabled, chains.ChainStatusDisabled, chains.ChainStatusPaused} {
+		var got chains.ChainStatus
+		require.NoError(t, got.UnmarshalText([]byte(s)))
+		assert.Equal(t, s, got)
+	}
+
+	var got chains.ChainStatus
+	assert.EqualError(t, got.UnmarshalText([]byte("enabeld")), `invalid chain status: "enabeld"`)
+	assert.Empty(t, got)
+}
+
+func TestDBChain_Status(t *testing.T) {
+	assert.Equal(t, chains.ChainStatusEnabled, chains.DBChain[string, *Config]{Enabled: true}.Status())
+	assert.Equal(t, chains.ChainStatusDisabled, chains.DBChain[string, *Config]{}.Status())
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/db"
 
+	"github.com/smartcontractkit/chainlink/core/chains"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 )
@@ -235,6 +236,10 @@ func (m *mockORM) Clear(chainID string, key string) error {
 	panic("implement me")
 }
 
+func (m *mockORM) GetAuditLog(chainID string, key string) ([]chains.ConfigAuditLogEntry[string], error) {
+	panic("implement me")
+}
+
 func (m *mockORM) NodesForChain(chainID string, offset, limit int, qopts ...pg.QOpt) (nodes []db.Node, count int, err error) {
 	return m.nodesForChain, len(m.nodesForChain), nil
 }
@@ -34,6 +34,7 @@ type ORM interface {
 
 	StoreString(chainID string, key, val string) error
 	Clear(chainID string, key string) error
+	GetAuditLog(chainID string, key string) ([]chains.ConfigAuditLogEntry[string], error)
 }
 
 var _ chains.ORM[string, *soldb.ChainCfg, soldb.Node] = (ORM)(nil)
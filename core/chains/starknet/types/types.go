@@ -28,6 +28,7 @@ type ORM interface {
 
 	StoreString(chainID string, key, val string) error
 	Clear(chainID string, key string) error
+	GetAuditLog(chainID string, key string) ([]chains.ConfigAuditLogEntry[string], error)
 }
 
 type DBChain = chains.DBChain[string, *db.ChainCfg]
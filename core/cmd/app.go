@@ -1043,6 +1043,11 @@ func NewApp(client *Client) *cli.App {
 							Usage:  "get information on a specific Ethereum Transaction",
 							Action: client.ShowTransaction,
 						},
+						{
+							Name:   "rebroadcast",
+							Usage:  "Replace a stuck Ethereum Transaction with a gas bumped one, keeping its nonce and payload. Prints the new transaction hash.",
+							Action: client.RebroadcastTransaction,
+						},
 					},
 				},
 				{
@@ -1093,7 +1098,7 @@ func NewApp(client *Client) *cli.App {
 			Name:  "chains",
 			Usage: "Commands for handling chain configuration",
 			Subcommands: cli.Commands{
-				chainCommand("EVM", EVMChainClient(client), cli.Int64Flag{Name: "id", Usage: "chain ID"}),
+				evmChainCommand(client),
 				chainCommand("Solana", SolanaChainClient(client),
 					cli.StringFlag{Name: "id", Usage: "chain ID, options: [mainnet, testnet, devnet, localnet]"}),
 				chainCommand("StarkNet", StarkNetChainClient(client), cli.StringFlag{Name: "id", Usage: "chain ID"}),
@@ -2,8 +2,12 @@ package cmd
 
 import (
 	"encoding/json"
+	"net/url"
 	"strconv"
 
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
@@ -61,3 +65,68 @@ func (ps EVMChainPresenters) RenderTable(rt RendererTable) error {
 func EVMChainClient(client *Client) ChainClient {
 	return newChainClient[*evmtypes.ChainCfg, presenters.EVMChainResource, EVMChainPresenter, EVMChainPresenters](client, "evm")
 }
+
+var evmChainConfigHeaders = []string{"Field", "Value", "Source"}
+
+// EVMChainConfigFieldPresenter implements TableRenderer for an EVMChainConfigFieldResource.
+type EVMChainConfigFieldPresenter struct {
+	presenters.EVMChainConfigFieldResource
+}
+
+// ToRow presents the EVMChainConfigFieldResource as a slice of strings.
+func (p *EVMChainConfigFieldPresenter) ToRow() []string {
+	return []string{p.GetID(), p.Value, p.Source}
+}
+
+// EVMChainConfigFieldPresenters implements TableRenderer for a slice of EVMChainConfigFieldPresenters.
+type EVMChainConfigFieldPresenters []EVMChainConfigFieldPresenter
+
+// RenderTable implements TableRenderer
+func (ps EVMChainConfigFieldPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	renderList(evmChainConfigHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// evmChainCommand returns the EVM chainCommand, with additional EVM only subcommands.
+func evmChainCommand(client *Client) cli.Command {
+	cmd := chainCommand("EVM", EVMChainClient(client), cli.Int64Flag{Name: "id", Usage: "chain ID"})
+	cmd.Subcommands = append(cmd.Subcommands, cli.Command{
+		Name:      "list-config",
+		Usage:     "List the effective config of an EVM chain, and where each value comes from",
+		ArgsUsage: "[chainID]",
+		Action:    client.ListEVMChainConfig,
+	})
+	return cmd
+}
+
+// ListEVMChainConfig lists the effective config of an EVM chain, with the
+// source of each value. The chain ID may be omitted if only one chain is
+// configured.
+func (cli *Client) ListEVMChainConfig(c *cli.Context) (err error) {
+	configURL := url.URL{
+		Path: "/v2/config/evm",
+	}
+	if c.Args().Present() {
+		query := configURL.Query()
+		query.Set("evmChainID", c.Args().First())
+		configURL.RawQuery = query.Encode()
+	}
+	resp, err := cli.HTTP.Get(configURL.String())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &EVMChainConfigFieldPresenters{})
+}
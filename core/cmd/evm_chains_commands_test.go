@@ -42,6 +42,33 @@ func TestClient_IndexEVMChains(t *testing.T) {
 	assertTableRenders(t, r)
 }
 
+func TestClient_ListEVMChainConfig(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Enabled = ptr(true)
+		c.EVM[0].NonceAutoSync = ptr(false)
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+	})
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("cli", 0)
+	require.NoError(t, set.Parse([]string{strconv.Itoa(client2.NullClientChainID)}))
+	require.Nil(t, client.ListEVMChainConfig(cli.NewContext(nil, set, nil)))
+	fields := *r.Renders[0].(*cmd.EVMChainConfigFieldPresenters)
+	require.NotEmpty(t, fields)
+	var found bool
+	for _, f := range fields {
+		if f.ID == "EvmNonceAutoSync" {
+			found = true
+			assert.Equal(t, "false", f.Value)
+			assert.Equal(t, "chain-override", f.Source)
+		}
+	}
+	assert.True(t, found, "missing EvmNonceAutoSync")
+	assertTableRenders(t, r)
+}
+
 // https://app.shortcut.com/chainlinklabs/story/33622/remove-legacy-config
 func TestClient_CreateEVMChain(t *testing.T) {
 	t.Parallel()
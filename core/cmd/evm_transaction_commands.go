@@ -82,6 +82,27 @@ func (cli *Client) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+// RebroadcastTransaction replaces the transaction with the given hash with a
+// new attempt with a bumped gas price
+func (cli *Client) RebroadcastTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the hash of the transaction"))
+	}
+	hash := c.Args().First()
+	resp, err := cli.HTTP.Post("/v2/transactions/evm/"+hash+"/rebroadcast", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = cli.renderAPIResponse(resp, &EthTxPresenter{}, "Rebroadcast Ethereum Transaction")
+	return err
+}
+
 // IndexTxAttempts returns the list of transactions in descending order,
 // taking an optional page parameter
 func (cli *Client) IndexTxAttempts(c *cli.Context) error {
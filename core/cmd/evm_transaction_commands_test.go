@@ -72,6 +72,32 @@ func TestClient_ShowTransaction(t *testing.T) {
 	assert.Equal(t, &tx.FromAddress, renderedTx.From)
 }
 
+func TestClient_RebroadcastTransaction(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewClientAndRenderer()
+
+	_, from := cltest.MustAddRandomKeyToKeystore(t, app.KeyStore.Eth())
+
+	t.Run("requires a transaction hash", func(t *testing.T) {
+		set := flag.NewFlagSet("test rebroadcast tx", 0)
+		c := cli.NewContext(nil, set, nil)
+		require.EqualError(t, client.RebroadcastTransaction(c), "must pass the hash of the transaction")
+	})
+
+	t.Run("refuses to rebroadcast a confirmed transaction", func(t *testing.T) {
+		tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, app.TxmORM(), 0, 1, from)
+
+		set := flag.NewFlagSet("test rebroadcast tx", 0)
+		set.Parse([]string{tx.EthTxAttempts[0].Hash.Hex()})
+		c := cli.NewContext(nil, set, nil)
+		err := client.RebroadcastTransaction(c)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "only unconfirmed transactions can be rebroadcast")
+	})
+}
+
 func TestClient_IndexTxAttempts(t *testing.T) {
 	t.Parallel()
 
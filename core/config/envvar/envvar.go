@@ -132,6 +132,10 @@ func NewUint32(name string) *EnvVar[uint32] {
 	return New[uint32](name, parse.Uint32)
 }
 
+func NewUint8(name string) *EnvVar[uint8] {
+	return New[uint8](name, parse.Uint8)
+}
+
 func NewUint16(name string) *EnvVar[uint16] {
 	return New[uint16](name, parse.Uint16)
 }
@@ -147,47 +147,89 @@ type ConfigSchema struct {
 	// Global
 	DefaultChainID *big.Int `env:"ETH_CHAIN_ID"`
 	// Per-chain overrides
-	BalanceMonitorEnabled             bool          `env:"BALANCE_MONITOR_ENABLED"`
-	BlockBackfillDepth                uint64        `env:"BLOCK_BACKFILL_DEPTH" default:"10"`
-	BlockBackfillSkip                 bool          `env:"BLOCK_BACKFILL_SKIP" default:"false"`
-	BlockEmissionIdleWarningThreshold time.Duration `env:"BLOCK_EMISSION_IDLE_WARNING_THRESHOLD"` //nodoc
-	EthTxReaperInterval               time.Duration `env:"ETH_TX_REAPER_INTERVAL"`
-	EthTxReaperThreshold              time.Duration `env:"ETH_TX_REAPER_THRESHOLD"`
-	EthTxResendAfterThreshold         time.Duration `env:"ETH_TX_RESEND_AFTER_THRESHOLD"`
-	EvmFinalityDepth                  uint32        `env:"ETH_FINALITY_DEPTH"`
-	EvmHeadTrackerHistoryDepth        uint          `env:"ETH_HEAD_TRACKER_HISTORY_DEPTH"`
-	EvmHeadTrackerMaxBufferSize       uint          `env:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE"`
-	EvmHeadTrackerSamplingInterval    time.Duration `env:"ETH_HEAD_TRACKER_SAMPLING_INTERVAL"`
-	EvmLogBackfillBatchSize           uint32        `env:"ETH_LOG_BACKFILL_BATCH_SIZE"`
-	EvmLogPollInterval                time.Duration `env:"ETH_LOG_POLL_INTERVAL"`
-	EvmLogKeepBlocksDepth             uint32        `env:"ETH_LOG_KEEP_BLOCKS_DEPTH"`
-	EvmRPCDefaultBatchSize            uint32        `env:"ETH_RPC_DEFAULT_BATCH_SIZE"`
-	LinkContractAddress               string        `env:"LINK_CONTRACT_ADDRESS"`
-	OCR2AutomationGasLimit            uint32        `env:"OCR2_AUTOMATION_GAS_LIMIT"`
-	OperatorFactoryAddress            string        `env:"OPERATOR_FACTORY_ADDRESS"`
-	MinIncomingConfirmations          uint32        `env:"MIN_INCOMING_CONFIRMATIONS"`
-	MinimumContractPayment            assets.Link   `env:"MINIMUM_CONTRACT_PAYMENT_LINK_JUELS"`
+	BalanceMonitorEnabled               bool          `env:"BALANCE_MONITOR_ENABLED"`
+	EvmAutoWalletFundEnabled            bool          `env:"ETH_AUTO_WALLET_FUND_ENABLED"`          //nodoc
+	EvmAutoWalletFundThreshold          *big.Int      `env:"ETH_AUTO_WALLET_FUND_THRESHOLD"`        //nodoc
+	EvmAutoWalletFundAmount             *big.Int      `env:"ETH_AUTO_WALLET_FUND_AMOUNT"`           //nodoc
+	EvmAutoWalletFundTreasuryAddress    string        `env:"ETH_AUTO_WALLET_FUND_TREASURY_ADDRESS"` //nodoc
+	EvmAutoCreateSendingKey             bool          `env:"ETH_AUTO_CREATE_SENDING_KEY"`
+	EvmWalletBalancePollInterval        time.Duration `env:"ETH_WALLET_BALANCE_POLL_INTERVAL"` //nodoc
+	EvmMaxOutstandingBalance            *assets.Wei   `env:"ETH_MAX_OUTSTANDING_BALANCE"`      //nodoc
+	BlockBackfillDepth                  uint64        `env:"BLOCK_BACKFILL_DEPTH" default:"10"`
+	BlockBackfillSkip                   bool          `env:"BLOCK_BACKFILL_SKIP" default:"false"`
+	BlockEmissionIdleWarningThreshold   time.Duration `env:"BLOCK_EMISSION_IDLE_WARNING_THRESHOLD"` //nodoc
+	EthTxReaperInterval                 time.Duration `env:"ETH_TX_REAPER_INTERVAL"`
+	EthTxReaperThreshold                time.Duration `env:"ETH_TX_REAPER_THRESHOLD"`
+	EthTxResendAfterThreshold           time.Duration `env:"ETH_TX_RESEND_AFTER_THRESHOLD"`
+	EvmTxExpiry                         time.Duration `env:"ETH_TX_EXPIRY"`
+	EvmReceiptPollingInterval           time.Duration `env:"ETH_RECEIPT_POLLING_INTERVAL"`
+	EvmMaxRevertedTransactionsPerBlock  uint16        `env:"ETH_MAX_REVERTED_TRANSACTIONS_PER_BLOCK"`
+	EvmCallDataSizeLimit                uint32        `env:"ETH_CALL_DATA_SIZE_LIMIT"`
+	EvmBlockTime                        time.Duration `env:"ETH_BLOCK_TIME"`
+	EvmDatabaseQueryTimeout             time.Duration `env:"ETH_DATABASE_QUERY_TIMEOUT"`
+	EvmContractCallTimeout              time.Duration `env:"ETH_CONTRACT_CALL_TIMEOUT"`
+	EvmFinalityDepth                    uint32        `env:"ETH_FINALITY_DEPTH"`
+	EvmHealthCheckGracePeriod           time.Duration `env:"ETH_HEALTH_CHECK_GRACE_PERIOD"`
+	EvmHeadTrackerHistoryDepth          uint          `env:"ETH_HEAD_TRACKER_HISTORY_DEPTH"`
+	EvmHeadTrackerMaxBufferSize         uint          `env:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE"`
+	EvmHeadTrackerSamplingInterval      time.Duration `env:"ETH_HEAD_TRACKER_SAMPLING_INTERVAL"`
+	EvmHeadTrackerCallbackTimeout       time.Duration `env:"ETH_HEAD_TRACKER_CALLBACK_TIMEOUT"` //nodoc
+	EvmHeadTrackerBlockDelay            uint16        `env:"ETH_HEAD_TRACKER_BLOCK_DELAY"`
+	EvmMaxReorgDepth                    uint32        `env:"ETH_MAX_REORG_DEPTH"`
+	EvmMaxBlockAge                      time.Duration `env:"ETH_MAX_BLOCK_AGE"`
+	EvmTrackerBlockHistoryFlushInterval time.Duration `env:"ETH_HEAD_TRACKER_FLUSH_INTERVAL"`
+	EvmLogBackfillBatchSize             uint32        `env:"ETH_LOG_BACKFILL_BATCH_SIZE"`
+	EvmLogPollInterval                  time.Duration `env:"ETH_LOG_POLL_INTERVAL"`
+	EvmLogKeepBlocksDepth               uint32        `env:"ETH_LOG_KEEP_BLOCKS_DEPTH"`
+	EvmLogTTL                           time.Duration `env:"ETH_LOG_TTL"`
+	EvmLogPruneInterval                 time.Duration `env:"ETH_LOG_PRUNE_INTERVAL"`
+	EvmLogFetcherUseBlockHash           bool          `env:"ETH_LOG_FETCHER_USE_BLOCK_HASH"`
+	EvmRPCDefaultBatchSize              uint32        `env:"ETH_RPC_DEFAULT_BATCH_SIZE"`
+	EvmRPCMaxInFlight                   uint32        `env:"ETH_RPC_MAX_IN_FLIGHT"`
+	EvmChainReadTimeoutFactor           float64       `env:"ETH_RPC_READ_TIMEOUT_FACTOR"`
+	EvmConfigReadOnly                   bool          `env:"EVM_CONFIG_READ_ONLY"`
+	EvmChainLocked                      bool          `env:"EVM_CHAIN_LOCKED"`
+	LinkContractAddress                 string        `env:"LINK_CONTRACT_ADDRESS"`
+	OCR2AutomationGasLimit              uint32        `env:"OCR2_AUTOMATION_GAS_LIMIT"`
+	OperatorFactoryAddress              string        `env:"OPERATOR_FACTORY_ADDRESS"`
+	MinIncomingConfirmations            uint32        `env:"MIN_INCOMING_CONFIRMATIONS"`
+	MinimumContractPayment              assets.Link   `env:"MINIMUM_CONTRACT_PAYMENT_LINK_JUELS"`
 	// Node liveness checking
 	NodeNoNewHeadsThreshold  time.Duration `env:"NODE_NO_NEW_HEADS_THRESHOLD"`
 	NodePollFailureThreshold uint32        `env:"NODE_POLL_FAILURE_THRESHOLD"`
 	NodePollInterval         time.Duration `env:"NODE_POLL_INTERVAL"`
 	NodeSelectionMode        string        `env:"NODE_SELECTION_MODE"`
+	EvmNodeSyncThreshold     uint32        `env:"NODE_SYNC_THRESHOLD"`
 
 	// EVM Gas Controls
-	EvmEIP1559DynamicFees bool     `env:"EVM_EIP1559_DYNAMIC_FEES"`
-	EvmGasBumpPercent     uint16   `env:"ETH_GAS_BUMP_PERCENT"`
-	EvmGasBumpThreshold   uint64   `env:"ETH_GAS_BUMP_THRESHOLD"`
-	EvmGasBumpWei         *big.Int `env:"ETH_GAS_BUMP_WEI"`
-	EvmGasFeeCapDefault   *big.Int `env:"EVM_GAS_FEE_CAP_DEFAULT"`
-	EvmGasLimitDefault    uint32   `env:"ETH_GAS_LIMIT_DEFAULT"`
-	EvmGasLimitMax        uint32   `env:"ETH_GAS_LIMIT_MAX"`
-	EvmGasLimitMultiplier float32  `env:"ETH_GAS_LIMIT_MULTIPLIER"`
-	EvmGasLimitTransfer   uint32   `env:"ETH_GAS_LIMIT_TRANSFER"`
-	EvmGasPriceDefault    *big.Int `env:"ETH_GAS_PRICE_DEFAULT"`
-	EvmGasTipCapDefault   *big.Int `env:"EVM_GAS_TIP_CAP_DEFAULT"`
-	EvmGasTipCapMinimum   *big.Int `env:"EVM_GAS_TIP_CAP_MINIMUM"`
-	EvmMaxGasPriceWei     *big.Int `env:"ETH_MAX_GAS_PRICE_WEI"`
-	EvmMinGasPriceWei     *big.Int `env:"ETH_MIN_GAS_PRICE_WEI"`
+	EvmEIP1559DynamicFees                 bool          `env:"EVM_EIP1559_DYNAMIC_FEES"`
+	EvmMinimumFeeMarket                   bool          `env:"EVM_MINIMUM_FEE_MARKET"`
+	EvmGasBumpPercent                     uint16        `env:"ETH_GAS_BUMP_PERCENT"`
+	EvmGasBumpThreshold                   uint64        `env:"ETH_GAS_BUMP_THRESHOLD"`
+	EvmGasNoBumpThreshold                 uint64        `env:"ETH_GAS_NO_BUMP_THRESHOLD"`
+	EvmGasBumpWei                         *big.Int      `env:"ETH_GAS_BUMP_WEI"`
+	EvmGasFeeCapDefault                   *big.Int      `env:"EVM_GAS_FEE_CAP_DEFAULT"`
+	EvmGasFeeCapRounding                  *big.Int      `env:"EVM_GAS_FEE_CAP_ROUNDING"`
+	EvmBlobGasPriceDefault                *big.Int      `env:"ETH_BLOB_GAS_PRICE_DEFAULT"`
+	EvmMaxBlobGasPrice                    *big.Int      `env:"ETH_MAX_BLOB_GAS_PRICE"`
+	EvmFeeHistoryBlocks                   uint16        `env:"ETH_FEE_HISTORY_BLOCKS"`
+	EvmGasLimitDefault                    uint32        `env:"ETH_GAS_LIMIT_DEFAULT"`
+	EvmGasLimitMax                        uint32        `env:"ETH_GAS_LIMIT_MAX"`
+	EvmGasLimitIncrementOnFailure         uint32        `env:"ETH_GAS_LIMIT_INCREMENT_ON_FAILURE"`
+	EvmGasLimitMultiplier                 float32       `env:"ETH_GAS_LIMIT_MULTIPLIER"`
+	EvmGasCapacityBuffer                  float64       `env:"ETH_GAS_CAPACITY_BUFFER"`
+	EvmGasLimitTransfer                   uint32        `env:"ETH_GAS_LIMIT_TRANSFER"`
+	EvmContractCreationGasLimit           uint32        `env:"ETH_CONTRACT_CREATION_GAS_LIMIT"`
+	EvmGasPriceDefault                    *big.Int      `env:"ETH_GAS_PRICE_DEFAULT"`
+	EvmGasPriceAutoAdjust                 bool          `env:"ETH_GAS_PRICE_AUTO_ADJUST"`
+	EvmGasPriceAutoAdjustInterval         time.Duration `env:"ETH_GAS_PRICE_AUTO_ADJUST_INTERVAL"`
+	EvmGasTipCapDefault                   *big.Int      `env:"EVM_GAS_TIP_CAP_DEFAULT"`
+	EvmGasTipCapMinimum                   *big.Int      `env:"EVM_GAS_TIP_CAP_MINIMUM"`
+	EvmMaxGasPriceWei                     *big.Int      `env:"ETH_MAX_GAS_PRICE_WEI"`
+	EvmMaxGasPriceWarningThresholdPercent uint8         `env:"ETH_MAX_GAS_PRICE_WARNING_THRESHOLD_PERCENT"`
+	EvmMinGasPriceWei                     *big.Int      `env:"ETH_MIN_GAS_PRICE_WEI"`
+	EvmGasPriceFloor                      *big.Int      `env:"ETH_GAS_PRICE_FLOOR"`
+	EvmGasPriceCacheTTL                   time.Duration `env:"ETH_GAS_PRICE_CACHE_TTL"`
 	// Gas limits per job type
 	EvmGasLimitOCRJobType    *uint32 `env:"ETH_GAS_LIMIT_OCR_JOB_TYPE"`
 	EvmGasLimitDRJobType     *uint32 `env:"ETH_GAS_LIMIT_DR_JOB_TYPE"`
@@ -195,20 +237,34 @@ type ConfigSchema struct {
 	EvmGasLimitFMJobType     *uint32 `env:"ETH_GAS_LIMIT_FM_JOB_TYPE"`
 	EvmGasLimitKeeperJobType *uint32 `env:"ETH_GAS_LIMIT_KEEPER_JOB_TYPE"`
 	// Gas Estimation
-	GasEstimatorMode                               string `env:"GAS_ESTIMATOR_MODE"`
-	BlockHistoryEstimatorBatchSize                 uint32 `env:"BLOCK_HISTORY_ESTIMATOR_BATCH_SIZE"`
-	BlockHistoryEstimatorBlockDelay                uint16 `env:"BLOCK_HISTORY_ESTIMATOR_BLOCK_DELAY"`
-	BlockHistoryEstimatorBlockHistorySize          uint16 `env:"BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE"`
-	BlockHistoryEstimatorCheckInclusionBlocks      uint16 `env:"BLOCK_HISTORY_ESTIMATOR_CHECK_INCLUSION_BLOCKS"`
-	BlockHistoryEstimatorCheckInclusionPercentile  uint16 `env:"BLOCK_HISTORY_ESTIMATOR_CHECK_INCLUSION_PERCENTILE"`
-	BlockHistoryEstimatorEIP1559FeeCapBufferBlocks uint16 `env:"BLOCK_HISTORY_ESTIMATOR_EIP1559_FEE_CAP_BUFFER_BLOCKS"`
-	BlockHistoryEstimatorTransactionPercentile     uint16 `env:"BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE"`
+	GasEstimatorMode                               string        `env:"GAS_ESTIMATOR_MODE"`
+	EvmGasEstimatorFallbackMode                    string        `env:"GAS_ESTIMATOR_FALLBACK_MODE"`
+	EvmGasEstimatorCheckRetries                    uint8         `env:"GAS_ESTIMATOR_CHECK_RETRIES"`
+	EvmGasOraclePollInterval                       time.Duration `env:"GAS_ESTIMATOR_ORACLE_POLL_INTERVAL"`
+	EvmGasOracleAddress                            string        `env:"ETH_GAS_ORACLE_ADDRESS"`
+	EvmGasPriceFeedEnabled                         bool          `env:"ETH_GAS_PRICE_FEED_ENABLED"`
+	EvmGasPriceFeedAddress                         string        `env:"ETH_GAS_PRICE_FEED_ADDRESS"`
+	BlockHistoryEstimatorBatchSize                 uint32        `env:"BLOCK_HISTORY_ESTIMATOR_BATCH_SIZE"`
+	BlockHistoryEstimatorBlockDelay                uint16        `env:"BLOCK_HISTORY_ESTIMATOR_BLOCK_DELAY"`
+	BlockHistoryEstimatorBlockHistorySize          uint16        `env:"BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE"`
+	BlockHistoryEstimatorCheckInclusionBlocks      uint16        `env:"BLOCK_HISTORY_ESTIMATOR_CHECK_INCLUSION_BLOCKS"`
+	BlockHistoryEstimatorCheckInclusionPercentile  uint16        `env:"BLOCK_HISTORY_ESTIMATOR_CHECK_INCLUSION_PERCENTILE"`
+	BlockHistoryEstimatorEIP1559FeeCapBufferBlocks uint16        `env:"BLOCK_HISTORY_ESTIMATOR_EIP1559_FEE_CAP_BUFFER_BLOCKS"`
+	BlockHistoryEstimatorTransactionPercentile     uint16        `env:"BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE"`
+	EvmGasEstimatorTransactionPriceHistory         uint16        `env:"GAS_ESTIMATOR_TRANSACTION_PRICE_HISTORY"`
+	EvmGasEstimatorBlockHistoryEWMAAlpha           float64       `env:"BLOCK_HISTORY_ESTIMATOR_EWMA_ALPHA"`
+	EvmGasEstimatorMinSampleCount                  uint16        `env:"BLOCK_HISTORY_ESTIMATOR_MIN_SAMPLE_COUNT"`
+	EvmGasHistoryExcludeZeroPriceTxs               bool          `env:"BLOCK_HISTORY_ESTIMATOR_EXCLUDE_ZERO_PRICE_TXS"`
 	// Txm
 	EvmGasBumpTxDepth          uint16 `env:"ETH_GAS_BUMP_TX_DEPTH"`
 	EvmMaxInFlightTransactions uint32 `env:"ETH_MAX_IN_FLIGHT_TRANSACTIONS"`
 	EvmMaxQueuedTransactions   uint64 `env:"ETH_MAX_QUEUED_TRANSACTIONS"`
 	EvmNonceAutoSync           bool   `env:"ETH_NONCE_AUTO_SYNC"`
+	EvmNonceAutoFillGap        bool   `env:"ETH_NONCE_AUTO_FILL_GAP"` //nodoc
 	EvmUseForwarders           bool   `env:"ETH_USE_FORWARDERS"`
+	EvmSimulateTransactions    bool   `env:"ETH_SIMULATE_TRANSACTIONS"` //nodoc
+	EvmTxPriorityQueue         bool   `env:"ETH_TX_PRIORITY_QUEUE"`
+	EvmAllowUnprotectedTxs     bool   `env:"ETH_ALLOW_UNPROTECTED_TXS"`
 
 	// Job Pipeline and tasks
 	DefaultHTTPLimit                 int64           `env:"DEFAULT_HTTP_LIMIT" default:"32768"`
@@ -238,9 +294,11 @@ type ConfigSchema struct {
 	FeatureOffchainReporting bool `env:"FEATURE_OFFCHAIN_REPORTING" default:"false"`
 	// Per-chain defaults
 	OCRContractConfirmations              uint          `env:"OCR_CONTRACT_CONFIRMATIONS"`                //nodoc
+	EvmOCRContractLookbackBlocks          uint64        `env:"OCR_CONTRACT_LOOKBACK_BLOCKS"`              //nodoc
 	OCRContractTransmitterTransmitTimeout time.Duration `env:"OCR_CONTRACT_TRANSMITTER_TRANSMIT_TIMEOUT"` //nodoc
 	OCRDatabaseTimeout                    time.Duration `env:"OCR_DATABASE_TIMEOUT"`                      //nodoc
 	OCRObservationGracePeriod             time.Duration `env:"OCR_OBSERVATION_GRACE_PERIOD"`              //nodoc
+	EvmOCRMinObservationTimeout           time.Duration `env:"OCR_MIN_OBSERVATION_TIMEOUT"`               //nodoc
 	// Global defaults
 	OCRObservationTimeout           time.Duration `env:"OCR_OBSERVATION_TIMEOUT" default:"5s"`            //nodoc
 	OCRBlockchainTimeout            time.Duration `env:"OCR_BLOCKCHAIN_TIMEOUT" default:"20s"`            //nodoc
@@ -33,6 +33,13 @@ func TestConfigSchema(t *testing.T) {
 		"AutoPprofPollInterval":                          "AUTO_PPROF_POLL_INTERVAL",
 		"AutoPprofProfileRoot":                           "AUTO_PPROF_PROFILE_ROOT",
 		"BalanceMonitorEnabled":                          "BALANCE_MONITOR_ENABLED",
+		"EvmAutoWalletFundEnabled":                       "ETH_AUTO_WALLET_FUND_ENABLED",
+		"EvmAutoWalletFundThreshold":                     "ETH_AUTO_WALLET_FUND_THRESHOLD",
+		"EvmAutoWalletFundAmount":                        "ETH_AUTO_WALLET_FUND_AMOUNT",
+		"EvmAutoWalletFundTreasuryAddress":               "ETH_AUTO_WALLET_FUND_TREASURY_ADDRESS",
+		"EvmAutoCreateSendingKey":                        "ETH_AUTO_CREATE_SENDING_KEY",
+		"EvmWalletBalancePollInterval":                   "ETH_WALLET_BALANCE_POLL_INTERVAL",
+		"EvmMaxOutstandingBalance":                       "ETH_MAX_OUTSTANDING_BALANCE",
 		"BlockBackfillDepth":                             "BLOCK_BACKFILL_DEPTH",
 		"BlockBackfillSkip":                              "BLOCK_BACKFILL_SKIP",
 		"BlockEmissionIdleWarningThreshold":              "BLOCK_EMISSION_IDLE_WARNING_THRESHOLD",
@@ -43,6 +50,10 @@ func TestConfigSchema(t *testing.T) {
 		"BlockHistoryEstimatorCheckInclusionPercentile":  "BLOCK_HISTORY_ESTIMATOR_CHECK_INCLUSION_PERCENTILE",
 		"BlockHistoryEstimatorEIP1559FeeCapBufferBlocks": "BLOCK_HISTORY_ESTIMATOR_EIP1559_FEE_CAP_BUFFER_BLOCKS",
 		"BlockHistoryEstimatorTransactionPercentile":     "BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE",
+		"EvmGasEstimatorTransactionPriceHistory":         "GAS_ESTIMATOR_TRANSACTION_PRICE_HISTORY",
+		"EvmGasEstimatorBlockHistoryEWMAAlpha":           "BLOCK_HISTORY_ESTIMATOR_EWMA_ALPHA",
+		"EvmGasEstimatorMinSampleCount":                  "BLOCK_HISTORY_ESTIMATOR_MIN_SAMPLE_COUNT",
+		"EvmGasHistoryExcludeZeroPriceTxs":               "BLOCK_HISTORY_ESTIMATOR_EXCLUDE_ZERO_PRICE_TXS",
 		"BridgeResponseURL":                              "BRIDGE_RESPONSE_URL",
 		"ChainType":                                      "CHAIN_TYPE",
 		"DatabaseBackupDir":                              "DATABASE_BACKUP_DIR",
@@ -63,44 +74,85 @@ func TestConfigSchema(t *testing.T) {
 		"EthTxReaperInterval":                            "ETH_TX_REAPER_INTERVAL",
 		"EthTxReaperThreshold":                           "ETH_TX_REAPER_THRESHOLD",
 		"EthTxResendAfterThreshold":                      "ETH_TX_RESEND_AFTER_THRESHOLD",
+		"EvmTxExpiry":                                    "ETH_TX_EXPIRY",
+		"EvmReceiptPollingInterval":                      "ETH_RECEIPT_POLLING_INTERVAL",
+		"EvmMaxRevertedTransactionsPerBlock":             "ETH_MAX_REVERTED_TRANSACTIONS_PER_BLOCK",
+		"EvmCallDataSizeLimit":                           "ETH_CALL_DATA_SIZE_LIMIT",
 		"EthereumHTTPURL":                                "ETH_HTTP_URL",
 		"EthereumSecondaryURL":                           "ETH_SECONDARY_URL",
 		"EthereumSecondaryURLs":                          "ETH_SECONDARY_URLS",
 		"EthereumURL":                                    "ETH_URL",
 		"EthereumNodes":                                  "EVM_NODES",
 		"EvmBalanceMonitorBlockDelay":                    "ETH_BALANCE_MONITOR_BLOCK_DELAY",
+		"EvmBlockTime":                                   "ETH_BLOCK_TIME",
+		"EvmDatabaseQueryTimeout":                        "ETH_DATABASE_QUERY_TIMEOUT",
+		"EvmContractCallTimeout":                         "ETH_CONTRACT_CALL_TIMEOUT",
 		"EvmEIP1559DynamicFees":                          "EVM_EIP1559_DYNAMIC_FEES",
+		"EvmMinimumFeeMarket":                            "EVM_MINIMUM_FEE_MARKET",
 		"EvmFinalityDepth":                               "ETH_FINALITY_DEPTH",
+		"EvmHealthCheckGracePeriod":                      "ETH_HEALTH_CHECK_GRACE_PERIOD",
 		"EvmGasBumpPercent":                              "ETH_GAS_BUMP_PERCENT",
 		"EvmGasBumpThreshold":                            "ETH_GAS_BUMP_THRESHOLD",
+		"EvmGasNoBumpThreshold":                          "ETH_GAS_NO_BUMP_THRESHOLD",
 		"EvmGasBumpTxDepth":                              "ETH_GAS_BUMP_TX_DEPTH",
 		"EvmGasBumpWei":                                  "ETH_GAS_BUMP_WEI",
 		"EvmGasFeeCapDefault":                            "EVM_GAS_FEE_CAP_DEFAULT",
+		"EvmGasFeeCapRounding":                           "EVM_GAS_FEE_CAP_ROUNDING",
+		"EvmBlobGasPriceDefault":                         "ETH_BLOB_GAS_PRICE_DEFAULT",
+		"EvmMaxBlobGasPrice":                             "ETH_MAX_BLOB_GAS_PRICE",
+		"EvmFeeHistoryBlocks":                            "ETH_FEE_HISTORY_BLOCKS",
 		"EvmGasLimitDefault":                             "ETH_GAS_LIMIT_DEFAULT",
 		"EvmGasLimitMax":                                 "ETH_GAS_LIMIT_MAX",
+		"EvmGasLimitIncrementOnFailure":                  "ETH_GAS_LIMIT_INCREMENT_ON_FAILURE",
 		"EvmGasLimitMultiplier":                          "ETH_GAS_LIMIT_MULTIPLIER",
+		"EvmGasCapacityBuffer":                           "ETH_GAS_CAPACITY_BUFFER",
 		"EvmGasLimitTransfer":                            "ETH_GAS_LIMIT_TRANSFER",
+		"EvmContractCreationGasLimit":                    "ETH_CONTRACT_CREATION_GAS_LIMIT",
 		"EvmGasLimitOCRJobType":                          "ETH_GAS_LIMIT_OCR_JOB_TYPE",
 		"EvmGasLimitDRJobType":                           "ETH_GAS_LIMIT_DR_JOB_TYPE",
 		"EvmGasLimitVRFJobType":                          "ETH_GAS_LIMIT_VRF_JOB_TYPE",
 		"EvmGasLimitFMJobType":                           "ETH_GAS_LIMIT_FM_JOB_TYPE",
 		"EvmGasLimitKeeperJobType":                       "ETH_GAS_LIMIT_KEEPER_JOB_TYPE",
+		"EvmGasOracleAddress":                            "ETH_GAS_ORACLE_ADDRESS",
 		"EvmGasPriceDefault":                             "ETH_GAS_PRICE_DEFAULT",
+		"EvmGasPriceAutoAdjust":                          "ETH_GAS_PRICE_AUTO_ADJUST",
+		"EvmGasPriceAutoAdjustInterval":                  "ETH_GAS_PRICE_AUTO_ADJUST_INTERVAL",
+		"EvmGasPriceFeedAddress":                         "ETH_GAS_PRICE_FEED_ADDRESS",
+		"EvmGasPriceFeedEnabled":                         "ETH_GAS_PRICE_FEED_ENABLED",
 		"EvmGasTipCapDefault":                            "EVM_GAS_TIP_CAP_DEFAULT",
 		"EvmGasTipCapMinimum":                            "EVM_GAS_TIP_CAP_MINIMUM",
 		"EvmHeadTrackerHistoryDepth":                     "ETH_HEAD_TRACKER_HISTORY_DEPTH",
 		"EvmHeadTrackerMaxBufferSize":                    "ETH_HEAD_TRACKER_MAX_BUFFER_SIZE",
 		"EvmHeadTrackerSamplingInterval":                 "ETH_HEAD_TRACKER_SAMPLING_INTERVAL",
+		"EvmHeadTrackerCallbackTimeout":                  "ETH_HEAD_TRACKER_CALLBACK_TIMEOUT",
+		"EvmHeadTrackerBlockDelay":                       "ETH_HEAD_TRACKER_BLOCK_DELAY",
+		"EvmMaxReorgDepth":                               "ETH_MAX_REORG_DEPTH",
+		"EvmMaxBlockAge":                                 "ETH_MAX_BLOCK_AGE",
+		"EvmTrackerBlockHistoryFlushInterval":            "ETH_HEAD_TRACKER_FLUSH_INTERVAL",
 		"EvmLogBackfillBatchSize":                        "ETH_LOG_BACKFILL_BATCH_SIZE",
 		"EvmLogPollInterval":                             "ETH_LOG_POLL_INTERVAL",
 		"EvmLogKeepBlocksDepth":                          "ETH_LOG_KEEP_BLOCKS_DEPTH",
+		"EvmLogTTL":                                      "ETH_LOG_TTL",
+		"EvmLogPruneInterval":                            "ETH_LOG_PRUNE_INTERVAL",
+		"EvmLogFetcherUseBlockHash":                      "ETH_LOG_FETCHER_USE_BLOCK_HASH",
 		"EvmMaxGasPriceWei":                              "ETH_MAX_GAS_PRICE_WEI",
+		"EvmMaxGasPriceWarningThresholdPercent":          "ETH_MAX_GAS_PRICE_WARNING_THRESHOLD_PERCENT",
 		"EvmMaxInFlightTransactions":                     "ETH_MAX_IN_FLIGHT_TRANSACTIONS",
 		"EvmMaxQueuedTransactions":                       "ETH_MAX_QUEUED_TRANSACTIONS",
 		"EvmMinGasPriceWei":                              "ETH_MIN_GAS_PRICE_WEI",
+		"EvmGasPriceFloor":                               "ETH_GAS_PRICE_FLOOR",
+		"EvmGasPriceCacheTTL":                            "ETH_GAS_PRICE_CACHE_TTL",
 		"EvmNonceAutoSync":                               "ETH_NONCE_AUTO_SYNC",
+		"EvmNonceAutoFillGap":                            "ETH_NONCE_AUTO_FILL_GAP",
 		"EvmUseForwarders":                               "ETH_USE_FORWARDERS",
+		"EvmSimulateTransactions":                        "ETH_SIMULATE_TRANSACTIONS",
+		"EvmTxPriorityQueue":                             "ETH_TX_PRIORITY_QUEUE",
+		"EvmAllowUnprotectedTxs":                         "ETH_ALLOW_UNPROTECTED_TXS",
 		"EvmRPCDefaultBatchSize":                         "ETH_RPC_DEFAULT_BATCH_SIZE",
+		"EvmRPCMaxInFlight":                              "ETH_RPC_MAX_IN_FLIGHT",
+		"EvmChainReadTimeoutFactor":                      "ETH_RPC_READ_TIMEOUT_FACTOR",
+		"EvmConfigReadOnly":                              "EVM_CONFIG_READ_ONLY",
+		"EvmChainLocked":                                 "EVM_CHAIN_LOCKED",
 		"ExplorerAccessKey":                              "EXPLORER_ACCESS_KEY",
 		"ExplorerSecret":                                 "EXPLORER_SECRET",
 		"ExplorerURL":                                    "EXPLORER_URL",
@@ -114,6 +166,9 @@ func TestConfigSchema(t *testing.T) {
 		"FeatureUICSAKeys":                               "FEATURE_UI_CSA_KEYS",
 		"FlagsContractAddress":                           "FLAGS_CONTRACT_ADDRESS",
 		"GasEstimatorMode":                               "GAS_ESTIMATOR_MODE",
+		"EvmGasEstimatorFallbackMode":                    "GAS_ESTIMATOR_FALLBACK_MODE",
+		"EvmGasEstimatorCheckRetries":                    "GAS_ESTIMATOR_CHECK_RETRIES",
+		"EvmGasOraclePollInterval":                       "GAS_ESTIMATOR_ORACLE_POLL_INTERVAL",
 		"GasUpdaterBatchSize":                            "GAS_UPDATER_BATCH_SIZE",
 		"GasUpdaterBlockDelay":                           "GAS_UPDATER_BLOCK_DELAY",
 		"GasUpdaterBlockHistorySize":                     "GAS_UPDATER_BLOCK_HISTORY_SIZE",
@@ -159,6 +214,7 @@ func TestConfigSchema(t *testing.T) {
 		"NodePollFailureThreshold":                       "NODE_POLL_FAILURE_THRESHOLD",
 		"NodePollInterval":                               "NODE_POLL_INTERVAL",
 		"NodeSelectionMode":                              "NODE_SELECTION_MODE",
+		"EvmNodeSyncThreshold":                           "NODE_SYNC_THRESHOLD",
 		"ORMMaxIdleConns":                                "ORM_MAX_IDLE_CONNS",
 		"ORMMaxOpenConns":                                "ORM_MAX_OPEN_CONNS",
 		"OptimismGasFees":                                "OPTIMISM_GAS_FEES",
@@ -212,10 +268,12 @@ func TestConfigSchema(t *testing.T) {
 		"OCRContractTransmitterTransmitTimeout": "OCR_CONTRACT_TRANSMITTER_TRANSMIT_TIMEOUT",
 		"OCRDatabaseTimeout":                    "OCR_DATABASE_TIMEOUT",
 		"OCRContractConfirmations":              "OCR_CONTRACT_CONFIRMATIONS",
+		"EvmOCRContractLookbackBlocks":          "OCR_CONTRACT_LOOKBACK_BLOCKS",
 		"OCRKeyBundleID":                        "OCR_KEY_BUNDLE_ID",
 		"OCRDefaultTransactionQueueDepth":       "OCR_DEFAULT_TRANSACTION_QUEUE_DEPTH",
 		"OCRTraceLogging":                       "OCR_TRACE_LOGGING",
 		"OCRObservationGracePeriod":             "OCR_OBSERVATION_GRACE_PERIOD",
+		"EvmOCRMinObservationTimeout":           "OCR_MIN_OBSERVATION_TIMEOUT",
 		"OCRObservationTimeout":                 "OCR_OBSERVATION_TIMEOUT",
 		"OCRTransmitterAddress":                 "OCR_TRANSMITTER_ADDRESS",
 		"OCRSimulateTransactions":               "OCR_SIMULATE_TRANSACTIONS",
@@ -199,6 +199,13 @@ type BasicConfig interface {
 // The second bool indicates if it is set or not
 type GlobalConfig interface {
 	GlobalBalanceMonitorEnabled() (bool, bool)
+	GlobalEvmAutoWalletFundEnabled() (bool, bool)
+	GlobalEvmAutoWalletFundThreshold() (*assets.Wei, bool)
+	GlobalEvmAutoWalletFundAmount() (*assets.Wei, bool)
+	GlobalEvmAutoWalletFundTreasuryAddress() (string, bool)
+	GlobalEvmAutoCreateSendingKey() (bool, bool)
+	GlobalEvmWalletBalancePollInterval() (time.Duration, bool)
+	GlobalEvmMaxOutstandingBalance() (*assets.Wei, bool)
 	GlobalBlockEmissionIdleWarningThreshold() (time.Duration, bool)
 	GlobalBlockHistoryEstimatorBatchSize() (uint32, bool)
 	GlobalBlockHistoryEstimatorBlockDelay() (uint16, bool)
@@ -207,49 +214,99 @@ type GlobalConfig interface {
 	GlobalBlockHistoryEstimatorCheckInclusionBlocks() (uint16, bool)
 	GlobalBlockHistoryEstimatorCheckInclusionPercentile() (uint16, bool)
 	GlobalBlockHistoryEstimatorTransactionPercentile() (uint16, bool)
+	GlobalEvmGasEstimatorTransactionPriceHistory() (uint16, bool)
+	GlobalEvmGasEstimatorBlockHistoryEWMAAlpha() (float64, bool)
+	GlobalEvmGasEstimatorMinSampleCount() (uint16, bool)
+	GlobalEvmGasHistoryExcludeZeroPriceTxs() (bool, bool)
 	GlobalChainType() (string, bool)
 	GlobalEthTxReaperInterval() (time.Duration, bool)
 	GlobalEthTxReaperThreshold() (time.Duration, bool)
 	GlobalEthTxResendAfterThreshold() (time.Duration, bool)
+	GlobalEvmTxExpiry() (time.Duration, bool)
+	GlobalEvmReceiptPollingInterval() (time.Duration, bool)
+	GlobalEvmMaxRevertedTransactionsPerBlock() (uint16, bool)
+	GlobalEvmCallDataSizeLimit() (uint32, bool)
+	GlobalEvmBlockTime() (time.Duration, bool)
+	GlobalEvmDatabaseQueryTimeout() (time.Duration, bool)
+	GlobalEvmContractCallTimeout() (time.Duration, bool)
 	GlobalEvmEIP1559DynamicFees() (bool, bool)
+	GlobalEvmMinimumFeeMarket() (bool, bool)
 	GlobalEvmFinalityDepth() (uint32, bool)
+	GlobalEvmHealthCheckGracePeriod() (time.Duration, bool)
 	GlobalEvmGasBumpPercent() (uint16, bool)
 	GlobalEvmGasBumpThreshold() (uint64, bool)
+	GlobalEvmGasNoBumpThreshold() (uint64, bool)
 	GlobalEvmGasBumpTxDepth() (uint16, bool)
 	GlobalEvmGasBumpWei() (*assets.Wei, bool)
 	GlobalEvmGasFeeCapDefault() (*assets.Wei, bool)
+	GlobalEvmGasFeeCapRounding() (*assets.Wei, bool)
+	GlobalEvmBlobGasPriceDefault() (*assets.Wei, bool)
+	GlobalEvmMaxBlobGasPrice() (*assets.Wei, bool)
+	GlobalEvmFeeHistoryBlocks() (uint16, bool)
 	GlobalEvmGasLimitDefault() (uint32, bool)
 	GlobalEvmGasLimitMax() (uint32, bool)
+	GlobalEvmGasLimitIncrementOnFailure() (uint32, bool)
 	GlobalEvmGasLimitMultiplier() (float32, bool)
+	GlobalEvmGasCapacityBuffer() (float64, bool)
 	GlobalEvmGasLimitTransfer() (uint32, bool)
+	GlobalEvmContractCreationGasLimit() (uint32, bool)
 	GlobalEvmGasLimitOCRJobType() (uint32, bool)
 	GlobalEvmGasLimitDRJobType() (uint32, bool)
 	GlobalEvmGasLimitVRFJobType() (uint32, bool)
 	GlobalEvmGasLimitFMJobType() (uint32, bool)
 	GlobalEvmGasLimitKeeperJobType() (uint32, bool)
 	GlobalEvmGasPriceDefault() (*assets.Wei, bool)
+	GlobalEvmGasPriceAutoAdjust() (bool, bool)
+	GlobalEvmGasPriceAutoAdjustInterval() (time.Duration, bool)
 	GlobalEvmGasTipCapDefault() (*assets.Wei, bool)
 	GlobalEvmGasTipCapMinimum() (*assets.Wei, bool)
 	GlobalEvmHeadTrackerHistoryDepth() (uint32, bool)
 	GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool)
 	GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool)
+	GlobalEvmHeadTrackerCallbackTimeout() (time.Duration, bool)
+	GlobalEvmHeadTrackerBlockDelay() (uint16, bool)
+	GlobalEvmMaxReorgDepth() (uint32, bool)
+	GlobalEvmMaxBlockAge() (time.Duration, bool)
+	GlobalEvmTrackerBlockHistoryFlushInterval() (time.Duration, bool)
 	GlobalEvmLogBackfillBatchSize() (uint32, bool)
 	GlobalEvmLogPollInterval() (time.Duration, bool)
 	GlobalEvmLogKeepBlocksDepth() (uint32, bool)
+	GlobalEvmLogTTL() (time.Duration, bool)
+	GlobalEvmLogPruneInterval() (time.Duration, bool)
+	GlobalEvmLogFetcherUseBlockHash() (bool, bool)
 	GlobalEvmMaxGasPriceWei() (*assets.Wei, bool)
+	GlobalEvmMaxGasPriceWarningThresholdPercent() (uint8, bool)
 	GlobalEvmMaxInFlightTransactions() (uint32, bool)
 	GlobalEvmMaxQueuedTransactions() (uint64, bool)
 	GlobalEvmMinGasPriceWei() (*assets.Wei, bool)
+	GlobalEvmGasPriceFloor() (*assets.Wei, bool)
+	GlobalEvmGasPriceCacheTTL() (time.Duration, bool)
 	GlobalEvmNonceAutoSync() (bool, bool)
+	GlobalEvmNonceAutoFillGap() (bool, bool)
 	GlobalEvmUseForwarders() (bool, bool)
+	GlobalEvmSimulateTransactions() (bool, bool)
+	GlobalEvmTxPriorityQueue() (bool, bool)
+	GlobalEvmAllowUnprotectedTxs() (bool, bool)
 	GlobalEvmRPCDefaultBatchSize() (uint32, bool)
+	GlobalEvmRPCMaxInFlight() (uint32, bool)
+	GlobalEvmChainReadTimeoutFactor() (float64, bool)
+	GlobalEvmConfigReadOnly() (bool, bool)
+	GlobalEvmChainLocked() (bool, bool)
 	GlobalFlagsContractAddress() (string, bool)
 	GlobalGasEstimatorMode() (string, bool)
+	GlobalEvmGasEstimatorFallbackMode() (string, bool)
+	GlobalEvmGasEstimatorCheckRetries() (uint8, bool)
+	GlobalEvmGasOraclePollInterval() (time.Duration, bool)
+	GlobalEvmGasOracleAddress() (string, bool)
+	GlobalEvmGasPriceFeedEnabled() (bool, bool)
+	GlobalEvmGasPriceFeedAddress() (string, bool)
 	GlobalLinkContractAddress() (string, bool)
 	GlobalOCRContractConfirmations() (uint16, bool)
+	GlobalEvmOCRContractLookbackBlocks() (uint64, bool)
 	GlobalOCRContractTransmitterTransmitTimeout() (time.Duration, bool)
 	GlobalOCRDatabaseTimeout() (time.Duration, bool)
 	GlobalOCRObservationGracePeriod() (time.Duration, bool)
+	GlobalEvmOCRMinObservationTimeout() (time.Duration, bool)
 	GlobalOCR2AutomationGasLimit() (uint32, bool)
 	GlobalOperatorFactoryAddress() (string, bool)
 	GlobalMinIncomingConfirmations() (uint32, bool)
@@ -258,6 +315,7 @@ type GlobalConfig interface {
 	GlobalNodePollFailureThreshold() (uint32, bool)
 	GlobalNodePollInterval() (time.Duration, bool)
 	GlobalNodeSelectionMode() (string, bool)
+	GlobalEvmNodeSyncThreshold() (uint32, bool)
 }
 
 type GeneralConfig interface {
@@ -1283,6 +1341,27 @@ func lookupEnv[T any](c *generalConfig, k string, parse func(string) (T, error))
 func (c *generalConfig) GlobalBalanceMonitorEnabled() (bool, bool) {
 	return lookupEnv(c, envvar.Name("BalanceMonitorEnabled"), strconv.ParseBool)
 }
+func (c *generalConfig) GlobalEvmAutoWalletFundEnabled() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmAutoWalletFundEnabled"), strconv.ParseBool)
+}
+func (c *generalConfig) GlobalEvmAutoWalletFundThreshold() (*assets.Wei, bool) {
+	return lookupEnv(c, envvar.Name("EvmAutoWalletFundThreshold"), parse.Wei)
+}
+func (c *generalConfig) GlobalEvmAutoWalletFundAmount() (*assets.Wei, bool) {
+	return lookupEnv(c, envvar.Name("EvmAutoWalletFundAmount"), parse.Wei)
+}
+func (c *generalConfig) GlobalEvmAutoWalletFundTreasuryAddress() (string, bool) {
+	return lookupEnv(c, envvar.Name("EvmAutoWalletFundTreasuryAddress"), parse.String)
+}
+func (c *generalConfig) GlobalEvmAutoCreateSendingKey() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmAutoCreateSendingKey"), strconv.ParseBool)
+}
+func (c *generalConfig) GlobalEvmWalletBalancePollInterval() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmWalletBalancePollInterval"), time.ParseDuration)
+}
+func (c *generalConfig) GlobalEvmMaxOutstandingBalance() (*assets.Wei, bool) {
+	return lookupEnv(c, envvar.Name("EvmMaxOutstandingBalance"), parse.Wei)
+}
 func (c *generalConfig) GlobalBlockEmissionIdleWarningThreshold() (time.Duration, bool) {
 	return lookupEnv(c, envvar.Name("BlockEmissionIdleWarningThreshold"), time.ParseDuration)
 }
@@ -1298,6 +1377,18 @@ func (c *generalConfig) GlobalBlockHistoryEstimatorBlockHistorySize() (uint16, b
 func (c *generalConfig) GlobalBlockHistoryEstimatorTransactionPercentile() (uint16, bool) {
 	return lookupEnv(c, envvar.Name("BlockHistoryEstimatorTransactionPercentile"), parse.Uint16)
 }
+func (c *generalConfig) GlobalEvmGasEstimatorTransactionPriceHistory() (uint16, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasEstimatorTransactionPriceHistory"), parse.Uint16)
+}
+func (c *generalConfig) GlobalEvmGasEstimatorBlockHistoryEWMAAlpha() (float64, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasEstimatorBlockHistoryEWMAAlpha"), parse.F64)
+}
+func (c *generalConfig) GlobalEvmGasEstimatorMinSampleCount() (uint16, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasEstimatorMinSampleCount"), parse.Uint16)
+}
+func (c *generalConfig) GlobalEvmGasHistoryExcludeZeroPriceTxs() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasHistoryExcludeZeroPriceTxs"), strconv.ParseBool)
+}
 func (c *generalConfig) GlobalEthTxReaperInterval() (time.Duration, bool) {
 	return lookupEnv(c, envvar.Name("EthTxReaperInterval"), time.ParseDuration)
 }
@@ -1307,6 +1398,18 @@ func (c *generalConfig) GlobalEthTxReaperThreshold() (time.Duration, bool) {
 func (c *generalConfig) GlobalEthTxResendAfterThreshold() (time.Duration, bool) {
 	return lookupEnv(c, envvar.Name("EthTxResendAfterThreshold"), time.ParseDuration)
 }
+func (c *generalConfig) GlobalEvmTxExpiry() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmTxExpiry"), time.ParseDuration)
+}
+func (c *generalConfig) GlobalEvmReceiptPollingInterval() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmReceiptPollingInterval"), time.ParseDuration)
+}
+func (c *generalConfig) GlobalEvmMaxRevertedTransactionsPerBlock() (uint16, bool) {
+	return lookupEnv(c, envvar.Name("EvmMaxRevertedTransactionsPerBlock"), parse.Uint16)
+}
+func (c *generalConfig) GlobalEvmCallDataSizeLimit() (uint32, bool) {
+	return lookupEnv(c, envvar.Name("EvmCallDataSizeLimit"), parse.Uint32)
+}
 func (c *generalConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmFinalityDepth"), parse.Uint32)
 }
@@ -1316,6 +1419,9 @@ func (c *generalConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 func (c *generalConfig) GlobalEvmGasBumpThreshold() (uint64, bool) {
 	return lookupEnv(c, envvar.Name("EvmGasBumpThreshold"), parse.Uint64)
 }
+func (c *generalConfig) GlobalEvmGasNoBumpThreshold() (uint64, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasNoBumpThreshold"), parse.Uint64)
+}
 func (c *generalConfig) GlobalEvmGasBumpTxDepth() (uint16, bool) {
 	return lookupEnv(c, envvar.Name("EvmGasBumpTxDepth"), parse.Uint16)
 }
@@ -1325,6 +1431,18 @@ func (c *generalConfig) GlobalEvmGasBumpWei() (*assets.Wei, bool) {
 func (c *generalConfig) GlobalEvmGasFeeCapDefault() (*assets.Wei, bool) {
 	return lookupEnv(c, envvar.Name("EvmGasFeeCapDefault"), parse.Wei)
 }
+func (c *generalConfig) GlobalEvmGasFeeCapRounding() (*assets.Wei, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasFeeCapRounding"), parse.Wei)
+}
+func (c *generalConfig) GlobalEvmBlobGasPriceDefault() (*assets.Wei, bool) {
+	return lookupEnv(c, envvar.Name("EvmBlobGasPriceDefault"), parse.Wei)
+}
+func (c *generalConfig) GlobalEvmMaxBlobGasPrice() (*assets.Wei, bool) {
+	return lookupEnv(c, envvar.Name("EvmMaxBlobGasPrice"), parse.Wei)
+}
+func (c *generalConfig) GlobalEvmFeeHistoryBlocks() (uint16, bool) {
+	return lookupEnv(c, envvar.Name("EvmFeeHistoryBlocks"), parse.Uint16)
+}
 func (c *generalConfig) GlobalBlockHistoryEstimatorEIP1559FeeCapBufferBlocks() (uint16, bool) {
 	return lookupEnv(c, envvar.Name("BlockHistoryEstimatorEIP1559FeeCapBufferBlocks"), parse.Uint16)
 }
@@ -1340,15 +1458,30 @@ func (c *generalConfig) GlobalEvmGasLimitDefault() (uint32, bool) {
 func (c *generalConfig) GlobalEvmGasLimitMax() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmGasLimitMax"), parse.Uint32)
 }
+func (c *generalConfig) GlobalEvmGasLimitIncrementOnFailure() (uint32, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasLimitIncrementOnFailure"), parse.Uint32)
+}
 func (c *generalConfig) GlobalEvmGasLimitMultiplier() (float32, bool) {
 	return lookupEnv(c, envvar.Name("EvmGasLimitMultiplier"), parse.F32)
 }
+func (c *generalConfig) GlobalEvmGasCapacityBuffer() (float64, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasCapacityBuffer"), parse.F64)
+}
 func (c *generalConfig) GlobalEvmGasLimitTransfer() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmGasLimitTransfer"), parse.Uint32)
 }
+func (c *generalConfig) GlobalEvmContractCreationGasLimit() (uint32, bool) {
+	return lookupEnv(c, envvar.Name("EvmContractCreationGasLimit"), parse.Uint32)
+}
 func (c *generalConfig) GlobalEvmGasPriceDefault() (*assets.Wei, bool) {
 	return lookupEnv(c, envvar.Name("EvmGasPriceDefault"), parse.Wei)
 }
+func (c *generalConfig) GlobalEvmGasPriceAutoAdjust() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasPriceAutoAdjust"), strconv.ParseBool)
+}
+func (c *generalConfig) GlobalEvmGasPriceAutoAdjustInterval() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasPriceAutoAdjustInterval"), time.ParseDuration)
+}
 func (c *generalConfig) GlobalEvmGasLimitOCRJobType() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmGasLimitOCRJobType"), parse.Uint32)
 }
@@ -1373,6 +1506,21 @@ func (c *generalConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
 func (c *generalConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool) {
 	return lookupEnv(c, envvar.Name("EvmHeadTrackerSamplingInterval"), time.ParseDuration)
 }
+func (c *generalConfig) GlobalEvmHeadTrackerCallbackTimeout() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmHeadTrackerCallbackTimeout"), time.ParseDuration)
+}
+func (c *generalConfig) GlobalEvmHeadTrackerBlockDelay() (uint16, bool) {
+	return lookupEnv(c, envvar.Name("EvmHeadTrackerBlockDelay"), parse.Uint16)
+}
+func (c *generalConfig) GlobalEvmMaxReorgDepth() (uint32, bool) {
+	return lookupEnv(c, envvar.Name("EvmMaxReorgDepth"), parse.Uint32)
+}
+func (c *generalConfig) GlobalEvmMaxBlockAge() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmMaxBlockAge"), time.ParseDuration)
+}
+func (c *generalConfig) GlobalEvmTrackerBlockHistoryFlushInterval() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmTrackerBlockHistoryFlushInterval"), time.ParseDuration)
+}
 func (c *generalConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmLogBackfillBatchSize"), parse.Uint32)
 }
@@ -1382,9 +1530,23 @@ func (c *generalConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
 func (c *generalConfig) GlobalEvmLogKeepBlocksDepth() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmLogKeepBlocksDepth"), parse.Uint32)
 }
+
+func (c *generalConfig) GlobalEvmLogTTL() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmLogTTL"), time.ParseDuration)
+}
+
+func (c *generalConfig) GlobalEvmLogPruneInterval() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmLogPruneInterval"), time.ParseDuration)
+}
+func (c *generalConfig) GlobalEvmLogFetcherUseBlockHash() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmLogFetcherUseBlockHash"), strconv.ParseBool)
+}
 func (c *generalConfig) GlobalEvmMaxGasPriceWei() (*assets.Wei, bool) {
 	return lookupEnv(c, envvar.Name("EvmMaxGasPriceWei"), parse.Wei)
 }
+func (c *generalConfig) GlobalEvmMaxGasPriceWarningThresholdPercent() (uint8, bool) {
+	return lookupEnv(c, envvar.Name("EvmMaxGasPriceWarningThresholdPercent"), parse.Uint8)
+}
 func (c *generalConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmMaxInFlightTransactions"), parse.Uint32)
 }
@@ -1394,15 +1556,39 @@ func (c *generalConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
 func (c *generalConfig) GlobalEvmMinGasPriceWei() (*assets.Wei, bool) {
 	return lookupEnv(c, envvar.Name("EvmMinGasPriceWei"), parse.Wei)
 }
+func (c *generalConfig) GlobalEvmGasPriceFloor() (*assets.Wei, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasPriceFloor"), parse.Wei)
+}
+func (c *generalConfig) GlobalEvmGasPriceCacheTTL() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasPriceCacheTTL"), time.ParseDuration)
+}
 func (c *generalConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	return lookupEnv(c, envvar.Name("EvmNonceAutoSync"), strconv.ParseBool)
 }
+func (c *generalConfig) GlobalEvmNonceAutoFillGap() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmNonceAutoFillGap"), strconv.ParseBool)
+}
 func (c *generalConfig) GlobalEvmUseForwarders() (bool, bool) {
 	return lookupEnv(c, envvar.Name("EvmUseForwarders"), strconv.ParseBool)
 }
+func (c *generalConfig) GlobalEvmSimulateTransactions() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmSimulateTransactions"), strconv.ParseBool)
+}
+func (c *generalConfig) GlobalEvmTxPriorityQueue() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmTxPriorityQueue"), strconv.ParseBool)
+}
+func (c *generalConfig) GlobalEvmAllowUnprotectedTxs() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmAllowUnprotectedTxs"), strconv.ParseBool)
+}
 func (c *generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmRPCDefaultBatchSize"), parse.Uint32)
 }
+func (c *generalConfig) GlobalEvmRPCMaxInFlight() (uint32, bool) {
+	return lookupEnv(c, envvar.Name("EvmRPCMaxInFlight"), parse.Uint32)
+}
+func (c *generalConfig) GlobalEvmChainReadTimeoutFactor() (float64, bool) {
+	return lookupEnv(c, envvar.Name("EvmChainReadTimeoutFactor"), parse.F64)
+}
 func (c *generalConfig) GlobalFlagsContractAddress() (string, bool) {
 	return lookupEnv(c, envvar.Name("FlagsContractAddress"), parse.String)
 }
@@ -1410,6 +1596,30 @@ func (c *generalConfig) GlobalGasEstimatorMode() (string, bool) {
 	return lookupEnv(c, envvar.Name("GasEstimatorMode"), parse.String)
 }
 
+func (c *generalConfig) GlobalEvmGasEstimatorFallbackMode() (string, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasEstimatorFallbackMode"), parse.String)
+}
+
+func (c *generalConfig) GlobalEvmGasEstimatorCheckRetries() (uint8, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasEstimatorCheckRetries"), parse.Uint8)
+}
+
+func (c *generalConfig) GlobalEvmGasOraclePollInterval() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasOraclePollInterval"), time.ParseDuration)
+}
+
+func (c *generalConfig) GlobalEvmGasOracleAddress() (string, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasOracleAddress"), parse.String)
+}
+
+func (c *generalConfig) GlobalEvmGasPriceFeedEnabled() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasPriceFeedEnabled"), strconv.ParseBool)
+}
+
+func (c *generalConfig) GlobalEvmGasPriceFeedAddress() (string, bool) {
+	return lookupEnv(c, envvar.Name("EvmGasPriceFeedAddress"), parse.String)
+}
+
 // GlobalChainType overrides all chains and forces them to act as a particular
 // chain type. List of chain types is given in `chaintype.go`.
 func (c *generalConfig) GlobalChainType() (string, bool) {
@@ -1430,6 +1640,9 @@ func (c *generalConfig) GlobalMinimumContractPayment() (*assets.Link, bool) {
 func (c *generalConfig) GlobalEvmEIP1559DynamicFees() (bool, bool) {
 	return lookupEnv(c, envvar.Name("EvmEIP1559DynamicFees"), strconv.ParseBool)
 }
+func (c *generalConfig) GlobalEvmMinimumFeeMarket() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmMinimumFeeMarket"), strconv.ParseBool)
+}
 func (c *generalConfig) GlobalEvmGasTipCapDefault() (*assets.Wei, bool) {
 	return lookupEnv(c, envvar.Name("EvmGasTipCapDefault"), parse.Wei)
 }
@@ -1453,10 +1666,38 @@ func (c *generalConfig) GlobalNodeSelectionMode() (string, bool) {
 	return lookupEnv(c, envvar.Name("NodeSelectionMode"), parse.String)
 }
 
+func (c *generalConfig) GlobalEvmNodeSyncThreshold() (uint32, bool) {
+	return lookupEnv(c, envvar.Name("EvmNodeSyncThreshold"), parse.Uint32)
+}
+
 func (c *generalConfig) GlobalOCR2AutomationGasLimit() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("OCR2AutomationGasLimit"), parse.Uint32)
 }
 
+func (c *generalConfig) GlobalEvmConfigReadOnly() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmConfigReadOnly"), strconv.ParseBool)
+}
+
+func (c *generalConfig) GlobalEvmChainLocked() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmChainLocked"), strconv.ParseBool)
+}
+
+func (c *generalConfig) GlobalEvmBlockTime() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmBlockTime"), time.ParseDuration)
+}
+
+func (c *generalConfig) GlobalEvmDatabaseQueryTimeout() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmDatabaseQueryTimeout"), time.ParseDuration)
+}
+
+func (c *generalConfig) GlobalEvmContractCallTimeout() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmContractCallTimeout"), time.ParseDuration)
+}
+
+func (c *generalConfig) GlobalEvmHealthCheckGracePeriod() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmHealthCheckGracePeriod"), time.ParseDuration)
+}
+
 // DatabaseLockingMode can be one of 'dual', 'advisorylock', 'lease' or 'none'
 // It controls which mode to use to enforce that only one Chainlink application can use the database
 func (c *generalConfig) DatabaseLockingMode() string {
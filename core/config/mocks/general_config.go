@@ -1187,8 +1187,8 @@ func (_m *GeneralConfig) GlobalEthTxResendAfterThreshold() (time.Duration, bool)
 	return r0, r1
 }
 
-// GlobalEvmEIP1559DynamicFees provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmEIP1559DynamicFees() (bool, bool) {
+// GlobalEvmAllowUnprotectedTxs provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmAllowUnprotectedTxs() (bool, bool) {
 	ret := _m.Called()
 
 	var r0 bool
@@ -1208,15 +1208,15 @@ func (_m *GeneralConfig) GlobalEvmEIP1559DynamicFees() (bool, bool) {
 	return r0, r1
 }
 
-// GlobalEvmFinalityDepth provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmFinalityDepth() (uint32, bool) {
+// GlobalEvmAutoCreateSendingKey provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmAutoCreateSendingKey() (bool, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 bool
@@ -1229,15 +1229,17 @@ func (_m *GeneralConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpPercent provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
+// GlobalEvmAutoWalletFundAmount provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmAutoWalletFundAmount() (*assets.Wei, bool) {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
 	}
 
 	var r1 bool
@@ -1250,15 +1252,15 @@ func (_m *GeneralConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpThreshold provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasBumpThreshold() (uint64, bool) {
+// GlobalEvmAutoWalletFundEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmAutoWalletFundEnabled() (bool, bool) {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 bool
@@ -1271,15 +1273,17 @@ func (_m *GeneralConfig) GlobalEvmGasBumpThreshold() (uint64, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpTxDepth provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasBumpTxDepth() (uint16, bool) {
+// GlobalEvmAutoWalletFundThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmAutoWalletFundThreshold() (*assets.Wei, bool) {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
 	}
 
 	var r1 bool
@@ -1292,17 +1296,15 @@ func (_m *GeneralConfig) GlobalEvmGasBumpTxDepth() (uint16, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpWei provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasBumpWei() (*assets.Wei, bool) {
+// GlobalEvmAutoWalletFundTreasuryAddress provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmAutoWalletFundTreasuryAddress() (string, bool) {
 	ret := _m.Called()
 
-	var r0 *assets.Wei
-	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*assets.Wei)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1315,8 +1317,8 @@ func (_m *GeneralConfig) GlobalEvmGasBumpWei() (*assets.Wei, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasFeeCapDefault provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasFeeCapDefault() (*assets.Wei, bool) {
+// GlobalEvmBlobGasPriceDefault provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmBlobGasPriceDefault() (*assets.Wei, bool) {
 	ret := _m.Called()
 
 	var r0 *assets.Wei
@@ -1338,15 +1340,15 @@ func (_m *GeneralConfig) GlobalEvmGasFeeCapDefault() (*assets.Wei, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitDRJobType provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasLimitDRJobType() (uint32, bool) {
+// GlobalEvmBlockTime provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmBlockTime() (time.Duration, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	var r1 bool
@@ -1359,8 +1361,8 @@ func (_m *GeneralConfig) GlobalEvmGasLimitDRJobType() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitDefault provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasLimitDefault() (uint32, bool) {
+// GlobalEvmCallDataSizeLimit provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmCallDataSizeLimit() (uint32, bool) {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -1380,15 +1382,15 @@ func (_m *GeneralConfig) GlobalEvmGasLimitDefault() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitFMJobType provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasLimitFMJobType() (uint32, bool) {
+// GlobalEvmChainLocked provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmChainLocked() (bool, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 bool
@@ -1401,15 +1403,15 @@ func (_m *GeneralConfig) GlobalEvmGasLimitFMJobType() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitKeeperJobType provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasLimitKeeperJobType() (uint32, bool) {
+// GlobalEvmChainReadTimeoutFactor provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmChainReadTimeoutFactor() (float64, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(float64)
 	}
 
 	var r1 bool
@@ -1422,15 +1424,15 @@ func (_m *GeneralConfig) GlobalEvmGasLimitKeeperJobType() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitMax provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasLimitMax() (uint32, bool) {
+// GlobalEvmConfigReadOnly provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmConfigReadOnly() (bool, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 bool
@@ -1443,15 +1445,15 @@ func (_m *GeneralConfig) GlobalEvmGasLimitMax() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitMultiplier provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasLimitMultiplier() (float32, bool) {
+// GlobalEvmContractCallTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmContractCallTimeout() (time.Duration, bool) {
 	ret := _m.Called()
 
-	var r0 float32
-	if rf, ok := ret.Get(0).(func() float32); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(float32)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	var r1 bool
@@ -1464,8 +1466,8 @@ func (_m *GeneralConfig) GlobalEvmGasLimitMultiplier() (float32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitOCRJobType provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasLimitOCRJobType() (uint32, bool) {
+// GlobalEvmContractCreationGasLimit provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmContractCreationGasLimit() (uint32, bool) {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -1485,15 +1487,15 @@ func (_m *GeneralConfig) GlobalEvmGasLimitOCRJobType() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitTransfer provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasLimitTransfer() (uint32, bool) {
+// GlobalEvmDatabaseQueryTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmDatabaseQueryTimeout() (time.Duration, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	var r1 bool
@@ -1506,15 +1508,15 @@ func (_m *GeneralConfig) GlobalEvmGasLimitTransfer() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitVRFJobType provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasLimitVRFJobType() (uint32, bool) {
+// GlobalEvmEIP1559DynamicFees provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmEIP1559DynamicFees() (bool, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 bool
@@ -1527,17 +1529,15 @@ func (_m *GeneralConfig) GlobalEvmGasLimitVRFJobType() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasPriceDefault provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasPriceDefault() (*assets.Wei, bool) {
+// GlobalEvmFeeHistoryBlocks provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmFeeHistoryBlocks() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 *assets.Wei
-	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*assets.Wei)
-		}
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 bool
@@ -1550,17 +1550,15 @@ func (_m *GeneralConfig) GlobalEvmGasPriceDefault() (*assets.Wei, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasTipCapDefault provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasTipCapDefault() (*assets.Wei, bool) {
+// GlobalEvmFinalityDepth provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 *assets.Wei
-	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*assets.Wei)
-		}
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 bool
@@ -1573,17 +1571,15 @@ func (_m *GeneralConfig) GlobalEvmGasTipCapDefault() (*assets.Wei, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasTipCapMinimum provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasTipCapMinimum() (*assets.Wei, bool) {
+// GlobalEvmGasBumpPercent provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 *assets.Wei
-	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*assets.Wei)
-		}
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 bool
@@ -1596,15 +1592,15 @@ func (_m *GeneralConfig) GlobalEvmGasTipCapMinimum() (*assets.Wei, bool) {
 	return r0, r1
 }
 
-// GlobalEvmHeadTrackerHistoryDepth provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
+// GlobalEvmGasBumpThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasBumpThreshold() (uint64, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 bool
@@ -1617,15 +1613,15 @@ func (_m *GeneralConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmHeadTrackerMaxBufferSize provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
+// GlobalEvmGasBumpTxDepth provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasBumpTxDepth() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 bool
@@ -1638,15 +1634,17 @@ func (_m *GeneralConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmHeadTrackerSamplingInterval provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool) {
+// GlobalEvmGasBumpWei provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasBumpWei() (*assets.Wei, bool) {
 	ret := _m.Called()
 
-	var r0 time.Duration
-	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
 	}
 
 	var r1 bool
@@ -1659,15 +1657,15 @@ func (_m *GeneralConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration,
 	return r0, r1
 }
 
-// GlobalEvmLogBackfillBatchSize provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
+// GlobalEvmGasCapacityBuffer provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasCapacityBuffer() (float64, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(float64)
 	}
 
 	var r1 bool
@@ -1680,15 +1678,15 @@ func (_m *GeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmLogKeepBlocksDepth provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmLogKeepBlocksDepth() (uint32, bool) {
+// GlobalEvmGasEstimatorBlockHistoryEWMAAlpha provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasEstimatorBlockHistoryEWMAAlpha() (float64, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(float64)
 	}
 
 	var r1 bool
@@ -1701,15 +1699,15 @@ func (_m *GeneralConfig) GlobalEvmLogKeepBlocksDepth() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmLogPollInterval provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
+// GlobalEvmGasEstimatorCheckRetries provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasEstimatorCheckRetries() (uint8, bool) {
 	ret := _m.Called()
 
-	var r0 time.Duration
-	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 uint8
+	if rf, ok := ret.Get(0).(func() uint8); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		r0 = ret.Get(0).(uint8)
 	}
 
 	var r1 bool
@@ -1722,17 +1720,15 @@ func (_m *GeneralConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMaxGasPriceWei provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmMaxGasPriceWei() (*assets.Wei, bool) {
+// GlobalEvmGasEstimatorFallbackMode provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasEstimatorFallbackMode() (string, bool) {
 	ret := _m.Called()
 
-	var r0 *assets.Wei
-	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*assets.Wei)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1745,15 +1741,15 @@ func (_m *GeneralConfig) GlobalEvmMaxGasPriceWei() (*assets.Wei, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMaxInFlightTransactions provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
+// GlobalEvmGasEstimatorMinSampleCount provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasEstimatorMinSampleCount() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 bool
@@ -1766,15 +1762,15 @@ func (_m *GeneralConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMaxQueuedTransactions provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
+// GlobalEvmGasEstimatorTransactionPriceHistory provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasEstimatorTransactionPriceHistory() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 bool
@@ -1787,8 +1783,8 @@ func (_m *GeneralConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMinGasPriceWei provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmMinGasPriceWei() (*assets.Wei, bool) {
+// GlobalEvmGasFeeCapDefault provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasFeeCapDefault() (*assets.Wei, bool) {
 	ret := _m.Called()
 
 	var r0 *assets.Wei
@@ -1810,8 +1806,31 @@ func (_m *GeneralConfig) GlobalEvmMinGasPriceWei() (*assets.Wei, bool) {
 	return r0, r1
 }
 
-// GlobalEvmNonceAutoSync provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmNonceAutoSync() (bool, bool) {
+// GlobalEvmGasFeeCapRounding provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasFeeCapRounding() (*assets.Wei, bool) {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasHistoryExcludeZeroPriceTxs provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasHistoryExcludeZeroPriceTxs() (bool, bool) {
 	ret := _m.Called()
 
 	var r0 bool
@@ -1831,8 +1850,8 @@ func (_m *GeneralConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	return r0, r1
 }
 
-// GlobalEvmRPCDefaultBatchSize provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
+// GlobalEvmGasLimitDRJobType provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitDRJobType() (uint32, bool) {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -1852,15 +1871,1228 @@ func (_m *GeneralConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmUseForwarders provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmUseForwarders() (bool, bool) {
+// GlobalEvmGasLimitDefault provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitDefault() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitFMJobType provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitFMJobType() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitIncrementOnFailure provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitIncrementOnFailure() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitKeeperJobType provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitKeeperJobType() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitMax provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitMax() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitMultiplier provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitMultiplier() (float32, bool) {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitOCRJobType provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitOCRJobType() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitTransfer provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitTransfer() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitVRFJobType provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitVRFJobType() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasNoBumpThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasNoBumpThreshold() (uint64, bool) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasOracleAddress provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasOracleAddress() (string, bool) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasOraclePollInterval provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasOraclePollInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasPriceAutoAdjust provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasPriceAutoAdjust() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasPriceAutoAdjustInterval provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasPriceAutoAdjustInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasPriceCacheTTL provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasPriceCacheTTL() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasPriceDefault provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasPriceDefault() (*assets.Wei, bool) {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasPriceFeedAddress provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasPriceFeedAddress() (string, bool) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasPriceFeedEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasPriceFeedEnabled() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasPriceFloor provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasPriceFloor() (*assets.Wei, bool) {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasTipCapDefault provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasTipCapDefault() (*assets.Wei, bool) {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasTipCapMinimum provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasTipCapMinimum() (*assets.Wei, bool) {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmHeadTrackerBlockDelay provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmHeadTrackerBlockDelay() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmHeadTrackerCallbackTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmHeadTrackerCallbackTimeout() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmHeadTrackerHistoryDepth provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmHeadTrackerMaxBufferSize provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmHeadTrackerSamplingInterval provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmHealthCheckGracePeriod provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmHealthCheckGracePeriod() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmLogBackfillBatchSize provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmLogFetcherUseBlockHash provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmLogFetcherUseBlockHash() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmLogKeepBlocksDepth provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmLogKeepBlocksDepth() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmLogPollInterval provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmLogPruneInterval provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmLogPruneInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmLogTTL provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmLogTTL() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmMaxBlobGasPrice provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxBlobGasPrice() (*assets.Wei, bool) {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmMaxBlockAge provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxBlockAge() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmMaxGasPriceWarningThresholdPercent provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxGasPriceWarningThresholdPercent() (uint8, bool) {
+	ret := _m.Called()
+
+	var r0 uint8
+	if rf, ok := ret.Get(0).(func() uint8); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint8)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmMaxGasPriceWei provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxGasPriceWei() (*assets.Wei, bool) {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmMaxInFlightTransactions provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmMaxOutstandingBalance provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxOutstandingBalance() (*assets.Wei, bool) {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmMaxQueuedTransactions provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmMaxReorgDepth provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxReorgDepth() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmMaxRevertedTransactionsPerBlock provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxRevertedTransactionsPerBlock() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmMinGasPriceWei provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMinGasPriceWei() (*assets.Wei, bool) {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmMinimumFeeMarket provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMinimumFeeMarket() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmNodeSyncThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmNodeSyncThreshold() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmNonceAutoFillGap provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmNonceAutoFillGap() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmNonceAutoSync provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmNonceAutoSync() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmOCRContractLookbackBlocks provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmOCRContractLookbackBlocks() (uint64, bool) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmOCRMinObservationTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmOCRMinObservationTimeout() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmRPCDefaultBatchSize provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmRPCMaxInFlight provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmRPCMaxInFlight() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmReceiptPollingInterval provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmReceiptPollingInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmSimulateTransactions provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmSimulateTransactions() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmTrackerBlockHistoryFlushInterval provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmTrackerBlockHistoryFlushInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmTxExpiry provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmTxExpiry() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmTxPriorityQueue provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmTxPriorityQueue() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmUseForwarders provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmUseForwarders() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmWalletBalancePollInterval provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmWalletBalancePollInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	var r1 bool
@@ -34,10 +34,18 @@ func (c *generalConfig) GlobalOCRContractConfirmations() (uint16, bool) {
 	return lookupEnv(c, envvar.Name("OCRContractConfirmations"), parse.Uint16)
 }
 
+func (c *generalConfig) GlobalEvmOCRContractLookbackBlocks() (uint64, bool) {
+	return lookupEnv(c, envvar.Name("EvmOCRContractLookbackBlocks"), parse.Uint64)
+}
+
 func (c *generalConfig) GlobalOCRObservationGracePeriod() (time.Duration, bool) {
 	return lookupEnv(c, envvar.Name("OCRObservationGracePeriod"), time.ParseDuration)
 }
 
+func (c *generalConfig) GlobalEvmOCRMinObservationTimeout() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("EvmOCRMinObservationTimeout"), time.ParseDuration)
+}
+
 func (c *generalConfig) GlobalOCRContractTransmitterTransmitTimeout() (time.Duration, bool) {
 	return lookupEnv(c, envvar.Name("OCRContractTransmitterTransmitTimeout"), time.ParseDuration)
 }
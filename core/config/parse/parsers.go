@@ -36,6 +36,11 @@ func LogLevel(str string) (zapcore.Level, error) {
 	return lvl, err
 }
 
+func Uint8(s string) (uint8, error) {
+	v, err := strconv.ParseUint(s, 10, 8)
+	return uint8(v), err
+}
+
 func Uint16(s string) (uint16, error) {
 	v, err := strconv.ParseUint(s, 10, 16)
 	return uint16(v), err
@@ -61,6 +66,11 @@ func F32(s string) (float32, error) {
 	return float32(v), err
 }
 
+// F64 converts string to float64
+func F64(s string) (float64, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
 // URL converts string to parsed URL type
 func URL(s string) (interface{}, error) {
 	return url.Parse(s)
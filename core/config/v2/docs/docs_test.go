@@ -71,9 +71,31 @@ func TestDoc(t *testing.T) {
 		require.Zero(t, *docDefaults.FlagsContractAddress)
 		require.Zero(t, *docDefaults.LinkContractAddress)
 		require.Zero(t, *docDefaults.OperatorFactoryAddress)
+		require.Zero(t, *docDefaults.GasEstimator.OracleAddress)
+		require.Zero(t, *docDefaults.GasEstimator.PriceFeedAddress)
+		require.Zero(t, *docDefaults.BalanceMonitor.AutoFundTreasuryAddress)
 		docDefaults.FlagsContractAddress = nil
 		docDefaults.LinkContractAddress = nil
 		docDefaults.OperatorFactoryAddress = nil
+		docDefaults.GasEstimator.OracleAddress = nil
+		docDefaults.GasEstimator.PriceFeedAddress = nil
+		docDefaults.BalanceMonitor.AutoFundTreasuryAddress = nil
+
+		// example-only values without global defaults
+		require.True(t, docDefaults.GasEstimator.PriceFloor.IsZero())
+		require.Zero(t, *docDefaults.OCR.KeyBundleID)
+		require.True(t, docDefaults.BalanceMonitor.MaxOutstandingBalance.IsZero())
+		require.True(t, docDefaults.GasEstimator.FeeCapRounding.IsZero())
+		require.Zero(t, *docDefaults.Alias)
+		require.Zero(t, *docDefaults.Transactions.L2SequencerHTTPURL)
+		require.True(t, docDefaults.RPCReadTimeoutFactor.IsZero())
+		docDefaults.GasEstimator.PriceFloor = nil
+		docDefaults.OCR.KeyBundleID = nil
+		docDefaults.BalanceMonitor.MaxOutstandingBalance = nil
+		docDefaults.GasEstimator.FeeCapRounding = nil
+		docDefaults.Alias = nil
+		docDefaults.Transactions.L2SequencerHTTPURL = nil
+		docDefaults.RPCReadTimeoutFactor = nil
 
 		assertTOML(t, fallbackDefaults, docDefaults)
 	})
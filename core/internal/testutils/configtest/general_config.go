@@ -84,6 +84,8 @@ type GeneralConfigOverrides struct {
 	GlobalEvmNonceAutoSync                          null.Bool
 	GlobalEvmRPCDefaultBatchSize                    null.Int
 	GlobalEvmUseForwarders                          null.Bool
+	GlobalEvmConfigReadOnly                         null.Bool
+	GlobalEvmChainLocked                            null.Bool
 	GlobalFlagsContractAddress                      null.String
 	GlobalGasEstimatorMode                          null.String
 	GlobalMinIncomingConfirmations                  null.Int
@@ -862,3 +864,17 @@ func (c *TestGeneralConfig) GlobalEvmUseForwarders() (bool, bool) {
 	}
 	return c.GeneralConfig.GlobalEvmUseForwarders()
 }
+
+func (c *TestGeneralConfig) GlobalEvmConfigReadOnly() (bool, bool) {
+	if c.Overrides.GlobalEvmConfigReadOnly.Valid {
+		return c.Overrides.GlobalEvmConfigReadOnly.Bool, true
+	}
+	return c.GeneralConfig.GlobalEvmConfigReadOnly()
+}
+
+func (c *TestGeneralConfig) GlobalEvmChainLocked() (bool, bool) {
+	if c.Overrides.GlobalEvmChainLocked.Valid {
+		return c.Overrides.GlobalEvmChainLocked.Bool, true
+	}
+	return c.GeneralConfig.GlobalEvmChainLocked()
+}
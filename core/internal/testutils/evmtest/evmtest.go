@@ -187,6 +187,10 @@ func (mo *MockORM) Clear(chainID utils.Big, key string) error {
 	panic("not implemented")
 }
 
+func (mo *MockORM) GetAuditLog(chainID utils.Big, key string) ([]evmtypes.ConfigAuditLogEntry, error) {
+	panic("not implemented")
+}
+
 func (mo *MockORM) Chain(id utils.Big, qopts ...pg.QOpt) (evmtypes.DBChain, error) {
 	mo.mu.RLock()
 	defer mo.mu.RUnlock()
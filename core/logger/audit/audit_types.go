@@ -48,9 +48,10 @@ const (
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
 
-	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
-	TerraTransactionCreated  EventID = "TERRA_TRANSACTION_CREATED"
-	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
+	EthTransactionCreated     EventID = "ETH_TRANSACTION_CREATED"
+	EthTransactionRebroadcast EventID = "ETH_TRANSACTION_REBROADCAST"
+	TerraTransactionCreated   EventID = "TERRA_TRANSACTION_CREATED"
+	SolanaTransactionCreated  EventID = "SOLANA_TRANSACTION_CREATED"
 
 	JobCreated EventID = "JOB_CREATED"
 	JobDeleted EventID = "JOB_DELETED"
@@ -59,6 +60,8 @@ const (
 	ChainSpecUpdated EventID = "CHAIN_SPEC_UPDATED"
 	ChainDeleted     EventID = "CHAIN_DELETED"
 
+	ChainReorgAcknowledged EventID = "CHAIN_REORG_ACKNOWLEDGED"
+
 	ChainRpcNodeAdded   EventID = "CHAIN_RPC_NODE_ADDED"
 	ChainRpcNodeDeleted EventID = "CHAIN_RPC_NODE_DELETED"
 
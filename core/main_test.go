@@ -537,9 +537,10 @@ func ExampleRun_txs_evm() {
 	//    core.test txs evm command [command options] [arguments...]
 	//
 	// COMMANDS:
-	//    create  Send <amount> ETH (or wei) from node ETH account <fromAddress> to destination <toAddress>.
-	//    list    List the Ethereum Transactions in descending order
-	//    show    get information on a specific Ethereum Transaction
+	//    create       Send <amount> ETH (or wei) from node ETH account <fromAddress> to destination <toAddress>.
+	//    list         List the Ethereum Transactions in descending order
+	//    show         get information on a specific Ethereum Transaction
+	//    rebroadcast  Replace a stuck Ethereum Transaction with a gas bumped one, keeping its nonce and payload. Prints the new transaction hash.
 	//
 	// OPTIONS:
 	//    --help, -h  show help
@@ -606,10 +607,11 @@ func ExampleRun_chains_evm() {
 	//    core.test chains evm command [command options] [arguments...]
 	//
 	// COMMANDS:
-	//    create     Create a new EVM chain
-	//    delete     Delete an existing EVM chain
-	//    list       List all existing EVM chains
-	//    configure  Configure an existing EVM chain
+	//    create       Create a new EVM chain
+	//    delete       Delete an existing EVM chain
+	//    list         List all existing EVM chains
+	//    configure    Configure an existing EVM chain
+	//    list-config  List the effective config of an EVM chain, and where each value comes from
 	//
 	// OPTIONS:
 	//    --help, -h  show help
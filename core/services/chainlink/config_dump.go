@@ -210,6 +210,43 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].Transactions.ResendAfterThreshold = d
 		}
 	}
+	if e := envvar.NewDuration("EvmTxExpiry").ParsePtr(); e != nil {
+		d := models.MustNewDuration(*e)
+		for i := range c.EVM {
+			c.EVM[i].Transactions.Expiry = d
+		}
+	}
+	if e := envvar.NewDuration("EvmReceiptPollingInterval").ParsePtr(); e != nil {
+		d := models.MustNewDuration(*e)
+		for i := range c.EVM {
+			c.EVM[i].Transactions.ReceiptPollingInterval = d
+		}
+	}
+	if e := envvar.NewBool("EvmAllowUnprotectedTxs").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].Transactions.AllowUnprotected = e
+		}
+	}
+	if e := envvar.NewBool("EvmTxPriorityQueue").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].Transactions.PriorityQueue = e
+		}
+	}
+	if e := envvar.NewUint32("EvmCallDataSizeLimit").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].Transactions.CallDataSizeLimit = e
+		}
+	}
+	if e := envvar.NewUint16("EvmMaxRevertedTransactionsPerBlock").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].Transactions.MaxRevertedPerBlock = e
+		}
+	}
+	if e := envvar.NewBool("EvmAutoCreateSendingKey").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].AutoCreateSendingKey = e
+		}
+	}
 	if e := envvar.NewUint32("EvmFinalityDepth").ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].FinalityDepth = e
@@ -231,11 +268,50 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].HeadTracker.SamplingInterval = d
 		}
 	}
+	if e := envvar.NewUint16("EvmHeadTrackerBlockDelay").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].HeadTracker.BlockDelay = e
+		}
+	}
+	if e := envvar.NewDuration("EvmTrackerBlockHistoryFlushInterval").ParsePtr(); e != nil {
+		d := models.MustNewDuration(*e)
+		for i := range c.EVM {
+			c.EVM[i].HeadTracker.FlushInterval = d
+		}
+	}
+	if e := envvar.NewDuration("EvmMaxBlockAge").ParsePtr(); e != nil {
+		d := models.MustNewDuration(*e)
+		for i := range c.EVM {
+			c.EVM[i].HeadTracker.MaxBlockAge = d
+		}
+	}
+	if e := envvar.NewUint32("EvmMaxReorgDepth").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].HeadTracker.MaxReorgDepth = e
+		}
+	}
 	if e := envvar.NewUint32("EvmLogBackfillBatchSize").ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].LogBackfillBatchSize = e
 		}
 	}
+	if e := envvar.NewDuration("EvmDatabaseQueryTimeout").ParsePtr(); e != nil {
+		d := models.MustNewDuration(*e)
+		for i := range c.EVM {
+			c.EVM[i].DatabaseQueryTimeout = d
+		}
+	}
+	if e := envvar.NewBool("EvmChainLocked").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].Locked = e
+		}
+	}
+	if e := envvar.NewDuration("EvmContractCallTimeout").ParsePtr(); e != nil {
+		d := models.MustNewDuration(*e)
+		for i := range c.EVM {
+			c.EVM[i].ContractCallTimeout = d
+		}
+	}
 	if e := envvar.NewDuration("EvmLogPollInterval").ParsePtr(); e != nil {
 		d := models.MustNewDuration(*e)
 		for i := range c.EVM {
@@ -307,11 +383,21 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].NodePool.SelectionMode = e
 		}
 	}
+	if e := envvar.NewUint32("EvmNodeSyncThreshold").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].NodePool.SyncThreshold = e
+		}
+	}
 	if e := envvar.NewBool("EvmEIP1559DynamicFees").ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].GasEstimator.EIP1559DynamicFees = e
 		}
 	}
+	if e := envvar.NewBool("EvmMinimumFeeMarket").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.MinimumFeeMarket = e
+		}
+	}
 	if e := envvar.NewUint16("EvmGasBumpPercent").ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].GasEstimator.BumpPercent = e
@@ -322,11 +408,36 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].GasEstimator.BumpThreshold = e
 		}
 	}
+	if e := envvar.NewUint32("EvmGasNoBumpThreshold").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.NoBumpThreshold = e
+		}
+	}
 	if e := envvar.New("EvmGasBumpWei", parse.BigInt).ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].GasEstimator.BumpMin = assets.NewWei(*e)
 		}
 	}
+	if e := envvar.New("EvmBlobGasPriceDefault", parse.BigInt).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.BlobPriceDefault = assets.NewWei(*e)
+		}
+	}
+	if e := envvar.New("EvmMaxBlobGasPrice", parse.BigInt).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.BlobPriceMax = assets.NewWei(*e)
+		}
+	}
+	if e := envvar.NewUint16("EvmFeeHistoryBlocks").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.FeeHistoryBlocks = e
+		}
+	}
+	if e := envvar.New("EvmGasFeeCapRounding", parse.BigInt).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.FeeCapRounding = assets.NewWei(*e)
+		}
+	}
 	if e := envvar.New("EvmGasFeeCapDefault", parse.BigInt).ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].GasEstimator.FeeCapDefault = assets.NewWei(*e)
@@ -337,6 +448,11 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].GasEstimator.LimitDefault = e
 		}
 	}
+	if e := envvar.NewUint32("EvmGasLimitIncrementOnFailure").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.LimitIncrementOnFailure = e
+		}
+	}
 	if e := envvar.NewUint32("EvmGasLimitMax").ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].GasEstimator.LimitMax = e
@@ -372,11 +488,26 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].GasEstimator.LimitMultiplier = e
 		}
 	}
+	if e := envvar.New("EvmChainReadTimeoutFactor", decimal.NewFromString).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].RPCReadTimeoutFactor = e
+		}
+	}
+	if e := envvar.New("EvmGasCapacityBuffer", decimal.NewFromString).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.LimitCapacityBuffer = e
+		}
+	}
 	if e := envvar.NewUint32("EvmGasLimitTransfer").ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].GasEstimator.LimitTransfer = e
 		}
 	}
+	if e := envvar.NewUint32("EvmContractCreationGasLimit").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.LimitContractCreation = e
+		}
+	}
 	if e := envvar.New("EvmGasPriceDefault", parse.BigInt).ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].GasEstimator.PriceDefault = assets.NewWei(*e)
@@ -397,11 +528,43 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].GasEstimator.PriceMax = assets.NewWei(*e)
 		}
 	}
+	if e := envvar.NewUint8("EvmMaxGasPriceWarningThresholdPercent").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.PriceMaxWarningThresholdPercent = e
+		}
+	}
+	if e := envvar.New("EvmMaxOutstandingBalance", parse.Wei).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].BalanceMonitor.MaxOutstandingBalance = *e
+		}
+	}
+	if e := envvar.New("EvmGasPriceFloor", parse.BigInt).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.PriceFloor = assets.NewWei(*e)
+		}
+	}
 	if e := envvar.New("EvmMinGasPriceWei", parse.BigInt).ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].GasEstimator.PriceMin = assets.NewWei(*e)
 		}
 	}
+	if e := envvar.NewUint8("EvmGasEstimatorCheckRetries").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.CheckRetries = e
+		}
+	}
+	if e := envvar.NewDuration("EvmGasOraclePollInterval").ParsePtr(); e != nil {
+		d := models.MustNewDuration(*e)
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.OraclePollInterval = d
+		}
+	}
+	if e := envvar.NewDuration("EvmGasPriceCacheTTL").ParsePtr(); e != nil {
+		d := models.MustNewDuration(*e)
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.PriceCacheTTL = d
+		}
+	}
 	if e := envvar.NewString("GasEstimatorMode").ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].GasEstimator.Mode = e
@@ -415,6 +578,21 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].GasEstimator.Mode = &v
 		}
 	}
+	if e := envvar.New("EvmGasOracleAddress", ethkey.NewEIP55Address).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.OracleAddress = e
+		}
+	}
+	if e := envvar.NewBool("EvmGasPriceFeedEnabled").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.PriceFeedEnabled = e
+		}
+	}
+	if e := envvar.New("EvmGasPriceFeedAddress", ethkey.NewEIP55Address).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.PriceFeedAddress = e
+		}
+	}
 	if e := envvar.NewUint16("EvmGasBumpTxDepth").ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].GasEstimator.BumpTxDepth = e
@@ -483,6 +661,26 @@ func (c *Config) loadLegacyEVMEnv() {
 			}
 		}
 	}
+	if e := envvar.NewUint16("EvmGasEstimatorMinSampleCount").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.BlockHistory.MinSampleCount = e
+		}
+	}
+	if e := envvar.NewBool("EvmGasHistoryExcludeZeroPriceTxs").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.BlockHistory.ExcludeZeroPriceTxs = e
+		}
+	}
+	if e := envvar.New("EvmGasEstimatorBlockHistoryEWMAAlpha", decimal.NewFromString).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.BlockHistory.EWMAAlpha = e
+		}
+	}
+	if e := envvar.NewUint16("EvmGasEstimatorTransactionPriceHistory").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.BlockHistory.TransactionPriceHistory = e
+		}
+	}
 	if e := envvar.NewUint32("EvmMaxInFlightTransactions").ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].Transactions.MaxInFlight = e
@@ -7,7 +7,24 @@ import (
 	v2 "github.com/smartcontractkit/chainlink/core/config/v2"
 )
 
-func (g *generalConfig) GlobalBalanceMonitorEnabled() (bool, bool) { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalBalanceMonitorEnabled() (bool, bool)    { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmAutoWalletFundEnabled() (bool, bool) { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmAutoWalletFundThreshold() (*assets.Wei, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmAutoWalletFundAmount() (*assets.Wei, bool) { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmAutoWalletFundTreasuryAddress() (string, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmAutoCreateSendingKey() (bool, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmWalletBalancePollInterval() (time.Duration, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmMaxOutstandingBalance() (*assets.Wei, bool) {
+	panic(v2.ErrUnsupported)
+}
 func (g *generalConfig) GlobalBlockEmissionIdleWarningThreshold() (time.Duration, bool) {
 	panic(v2.ErrUnsupported)
 }
@@ -32,6 +49,12 @@ func (g *generalConfig) GlobalBlockHistoryEstimatorEIP1559FeeCapBufferBlocks() (
 func (g *generalConfig) GlobalBlockHistoryEstimatorTransactionPercentile() (uint16, bool) {
 	panic(v2.ErrUnsupported)
 }
+func (g *generalConfig) GlobalEvmGasEstimatorTransactionPriceHistory() (uint16, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmGasEstimatorBlockHistoryEWMAAlpha() (float64, bool) {
+	panic(v2.ErrUnsupported)
+}
 func (g *generalConfig) GlobalChainType() (string, bool) { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEthTxReaperInterval() (time.Duration, bool) {
 	panic(v2.ErrUnsupported)
@@ -42,16 +65,44 @@ func (g *generalConfig) GlobalEthTxReaperThreshold() (time.Duration, bool) {
 func (g *generalConfig) GlobalEthTxResendAfterThreshold() (time.Duration, bool) {
 	panic(v2.ErrUnsupported)
 }
+func (g *generalConfig) GlobalEvmTxExpiry() (time.Duration, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmReceiptPollingInterval() (time.Duration, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmMaxRevertedTransactionsPerBlock() (uint16, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmCallDataSizeLimit() (uint32, bool) {
+	panic(v2.ErrUnsupported)
+}
 func (g *generalConfig) GlobalEvmEIP1559DynamicFees() (bool, bool)      { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmMinimumFeeMarket() (bool, bool)        { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmFinalityDepth() (uint32, bool)         { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasBumpPercent() (uint16, bool)        { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasBumpThreshold() (uint64, bool)      { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmGasNoBumpThreshold() (uint64, bool)    { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasBumpTxDepth() (uint16, bool)        { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasBumpWei() (*assets.Wei, bool)       { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasFeeCapDefault() (*assets.Wei, bool) { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalEvmGasLimitDefault() (uint32, bool)       { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalEvmGasLimitMax() (uint32, bool)           { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmGasFeeCapRounding() (*assets.Wei, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmBlobGasPriceDefault() (*assets.Wei, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmMaxBlobGasPrice() (*assets.Wei, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmFeeHistoryBlocks() (uint16, bool) { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmGasLimitDefault() (uint32, bool)  { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmGasLimitMax() (uint32, bool)      { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmGasLimitIncrementOnFailure() (uint32, bool) {
+	panic(v2.ErrUnsupported)
+}
 func (g *generalConfig) GlobalEvmGasLimitMultiplier() (float32, bool)   { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmGasCapacityBuffer() (float64, bool)    { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasLimitTransfer() (uint32, bool)      { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasPriceDefault() (*assets.Wei, bool)  { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasTipCapDefault() (*assets.Wei, bool) { panic(v2.ErrUnsupported) }
@@ -65,6 +116,15 @@ func (g *generalConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
 func (g *generalConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool) {
 	panic(v2.ErrUnsupported)
 }
+func (g *generalConfig) GlobalEvmHeadTrackerCallbackTimeout() (time.Duration, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmHeadTrackerBlockDelay() (uint16, bool) { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmMaxReorgDepth() (uint32, bool)         { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmMaxBlockAge() (time.Duration, bool)    { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmTrackerBlockHistoryFlushInterval() (time.Duration, bool) {
+	panic(v2.ErrUnsupported)
+}
 func (g *generalConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
 	panic(v2.ErrUnsupported)
@@ -73,29 +133,43 @@ func (g *generalConfig) GlobalEvmLogKeepBlocksDepth() (uint32, bool) {
 	panic(v2.ErrUnsupported)
 }
 func (g *generalConfig) GlobalEvmMaxGasPriceWei() (*assets.Wei, bool) { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmMaxGasPriceWarningThresholdPercent() (uint8, bool) {
+	panic(v2.ErrUnsupported)
+}
 func (g *generalConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
 	panic(v2.ErrUnsupported)
 }
-func (g *generalConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalEvmMinGasPriceWei() (*assets.Wei, bool)   { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalEvmNonceAutoSync() (bool, bool)           { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalEvmUseForwarders() (bool, bool)           { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool)   { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalFlagsContractAddress() (string, bool)     { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalGasEstimatorMode() (string, bool)         { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalLinkContractAddress() (string, bool)      { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalOperatorFactoryAddress() (string, bool)   { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalMinIncomingConfirmations() (uint32, bool) { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool)    { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmMinGasPriceWei() (*assets.Wei, bool)      { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmGasPriceFloor() (*assets.Wei, bool)       { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmGasPriceCacheTTL() (time.Duration, bool)  { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmNonceAutoSync() (bool, bool)              { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmNonceAutoFillGap() (bool, bool)           { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmUseForwarders() (bool, bool)              { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmSimulateTransactions() (bool, bool)       { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool)      { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalFlagsContractAddress() (string, bool)        { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalGasEstimatorMode() (string, bool)            { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmGasEstimatorFallbackMode() (string, bool) { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmGasEstimatorCheckRetries() (uint8, bool)  { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmGasOracleAddress() (string, bool)         { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmGasPriceFeedEnabled() (bool, bool)        { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmGasPriceFeedAddress() (string, bool)      { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalLinkContractAddress() (string, bool)         { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalOperatorFactoryAddress() (string, bool)      { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalMinIncomingConfirmations() (uint32, bool)    { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalMinimumContractPayment() (*assets.Link, bool) {
 	panic(v2.ErrUnsupported)
 }
 func (g *generalConfig) GlobalNodeNoNewHeadsThreshold() (time.Duration, bool) {
 	panic(v2.ErrUnsupported)
 }
-func (g *generalConfig) GlobalNodePollFailureThreshold() (uint32, bool) { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalNodePollInterval() (time.Duration, bool)  { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalNodeSelectionMode() (string, bool)        { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalOCRContractConfirmations() (uint16, bool) { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalNodePollFailureThreshold() (uint32, bool)     { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalNodePollInterval() (time.Duration, bool)      { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalNodeSelectionMode() (string, bool)            { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmNodeSyncThreshold() (uint32, bool)         { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalOCRContractConfirmations() (uint16, bool)     { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmOCRContractLookbackBlocks() (uint64, bool) { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalOCRContractTransmitterTransmitTimeout() (time.Duration, bool) {
 	panic(v2.ErrUnsupported)
 }
@@ -105,6 +179,9 @@ func (g *generalConfig) GlobalOCRDatabaseTimeout() (time.Duration, bool) {
 func (g *generalConfig) GlobalOCRObservationGracePeriod() (time.Duration, bool) {
 	panic(v2.ErrUnsupported)
 }
+func (g *generalConfig) GlobalEvmOCRMinObservationTimeout() (time.Duration, bool) {
+	panic(v2.ErrUnsupported)
+}
 
 func (g *generalConfig) GlobalOCR2AutomationGasLimit() (uint32, bool)   { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasLimitOCRJobType() (uint32, bool)    { panic(v2.ErrUnsupported) }
@@ -112,3 +189,46 @@ func (g *generalConfig) GlobalEvmGasLimitDRJobType() (uint32, bool)     { panic(
 func (g *generalConfig) GlobalEvmGasLimitVRFJobType() (uint32, bool)    { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasLimitFMJobType() (uint32, bool)     { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasLimitKeeperJobType() (uint32, bool) { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmConfigReadOnly() (bool, bool)          { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmChainLocked() (bool, bool)             { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmBlockTime() (time.Duration, bool)      { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmDatabaseQueryTimeout() (time.Duration, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmContractCallTimeout() (time.Duration, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmHealthCheckGracePeriod() (time.Duration, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmLogTTL() (time.Duration, bool)           { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmLogPruneInterval() (time.Duration, bool) { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmLogFetcherUseBlockHash() (bool, bool)    { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmRPCMaxInFlight() (uint32, bool)          { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmGasOraclePollInterval() (time.Duration, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmChainReadTimeoutFactor() (float64, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmTxPriorityQueue() (bool, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmGasEstimatorMinSampleCount() (uint16, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmAllowUnprotectedTxs() (bool, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmGasPriceAutoAdjust() (bool, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmGasPriceAutoAdjustInterval() (time.Duration, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmContractCreationGasLimit() (uint32, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmGasHistoryExcludeZeroPriceTxs() (bool, bool) {
+	panic(v2.ErrUnsupported)
+}
@@ -428,31 +428,61 @@ func TestConfig_Marshal(t *testing.T) {
 			Enabled: ptr(false),
 			Chain: evmcfg.Chain{
 				BalanceMonitor: evmcfg.BalanceMonitor{
-					Enabled: ptr(true),
+					Enabled:                 ptr(true),
+					PollInterval:            &hour,
+					AutoFund:                ptr(true),
+					AutoFundThreshold:       assets.GWei(250_000_000),
+					AutoFundAmount:          assets.GWei(1_000_000_000),
+					AutoFundTreasuryAddress: mustAddress("0x2a3e23c6f242F5345320814aC8a1b4E58707D292"),
+					MaxOutstandingBalance:   assets.GWei(5_000_000_000),
 				},
-				BlockBackfillDepth:   ptr[uint32](100),
-				BlockBackfillSkip:    ptr(true),
-				ChainType:            ptr("Optimism"),
-				FinalityDepth:        ptr[uint32](42),
-				FlagsContractAddress: mustAddress("0xae4E781a6218A8031764928E88d457937A954fC3"),
+				BlockBackfillDepth:     ptr[uint32](100),
+				BlockBackfillSkip:      ptr(true),
+				BlockTime:              &second,
+				ChainType:              ptr("Optimism"),
+				Alias:                  ptr("Mainnet"),
+				DatabaseQueryTimeout:   &minute,
+				ContractCallTimeout:    &minute,
+				FinalityDepth:          ptr[uint32](42),
+				FlagsContractAddress:   mustAddress("0xae4E781a6218A8031764928E88d457937A954fC3"),
+				HealthCheckGracePeriod: &minute,
 
 				GasEstimator: evmcfg.GasEstimator{
-					Mode:               ptr("L2Suggested"),
-					EIP1559DynamicFees: ptr(true),
-					BumpPercent:        ptr[uint16](10),
-					BumpThreshold:      ptr[uint32](6),
-					BumpTxDepth:        ptr[uint16](6),
-					BumpMin:            assets.NewWeiI(100),
-					FeeCapDefault:      assets.NewWeiI(math.MaxInt64),
-					LimitDefault:       ptr[uint32](12),
-					LimitMax:           ptr[uint32](17),
-					LimitMultiplier:    mustDecimal("1.234"),
-					LimitTransfer:      ptr[uint32](100),
-					TipCapDefault:      assets.NewWeiI(2),
-					TipCapMin:          assets.NewWeiI(1),
-					PriceDefault:       assets.NewWeiI(math.MaxInt64),
-					PriceMax:           assets.NewWei(utils.HexToBig("FFFFFFFFFFFF")),
-					PriceMin:           assets.NewWeiI(13),
+					Mode:                            ptr("L2Suggested"),
+					FallbackMode:                    ptr("L2Suggested"),
+					CheckRetries:                    ptr[uint8](5),
+					OraclePollInterval:              models.MustNewDuration(time.Minute),
+					OracleAddress:                   mustAddress("0x420000000000000000000000000000000000000F"),
+					PriceFeedEnabled:                ptr(true),
+					PriceFeedAddress:                mustAddress("0x169E633A2D1E6c10dD91238Ba11c4A708dfEF37C"),
+					EIP1559DynamicFees:              ptr(true),
+					MinimumFeeMarket:                ptr(true),
+					BumpPercent:                     ptr[uint16](10),
+					BumpThreshold:                   ptr[uint32](6),
+					NoBumpThreshold:                 ptr[uint32](100),
+					BumpTxDepth:                     ptr[uint16](6),
+					BumpMin:                         assets.NewWeiI(100),
+					FeeCapDefault:                   assets.NewWeiI(math.MaxInt64),
+					FeeCapRounding:                  assets.NewWeiI(100),
+					LimitDefault:                    ptr[uint32](12),
+					LimitMax:                        ptr[uint32](17),
+					LimitIncrementOnFailure:         ptr[uint32](10_000),
+					LimitMultiplier:                 mustDecimal("1.234"),
+					LimitCapacityBuffer:             mustDecimal("1.1"),
+					LimitTransfer:                   ptr[uint32](100),
+					LimitContractCreation:           ptr[uint32](3_000_000),
+					TipCapDefault:                   assets.NewWeiI(2),
+					TipCapMin:                       assets.NewWeiI(1),
+					PriceDefault:                    assets.NewWeiI(math.MaxInt64),
+					PriceMax:                        assets.NewWei(utils.HexToBig("FFFFFFFFFFFF")),
+					PriceMaxExemptAddresses:         []ethkey.EIP55Address{*mustAddress("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")},
+					PriceMaxWarningThresholdPercent: ptr[uint8](90),
+					PriceMin:                        assets.NewWeiI(13),
+					PriceFloor:                      assets.NewWeiI(21),
+					PriceCacheTTL:                   models.MustNewDuration(3 * time.Second),
+					BlobPriceDefault:                assets.GWei(2),
+					BlobPriceMax:                    assets.GWei(500),
+					FeeHistoryBlocks:                ptr[uint16](20),
 
 					LimitJobType: evmcfg.GasLimitJobType{
 						OCR:    ptr[uint32](1001),
@@ -469,6 +499,10 @@ func TestConfig_Marshal(t *testing.T) {
 						CheckInclusionPercentile:  ptr[uint16](19),
 						EIP1559FeeCapBufferBlocks: ptr[uint16](13),
 						TransactionPercentile:     ptr[uint16](15),
+						TransactionPriceHistory:   ptr[uint16](500),
+						EWMAAlpha:                 mustDecimal("0.2"),
+						MinSampleCount:            ptr[uint16](10),
+						ExcludeZeroPriceTxs:       ptr(false),
 					},
 				},
 
@@ -480,44 +514,71 @@ func TestConfig_Marshal(t *testing.T) {
 						},
 					},
 				},
+				FeatureFlags: map[string]float64{"Forwarders": 0.5},
 
 				LinkContractAddress:      mustAddress("0x538aAaB4ea120b2bC2fe5D296852D948F07D849e"),
 				LogBackfillBatchSize:     ptr[uint32](17),
 				LogPollInterval:          &minute,
 				LogKeepBlocksDepth:       ptr[uint32](100000),
+				LogTTL:                   &hour,
+				LogPruneInterval:         &minute,
+				LogBackfillUseBlockHash:  ptr(true),
 				MinContractPayment:       assets.NewLinkFromJuels(math.MaxInt64),
 				MinIncomingConfirmations: ptr[uint32](13),
 				NonceAutoSync:            ptr(true),
+				NonceAutoFillGap:         ptr(true),
 				NoNewHeadsThreshold:      &minute,
 				OperatorFactoryAddress:   mustAddress("0xa5B85635Be42F21f94F28034B7DA440EeFF0F418"),
 				RPCDefaultBatchSize:      ptr[uint32](17),
+				RPCMaxInFlight:           ptr[uint32](64),
+				RPCReadTimeoutFactor:     mustDecimal("1.5"),
 				RPCBlockQueryDelay:       ptr[uint16](10),
+				ReadOnly:                 ptr(true),
+				Locked:                   ptr(true),
+				AutoCreateSendingKey:     ptr(true),
 
 				Transactions: evmcfg.Transactions{
-					MaxInFlight:          ptr[uint32](19),
-					MaxQueued:            ptr[uint32](99),
-					ReaperInterval:       &minute,
-					ReaperThreshold:      &minute,
-					ResendAfterThreshold: &hour,
-					ForwardersEnabled:    ptr(true),
+					MaxInFlight:            ptr[uint32](19),
+					MaxQueued:              ptr[uint32](99),
+					ReaperInterval:         &minute,
+					ReaperThreshold:        &minute,
+					ResendAfterThreshold:   &hour,
+					Expiry:                 &hour,
+					ReceiptPollingInterval: &second,
+					MaxRevertedPerBlock:    ptr[uint16](10),
+					CallDataSizeLimit:      ptr[uint32](100000),
+					Simulate:               ptr(true),
+					PriorityQueue:          ptr(true),
+					AllowUnprotected:       ptr(true),
+					L2SequencerHTTPURL:     mustURL("https://sequencer.example"),
+					ForwardersEnabled:      ptr(true),
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
 					HistoryDepth:     ptr[uint32](15),
 					MaxBufferSize:    ptr[uint32](17),
 					SamplingInterval: &hour,
+					CallbackTimeout:  &minute,
+					BlockDelay:       ptr[uint16](2),
+					MaxReorgDepth:    ptr[uint32](100),
+					MaxBlockAge:      models.MustNewDuration(10 * time.Minute),
+					FlushInterval:    models.MustNewDuration(5 * time.Second),
 				},
 
 				NodePool: evmcfg.NodePool{
 					PollFailureThreshold: ptr[uint32](5),
 					PollInterval:         &minute,
 					SelectionMode:        &selectionMode,
+					SyncThreshold:        ptr[uint32](13),
 				},
 				OCR: evmcfg.OCR{
 					ContractConfirmations:              ptr[uint16](11),
+					ContractLookbackBlocks:             ptr[uint64](500),
 					ContractTransmitterTransmitTimeout: &minute,
 					DatabaseTimeout:                    &second,
 					ObservationGracePeriod:             &second,
+					MinObservationTimeout:              models.MustNewDuration(10 * time.Second),
+					KeyBundleID:                        ptr(models.MustSha256HashFromHex("7a5f66bbe6594259325bf2b4f5b1a9c9")),
 				},
 				OCR2: evmcfg.OCR2{
 					Automation: evmcfg.Automation{
@@ -527,14 +588,16 @@ func TestConfig_Marshal(t *testing.T) {
 			},
 			Nodes: []*evmcfg.Node{
 				{
-					Name:    ptr("foo"),
-					HTTPURL: mustURL("https://foo.web"),
-					WSURL:   mustURL("wss://web.socket/test"),
+					Name:     ptr("foo"),
+					HTTPURL:  mustURL("https://foo.web"),
+					WSURL:    mustURL("wss://web.socket/test"),
+					Priority: ptr[uint8](0),
 				},
 				{
-					Name:    ptr("bar"),
-					HTTPURL: mustURL("https://bar.com"),
-					WSURL:   mustURL("wss://web.socket/test"),
+					Name:     ptr("bar"),
+					HTTPURL:  mustURL("https://bar.com"),
+					WSURL:    mustURL("wss://web.socket/test"),
+					Priority: ptr[uint8](1),
 				},
 				{
 					Name:     ptr("broadcast"),
@@ -810,20 +873,34 @@ ChainID = '1'
 Enabled = false
 BlockBackfillDepth = 100
 BlockBackfillSkip = true
+BlockTime = '1s'
 ChainType = 'Optimism'
+Alias = 'Mainnet'
+DatabaseQueryTimeout = '1m0s'
+ContractCallTimeout = '1m0s'
 FinalityDepth = 42
 FlagsContractAddress = '0xae4E781a6218A8031764928E88d457937A954fC3'
+HealthCheckGracePeriod = '1m0s'
 LinkContractAddress = '0x538aAaB4ea120b2bC2fe5D296852D948F07D849e'
 LogBackfillBatchSize = 17
 LogPollInterval = '1m0s'
 LogKeepBlocksDepth = 100000
+LogTTL = '1h0m0s'
+LogPruneInterval = '1m0s'
+LogBackfillUseBlockHash = true
 MinIncomingConfirmations = 13
 MinContractPayment = '9.223372036854775807 link'
 NonceAutoSync = true
+NonceAutoFillGap = true
 NoNewHeadsThreshold = '1m0s'
 OperatorFactoryAddress = '0xa5B85635Be42F21f94F28034B7DA440EeFF0F418'
 RPCDefaultBatchSize = 17
+RPCMaxInFlight = 64
+RPCReadTimeoutFactor = '1.5'
 RPCBlockQueryDelay = 10
+ReadOnly = true
+Locked = true
+AutoCreateSendingKey = true
 
 [EVM.Transactions]
 ForwardersEnabled = true
@@ -832,25 +909,58 @@ MaxQueued = 99
 ReaperInterval = '1m0s'
 ReaperThreshold = '1m0s'
 ResendAfterThreshold = '1h0m0s'
+Expiry = '1h0m0s'
+ReceiptPollingInterval = '1s'
+MaxRevertedPerBlock = 10
+CallDataSizeLimit = 100000
+Simulate = true
+PriorityQueue = true
+AllowUnprotected = true
+L2SequencerHTTPURL = 'https://sequencer.example'
 
 [EVM.BalanceMonitor]
 Enabled = true
+PollInterval = '1h0m0s'
+AutoFund = true
+AutoFundThreshold = '250 milli'
+AutoFundAmount = '1 ether'
+AutoFundTreasuryAddress = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
+MaxOutstandingBalance = '5 ether'
 
 [EVM.GasEstimator]
 Mode = 'L2Suggested'
+FallbackMode = 'L2Suggested'
+CheckRetries = 5
+OraclePollInterval = '1m0s'
+OracleAddress = '0x420000000000000000000000000000000000000F'
+PriceFeedEnabled = true
+PriceFeedAddress = '0x169E633A2D1E6c10dD91238Ba11c4A708dfEF37C'
 PriceDefault = '9.223372036854775807 ether'
 PriceMax = '281.474976710655 micro'
+PriceMaxExemptAddresses = ['0x2a3e23c6f242F5345320814aC8a1b4E58707D292']
+PriceMaxWarningThresholdPercent = 90
 PriceMin = '13 wei'
+PriceFloor = '21 wei'
+PriceCacheTTL = '3s'
+BlobPriceDefault = '2 gwei'
+BlobPriceMax = '500 gwei'
+FeeHistoryBlocks = 20
 LimitDefault = 12
 LimitMax = 17
+LimitIncrementOnFailure = 10000
 LimitMultiplier = '1.234'
+LimitCapacityBuffer = '1.1'
 LimitTransfer = 100
+LimitContractCreation = 3000000
 BumpMin = '100 wei'
 BumpPercent = 10
 BumpThreshold = 6
+NoBumpThreshold = 100
 BumpTxDepth = 6
 EIP1559DynamicFees = true
+MinimumFeeMarket = true
 FeeCapDefault = '9.223372036854775807 ether'
+FeeCapRounding = '100 wei'
 TipCapDefault = '2 wei'
 TipCapMin = '1 wei'
 
@@ -868,11 +978,20 @@ CheckInclusionBlocks = 18
 CheckInclusionPercentile = 19
 EIP1559FeeCapBufferBlocks = 13
 TransactionPercentile = 15
+TransactionPriceHistory = 500
+EWMAAlpha = '0.2'
+MinSampleCount = 10
+ExcludeZeroPriceTxs = false
 
 [EVM.HeadTracker]
 HistoryDepth = 15
 MaxBufferSize = 17
 SamplingInterval = '1h0m0s'
+CallbackTimeout = '1m0s'
+BlockDelay = 2
+MaxReorgDepth = 100
+MaxBlockAge = '10m0s'
+FlushInterval = '5s'
 
 [[EVM.KeySpecific]]
 Key = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
@@ -880,16 +999,23 @@ Key = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
 [EVM.KeySpecific.GasEstimator]
 PriceMax = '79.228162514264337593543950335 gether'
 
+[EVM.FeatureFlags]
+Forwarders = 0.5
+
 [EVM.NodePool]
 PollFailureThreshold = 5
 PollInterval = '1m0s'
 SelectionMode = 'HighestHead'
+SyncThreshold = 13
 
 [EVM.OCR]
 ContractConfirmations = 11
+ContractLookbackBlocks = 500
 ContractTransmitterTransmitTimeout = '1m0s'
 DatabaseTimeout = '1s'
 ObservationGracePeriod = '1s'
+MinObservationTimeout = '10s'
+KeyBundleID = '7a5f66bbe6594259325bf2b4f5b1a9c900000000000000000000000000000000'
 
 [EVM.OCR2]
 [EVM.OCR2.Automation]
@@ -899,11 +1025,13 @@ GasLimit = 540
 Name = 'foo'
 WSURL = 'wss://web.socket/test'
 HTTPURL = 'https://foo.web'
+Priority = 0
 
 [[EVM.Nodes]]
 Name = 'bar'
 WSURL = 'wss://web.socket/test'
 HTTPURL = 'https://bar.com'
+Priority = 1
 
 [[EVM.Nodes]]
 Name = 'broadcast'
@@ -1006,6 +1134,9 @@ func TestConfig_full(t *testing.T) {
 			if got.EVM[c].Nodes[n].SendOnly == nil {
 				got.EVM[c].Nodes[n].SendOnly = ptr(true)
 			}
+			if got.EVM[c].Nodes[n].Priority == nil {
+				got.EVM[c].Nodes[n].Priority = ptr[uint8](0)
+			}
 		}
 	}
 	cfgtest.AssertFieldsNotNil(t, got)
@@ -1026,9 +1157,17 @@ func TestConfig_Validate(t *testing.T) {
 		- 1.ChainID: invalid value (1): duplicate - must be unique
 		- 0.Nodes.1.Name: invalid value (foo): duplicate - must be unique
 		- 3.Nodes.4.WSURL: invalid value (ws://dupe.com): duplicate - must be unique
-		- 0: 4 errors:
+		- 0: 12 errors:
 			- Nodes: missing: must have at least one primary node with WSURL
 			- GasEstimator.BumpTxDepth: invalid value (11): must be less than or equal to Transactions.MaxInFlight
+			- RPCReadTimeoutFactor: invalid value (0): must be greater than zero
+			- LogPruneInterval: invalid value (0s): must be greater than zero if LogTTL is set
+			- HeadTracker.BlockDelay: invalid value (100): must be less than HistoryDepth
+			- HeadTracker.CallbackTimeout: invalid value (0s): must be greater than zero
+			- ContractCallTimeout: invalid value (0s): must be greater than zero
+			- BalanceMonitor.AutoFundTreasuryAddress: missing: required when AutoFund is enabled
+			- BalanceMonitor.PollInterval: invalid value (0s): must be greater than zero when AutoFund is enabled
+			- feature flag Forwarders: percentage NaN must be between 0 and 1
 			- GasEstimator: 6 errors:
 				- BumpPercent: invalid value (1): may not be less than Geth's default of 10
 				- TipCapDefault: invalid value (3 wei): must be greater than or equal to TipCapMinimum
@@ -1037,12 +1176,8 @@ func TestConfig_Validate(t *testing.T) {
 				- PriceMax: invalid value (10 gwei): must be greater than or equal to PriceDefault
 				- BlockHistory.BlockHistorySize: invalid value (0): must be greater than or equal to 1 with BlockHistory Mode
 			- Nodes: 2 errors:
-				- 0: 2 errors:
-					- WSURL: missing: required for primary nodes
-					- HTTPURL: missing: required for all nodes
-				- 1: 2 errors:
-					- WSURL: missing: required for primary nodes
-					- HTTPURL: missing: required for all nodes
+				- 0.HTTPURL: missing: required for all nodes
+				- 1.HTTPURL: missing: required for all nodes
 		- 1: 6 errors:
 			- ChainType: invalid value (Foo): must not be set with this chain id
 			- Nodes: missing: must have at least one node
@@ -1059,15 +1194,17 @@ func TestConfig_Validate(t *testing.T) {
 			- FinalityDepth: invalid value (0): must be greater than or equal to 1
 			- MinIncomingConfirmations: invalid value (0): must be greater than or equal to 1
 		- 3.Nodes: 5 errors:
-				- 0: 2 errors:
+				- 0: 3 errors:
 					- Name: missing: required for all nodes
+					- WSURL: missing: required for primary nodes
 					- HTTPURL: empty: required for all nodes
 				- 1: 3 errors:
 					- Name: missing: required for all nodes
 					- WSURL: invalid value (http): must be ws or wss
 					- HTTPURL: missing: required for all nodes
-				- 2: 2 errors:
+				- 2: 3 errors:
 					- Name: empty: required for all nodes
+					- WSURL: missing: required for primary nodes
 					- HTTPURL: invalid value (ws): must be http or https
 				- 3.HTTPURL: missing: required for all nodes
 				- 4.HTTPURL: missing: required for all nodes
@@ -444,6 +444,29 @@ func TestORM_CreateJob_OCRBootstrap(t *testing.T) {
 	cltest.AssertCount(t, db, "jobs", 0)
 }
 
+func TestORM_CreateJob_ChainLocked(t *testing.T) {
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		locked := true
+		c.EVM[0].Locked = &locked
+	})
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+
+	lggr := logger.TestLogger(t)
+	pipelineORM := pipeline.NewORM(db, lggr, config)
+	bridgesORM := bridges.NewORM(db, lggr, config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	jobORM := NewTestORM(t, db, cc, pipelineORM, bridgesORM, keyStore, config)
+
+	jb, err := directrequest.ValidatedDirectRequestSpec(testspecs.DirectRequestSpec)
+	require.NoError(t, err)
+
+	err = jobORM.CreateJob(&jb)
+	require.ErrorIs(t, err, job.ErrChainLocked)
+	cltest.AssertCount(t, db, "jobs", 0)
+	cltest.AssertCount(t, db, "direct_request_specs", 0)
+}
+
 func TestORM_CreateJob_OCR_DuplicatedContractAddress(t *testing.T) {
 	customChainID := utils.NewBig(testutils.NewRandomEVMChainID())
 
@@ -38,6 +38,7 @@ var (
 	ErrNoSuchTransmitterKey  = errors.New("no such transmitter key exists")
 	ErrSendingKeyIsForwarder = errors.New("forwarding is enabled, but the transmitter is set to a local sending key")
 	ErrNoSuchPublicKey       = errors.New("no such public key exists")
+	ErrChainLocked           = errors.New("chain is locked")
 )
 
 //go:generate mockery --name ORM --output ./mocks/ --case=underscore
@@ -144,6 +145,9 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 	if err := o.assertBridgesExist(p); err != nil {
 		return err
 	}
+	if err := o.assertChainNotLocked(jb); err != nil {
+		return err
+	}
 
 	var jobID int32
 	err := q.Transaction(func(tx pg.Queryer) error {
@@ -580,6 +584,59 @@ func (o *orm) FindJobs(offset, limit int) (jobs []Job, count int, err error) {
 	return jobs, int(count), err
 }
 
+// assertChainNotLocked returns ErrChainLocked if jb would run on an EVM chain
+// which has been locked with EvmChainLocked. Jobs for chains which cannot be
+// found are left for the job type specific validation to reject.
+func (o *orm) assertChainNotLocked(jb *Job) error {
+	if o.chainSet == nil {
+		return nil
+	}
+	chainID, ok := evmChainIDForJob(jb)
+	if !ok {
+		return nil
+	}
+	var chain evm.Chain
+	var err error
+	if chainID == nil {
+		chain, err = o.chainSet.Default()
+	} else {
+		chain, err = o.chainSet.Get(chainID.ToInt())
+	}
+	if err != nil {
+		return nil
+	}
+	if chain.Config().EvmChainLocked() {
+		return errors.Wrapf(ErrChainLocked, "cannot create job on chain %s", chain.ID())
+	}
+	return nil
+}
+
+// evmChainIDForJob returns the EVM chain ID of jb's spec, which is nil if the
+// default chain should be used. ok is false if jb does not run on an EVM chain.
+func evmChainIDForJob(jb *Job) (chainID *utils.Big, ok bool) {
+	switch {
+	case jb.DirectRequestSpec != nil:
+		return jb.DirectRequestSpec.EVMChainID, true
+	case jb.FluxMonitorSpec != nil:
+		return jb.FluxMonitorSpec.EVMChainID, true
+	case jb.OCROracleSpec != nil:
+		return jb.OCROracleSpec.EVMChainID, true
+	case jb.KeeperSpec != nil:
+		return jb.KeeperSpec.EVMChainID, true
+	case jb.VRFSpec != nil:
+		return jb.VRFSpec.EVMChainID, true
+	case jb.BlockhashStoreSpec != nil:
+		return jb.BlockhashStoreSpec.EVMChainID, true
+	case jb.OCR2OracleSpec != nil && jb.OCR2OracleSpec.Relay == relay.EVM:
+		id, isInt := jb.OCR2OracleSpec.RelayConfig["chainID"].(int64)
+		if !isInt {
+			return nil, false
+		}
+		return utils.NewBigI(id), true
+	}
+	return nil, false
+}
+
 func (o *orm) LoadEnvConfigVars(jb *Job) error {
 	if jb.OCROracleSpec != nil {
 		ch, err := o.chainSet.Get(jb.OCROracleSpec.EVMChainID.ToInt())
@@ -643,6 +700,7 @@ type OCRSpecConfig interface {
 	OCRContractTransmitterTransmitTimeout() time.Duration
 	OCRTransmitterAddress() (ethkey.EIP55Address, error)
 	OCRKeyBundleID() (string, error)
+	EvmOCRKeyBundleID() string
 }
 
 // LoadEnvConfigVarsLocalOCR loads local OCR env vars into the OCROracleSpec.
@@ -696,9 +754,15 @@ func LoadEnvConfigVarsOCR(cfg OCRSpecConfig, p2pStore keystore.P2P, os OCROracle
 	}
 
 	if os.EncryptedOCRKeyBundleID == nil {
-		kb, err := cfg.OCRKeyBundleID()
-		if err != nil {
-			return nil, err
+		// A key bundle configured for the chain takes precedence over the
+		// node-wide default
+		kb := cfg.EvmOCRKeyBundleID()
+		if kb == "" {
+			var err error
+			kb, err = cfg.OCRKeyBundleID()
+			if err != nil {
+				return nil, err
+			}
 		}
 		encryptedOCRKeyBundleID, err := models.Sha256HashFromHex(kb)
 		if err != nil {
@@ -9,6 +9,8 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -16,8 +18,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 func NewTestORM(t *testing.T, db *sqlx.DB, chainSet evm.ChainSet, pipelineORM pipeline.ORM, bridgeORM bridges.ORM, keyStore keystore.Master, cfg pg.LogConfig) job.ORM {
@@ -45,6 +49,44 @@ func TestLoadEnvConfigVarsLocalOCR(t *testing.T) {
 	require.True(t, jobSpec.ContractTransmitterTransmitTimeoutEnv)
 }
 
+func TestLoadEnvConfigVarsOCR_KeyBundleID(t *testing.T) {
+	t.Parallel()
+
+	nodeBundle := models.MustSha256HashFromHex("acdd42797a8b921b2910497badc50006")
+	chainBundle := models.MustSha256HashFromHex("7a5f66bbe6594259325bf2b4f5b1a9c9")
+	specBundle := models.MustSha256HashFromHex("f1e0f5a9d4c3b2a19087a6b5c4d3e2f1")
+	transmitter := ethkey.EIP55AddressFromAddress(testutils.NewAddress())
+
+	newChainConfig := func(t *testing.T, chainBundle *models.Sha256Hash) evmconfig.ChainScopedConfig {
+		config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.OCR.KeyBundleID = &nodeBundle
+			c.EVM[0].OCR.KeyBundleID = chainBundle
+		})
+		return evmtest.NewChainScopedConfig(t, config)
+	}
+
+	t.Run("uses the node default", func(t *testing.T) {
+		os, err := job.LoadEnvConfigVarsOCR(newChainConfig(t, nil), nil, job.OCROracleSpec{TransmitterAddress: &transmitter})
+		require.NoError(t, err)
+		assert.True(t, os.EncryptedOCRKeyBundleIDEnv)
+		assert.Equal(t, nodeBundle, *os.EncryptedOCRKeyBundleID)
+	})
+
+	t.Run("prefers the chain key bundle", func(t *testing.T) {
+		os, err := job.LoadEnvConfigVarsOCR(newChainConfig(t, &chainBundle), nil, job.OCROracleSpec{TransmitterAddress: &transmitter})
+		require.NoError(t, err)
+		assert.True(t, os.EncryptedOCRKeyBundleIDEnv)
+		assert.Equal(t, chainBundle, *os.EncryptedOCRKeyBundleID)
+	})
+
+	t.Run("keeps the job's key bundle", func(t *testing.T) {
+		os, err := job.LoadEnvConfigVarsOCR(newChainConfig(t, &chainBundle), nil, job.OCROracleSpec{TransmitterAddress: &transmitter, EncryptedOCRKeyBundleID: &specBundle})
+		require.NoError(t, err)
+		assert.False(t, os.EncryptedOCRKeyBundleIDEnv)
+		assert.Equal(t, specBundle, *os.EncryptedOCRKeyBundleID)
+	})
+}
+
 func TestLoadEnvConfigVarsDR(t *testing.T) {
 	t.Parallel()
 
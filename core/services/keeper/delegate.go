@@ -108,7 +108,7 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.ServiceCtx, err
 		d.pr,
 		chain.Client(),
 		chain.HeadBroadcaster(),
-		chain.TxManager().GetGasEstimator(),
+		chain.TxManager(),
 		svcLogger,
 		chain.Config(),
 		effectiveKeeperAddress,
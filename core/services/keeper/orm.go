@@ -274,3 +274,14 @@ func (korm ORM) SetLastRunInfoForUpkeepOnJob(jobID int32, upkeepID *utils.Big, h
 	}
 	return rowsAffected, nil
 }
+
+// ResetLastRunInfoForUpkeepOnJob clears the last run block height and keeper index of an upkeep, so that it is eligible to be performed again.
+func (korm ORM) ResetLastRunInfoForUpkeepOnJob(jobID int32, upkeepID *utils.Big, qopts ...pg.QOpt) error {
+	_, err := korm.q.WithOpts(qopts...).Exec(`
+	UPDATE upkeep_registrations
+	SET last_run_block_height = 0,
+		last_keeper_index = NULL
+	WHERE upkeep_id = $1 AND
+	registry_id = (SELECT id FROM keeper_registries WHERE job_id = $2)`, upkeepID, jobID)
+	return errors.Wrap(err, "ResetLastRunInfoForUpkeepOnJob failed")
+}
@@ -427,6 +427,27 @@ func TestKeeperDB_NewSetLastRunInfoForUpkeepOnJob(t *testing.T) {
 	assertLastRunHeight(t, db, upkeep, 101, 0)
 }
 
+func TestKeeperDB_ResetLastRunInfoForUpkeepOnJob(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	registry, j := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore, 0, 1, 20)
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, config, registry)
+
+	rowsAffected, err := orm.SetLastRunInfoForUpkeepOnJob(j.ID, upkeep.UpkeepID, 100, registry.FromAddress)
+	require.NoError(t, err)
+	require.Equal(t, rowsAffected, int64(1))
+	assertLastRunHeight(t, db, upkeep, 100, 0)
+
+	require.NoError(t, orm.ResetLastRunInfoForUpkeepOnJob(j.ID, upkeep.UpkeepID))
+
+	err = db.Get(&upkeep, `SELECT * FROM upkeep_registrations WHERE upkeep_id = $1`, upkeep.UpkeepID)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), upkeep.LastRunBlockHeight)
+	require.False(t, upkeep.LastKeeperIndex.Valid)
+}
+
 func TestKeeperDB_LeastSignificant(t *testing.T) {
 	t.Parallel()
 	db, _, _ := setupKeeperDB(t)
@@ -16,6 +16,7 @@ import (
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -51,6 +52,7 @@ type UpkeepExecuter struct {
 	executionQueue         chan struct{}
 	headBroadcaster        httypes.HeadBroadcasterRegistry
 	gasEstimator           gas.Estimator
+	txm                    txmgr.TxManager
 	job                    job.Job
 	mailbox                *utils.Mailbox[*evmtypes.Head]
 	orm                    ORM
@@ -68,7 +70,7 @@ func NewUpkeepExecuter(
 	pr pipeline.Runner,
 	ethClient evmclient.Client,
 	headBroadcaster httypes.HeadBroadcaster,
-	gasEstimator gas.Estimator,
+	txm txmgr.TxManager,
 	logger logger.Logger,
 	config Config,
 	effectiveKeeperAddress common.Address,
@@ -78,7 +80,8 @@ func NewUpkeepExecuter(
 		ethClient:              ethClient,
 		executionQueue:         make(chan struct{}, executionQueueSize),
 		headBroadcaster:        headBroadcaster,
-		gasEstimator:           gasEstimator,
+		gasEstimator:           txm.GetGasEstimator(),
+		txm:                    txm,
 		job:                    job,
 		mailbox:                utils.NewMailbox[*evmtypes.Head](1),
 		config:                 config,
@@ -98,8 +101,10 @@ func (ex *UpkeepExecuter) Start(context.Context) error {
 		if latestHead != nil {
 			ex.mailbox.Deliver(latestHead)
 		}
+		unregisterExpiry := ex.txm.RegisterExpiryCallback(ex.onEthTxExpired)
 		go func() {
 			defer unsubscribeHeads()
+			defer unregisterExpiry()
 			defer ex.wgDone.Done()
 			<-ex.chStop
 		}()
@@ -121,6 +126,25 @@ func (ex *UpkeepExecuter) OnNewLongestChain(_ context.Context, head *evmtypes.He
 	ex.mailbox.Deliver(head)
 }
 
+// onEthTxExpired makes an upkeep of this job eligible again once its perform
+// upkeep transaction has expired without being broadcast
+func (ex *UpkeepExecuter) onEthTxExpired(etx txmgr.EthTx) {
+	meta, err := etx.GetMeta()
+	if err != nil || meta == nil || meta.JobID == nil || *meta.JobID != ex.job.ID || meta.UpkeepID == nil {
+		return
+	}
+	upkeepID, ok := ParseUpkeepId(*meta.UpkeepID)
+	if !ok {
+		ex.logger.Errorw("Failed to parse upkeep ID of expired transaction", "ethTxID", etx.ID, "upkeepID", *meta.UpkeepID)
+		return
+	}
+	if err := ex.orm.ResetLastRunInfoForUpkeepOnJob(ex.job.ID, utils.NewBig(upkeepID)); err != nil {
+		ex.logger.Errorw("Failed to reset last run info of upkeep with expired transaction", "ethTxID", etx.ID, "upkeepID", *meta.UpkeepID, "err", err)
+		return
+	}
+	ex.logger.Infow("Perform upkeep transaction expired without being broadcast, upkeep will be retried", "ethTxID", etx.ID, "upkeepID", *meta.UpkeepID)
+}
+
 func (ex *UpkeepExecuter) run() {
 	defer ex.wgDone.Done()
 	for {
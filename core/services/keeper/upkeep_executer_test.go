@@ -35,6 +35,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/pg/datatypes"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	bigmath "github.com/smartcontractkit/chainlink/core/utils/big_math"
 )
@@ -82,13 +83,14 @@ func setup(t *testing.T, estimator *gasmocks.Estimator, overrideFn func(c *chain
 	ethClient.On("HeaderByNumber", mock.Anything, mock.Anything).Maybe().Return(block, nil)
 	txm := txmmocks.NewTxManager(t)
 	txm.On("GetGasEstimator").Return(estimator)
+	txm.On("RegisterExpiryCallback", mock.Anything).Return(func() {})
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{TxManager: txm, DB: db, Client: ethClient, KeyStore: keyStore.Eth(), GeneralConfig: cfg})
 	jpv2 := cltest.NewJobPipelineV2(t, cfg, cc, db, keyStore, nil, nil)
 	ch := evmtest.MustGetDefaultChain(t, cc)
 	orm := keeper.NewORM(db, logger.TestLogger(t), ch.Config(), txmgr.SendEveryStrategy{})
 	registry, job := cltest.MustInsertKeeperRegistry(t, db, orm, keyStore.Eth(), 0, 1, 20)
 	lggr := logger.TestLogger(t)
-	executer := keeper.NewUpkeepExecuter(job, orm, jpv2.Pr, ethClient, ch.HeadBroadcaster(), ch.TxManager().GetGasEstimator(), lggr, ch.Config(), job.KeeperSpec.FromAddress.Address())
+	executer := keeper.NewUpkeepExecuter(job, orm, jpv2.Pr, ethClient, ch.HeadBroadcaster(), ch.TxManager(), lggr, ch.Config(), job.KeeperSpec.FromAddress.Address())
 	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, ch.Config(), registry)
 	err := executer.Start(testutils.Context(t))
 	t.Cleanup(func() { executer.Close() })
@@ -272,7 +274,7 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 		jb.KeeperSpec.EVMChainID = (*utils.Big)(big.NewInt(999))
 		cltest.MustInsertUpkeepForRegistry(t, db, ch.Config(), registry)
 		lggr := logger.TestLogger(t)
-		executer := keeper.NewUpkeepExecuter(jb, orm, jpv2.Pr, ethMock, ch.HeadBroadcaster(), ch.TxManager().GetGasEstimator(), lggr, ch.Config(), jb.KeeperSpec.FromAddress.Address())
+		executer := keeper.NewUpkeepExecuter(jb, orm, jpv2.Pr, ethMock, ch.HeadBroadcaster(), ch.TxManager(), lggr, ch.Config(), jb.KeeperSpec.FromAddress.Address())
 		err := executer.Start(testutils.Context(t))
 		require.NoError(t, err)
 		head := newHead()
@@ -409,4 +411,42 @@ func Test_UpkeepExecuter_PerformsUpkeep_Error(t *testing.T) {
 	cltest.AssertCountStays(t, db, "eth_txes", 0)
 }
 
+func Test_UpkeepExecuter_RetriesUpkeepWithExpiredTransaction(t *testing.T) {
+	t.Parallel()
+
+	db, _, ethMock, _, _, _, _, jpv2, _, keyStore, ch, orm := setup(t, mockEstimator(t), nil)
+
+	registry, jb := cltest.MustInsertKeeperRegistry(t, db, orm, keyStore.Eth(), 0, 1, 20)
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, ch.Config(), registry)
+	_, err := orm.SetLastRunInfoForUpkeepOnJob(jb.ID, upkeep.UpkeepID, 100, registry.FromAddress)
+	require.NoError(t, err)
+
+	txm := txmmocks.NewTxManager(t)
+	txm.On("GetGasEstimator").Return(mockEstimator(t))
+	var onExpired txmgr.ExpiryCallback
+	txm.On("RegisterExpiryCallback", mock.Anything).Run(func(args mock.Arguments) {
+		onExpired = args.Get(0).(txmgr.ExpiryCallback)
+	}).Return(func() {})
+	executer := keeper.NewUpkeepExecuter(jb, orm, jpv2.Pr, ethMock, ch.HeadBroadcaster(), txm, logger.TestLogger(t), ch.Config(), jb.KeeperSpec.FromAddress.Address())
+	require.NoError(t, executer.Start(testutils.Context(t)))
+	t.Cleanup(func() { executer.Close() })
+	require.NotNil(t, onExpired)
+
+	newEthTx := func(jobID int32) txmgr.EthTx {
+		upkeepID := upkeep.PrettyID()
+		meta := datatypes.JSON(cltest.MustJSONMarshal(t, txmgr.EthTxMeta{JobID: &jobID, UpkeepID: &upkeepID}))
+		return txmgr.EthTx{Meta: &meta}
+	}
+
+	// transactions of other jobs are ignored
+	onExpired(newEthTx(jb.ID + 1))
+	assertLastRunHeight(t, db, upkeep, 100, 0)
+
+	onExpired(newEthTx(jb.ID))
+	err = db.Get(&upkeep, `SELECT * FROM upkeep_registrations WHERE id = $1`, upkeep.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), upkeep.LastRunBlockHeight)
+	assert.False(t, upkeep.LastKeeperIndex.Valid)
+}
+
 func ptr[T any](t T) *T { return &t }
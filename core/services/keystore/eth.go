@@ -334,6 +334,8 @@ func (ks *eth) SubscribeToKeyChanges() (ch chan struct{}, unsub func()) {
 	}
 }
 
+// SignTx signs tx for the chain with chainID. If chainID is nil, tx is signed
+// with the Homestead signer, without EIP-155 replay protection.
 func (ks *eth) SignTx(address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
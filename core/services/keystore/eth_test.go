@@ -300,6 +300,15 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	require.NoError(t, err)
 
 	require.NotEqual(t, tx, signed)
+	assert.True(t, signed.Protected())
+
+	unprotected, err := ethKeyStore.SignTx(k.Address, tx, nil)
+	require.NoError(t, err)
+
+	assert.False(t, unprotected.Protected())
+	sender, err := types.Sender(types.HomesteadSigner{}, unprotected)
+	require.NoError(t, err)
+	assert.Equal(t, k.Address, sender)
 }
 
 func Test_EthKeyStore_E2E(t *testing.T) {
@@ -0,0 +1,69 @@
+package ocrkey
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/chaintype"
+)
+
+// ChainAwareSigner signs messages using the signature scheme of the given
+// chain type
+type ChainAwareSigner interface {
+	Sign(msg []byte, chainType chaintype.ChainType) ([]byte, error)
+}
+
+// SignerPlugin signs messages for a chain type which KeyV2 does not support
+// natively, e.g. chains requiring Schnorr signatures
+type SignerPlugin interface {
+	Sign(msg []byte) ([]byte, error)
+}
+
+// SignerPluginFactory returns the SignerPlugin used to sign on behalf of key
+type SignerPluginFactory func(key KeyV2) (SignerPlugin, error)
+
+var (
+	signerPluginsMu sync.RWMutex
+	signerPlugins   = make(map[chaintype.ChainType]SignerPluginFactory)
+)
+
+// RegisterSignerPlugin registers the factory used to sign for chainType. It
+// panics if chainType is signed natively or already has a plugin registered.
+func RegisterSignerPlugin(chainType chaintype.ChainType, factory SignerPluginFactory) {
+	switch chainType {
+	case chaintype.EVM, chaintype.Solana:
+		panic(errors.Errorf("cannot register signer plugin for natively supported chain type %q", chainType))
+	}
+	signerPluginsMu.Lock()
+	defer signerPluginsMu.Unlock()
+	if _, ok := signerPlugins[chainType]; ok {
+		panic(errors.Errorf("signer plugin already registered for chain type %q", chainType))
+	}
+	signerPlugins[chainType] = factory
+}
+
+var _ ChainAwareSigner = KeyV2{}
+
+// Sign returns a signature on msg for chainType: an ethereum-style ECDSA
+// secp256k1 signature for EVM chains, an EdDSA-Ed25519 signature for Solana,
+// and otherwise the signature of the SignerPlugin registered for chainType.
+func (key KeyV2) Sign(msg []byte, chainType chaintype.ChainType) ([]byte, error) {
+	switch chainType {
+	case chaintype.EVM:
+		return key.SignOnChain(msg)
+	case chaintype.Solana:
+		return key.SignOffChain(msg)
+	}
+	signerPluginsMu.RLock()
+	factory, ok := signerPlugins[chainType]
+	signerPluginsMu.RUnlock()
+	if !ok {
+		return nil, errors.Wrap(chaintype.NewErrInvalidChainType(chainType), "no signer available")
+	}
+	plugin, err := factory(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create signer for chain type %q", chainType)
+	}
+	return plugin.Sign(msg)
+}
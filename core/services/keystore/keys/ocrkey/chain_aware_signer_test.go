@@ -0,0 +1,68 @@
+package ocrkey_test
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
+)
+
+type signerPluginFunc func(msg []byte) ([]byte, error)
+
+func (f signerPluginFunc) Sign(msg []byte) ([]byte, error) { return f(msg) }
+
+func TestOCRKeys_ChainAwareSigner(t *testing.T) {
+	t.Parallel()
+
+	key, err := ocrkey.NewV2()
+	require.NoError(t, err)
+	msg := []byte("hello world")
+
+	t.Run("EVM uses ECDSA", func(t *testing.T) {
+		sig, err := key.Sign(msg, chaintype.EVM)
+		require.NoError(t, err)
+		pub, err := crypto.SigToPub(crypto.Keccak256(msg), sig)
+		require.NoError(t, err)
+		assert.Equal(t, common.Address(key.PublicKeyAddressOnChain()), crypto.PubkeyToAddress(*pub))
+	})
+
+	t.Run("Solana uses Ed25519", func(t *testing.T) {
+		sig, err := key.Sign(msg, chaintype.Solana)
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(ed25519.PublicKey(key.PublicKeyOffChain()), msg, sig))
+	})
+
+	t.Run("other chain types use the registered plugin", func(t *testing.T) {
+		_, err := key.Sign(msg, chaintype.Terra)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown chain type "terra"`)
+
+		ocrkey.RegisterSignerPlugin(chaintype.StarkNet, func(k ocrkey.KeyV2) (ocrkey.SignerPlugin, error) {
+			assert.Equal(t, key.ID(), k.ID())
+			return signerPluginFunc(func(msg []byte) ([]byte, error) {
+				return append([]byte("schnorr:"), msg...), nil
+			}), nil
+		})
+		sig, err := key.Sign(msg, chaintype.StarkNet)
+		require.NoError(t, err)
+		assert.Equal(t, "schnorr:hello world", string(sig))
+
+		assert.Panics(t, func() {
+			ocrkey.RegisterSignerPlugin(chaintype.StarkNet, func(ocrkey.KeyV2) (ocrkey.SignerPlugin, error) {
+				return nil, errors.New("unused")
+			})
+		})
+		assert.Panics(t, func() {
+			ocrkey.RegisterSignerPlugin(chaintype.EVM, func(ocrkey.KeyV2) (ocrkey.SignerPlugin, error) {
+				return nil, errors.New("unused")
+			})
+		})
+	})
+}
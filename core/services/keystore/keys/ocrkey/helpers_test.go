@@ -1,5 +1,11 @@
 package ocrkey
 
+import (
+	"encoding/json"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
 func (kb *KeyV2) ExportedOnChainSigning() *onChainPrivateKey {
 	return kb.OnChainSigning
 }
@@ -11,3 +17,24 @@ func (kb *KeyV2) ExportedOffChainSigning() *offChainPrivateKey {
 func (kb *KeyV2) ExportedOffChainEncryption() *[32]byte {
 	return kb.OffChainEncryption
 }
+
+// ExportedEncryptV0 encrypts kb in the unversioned format used before
+// currentKeyVersion was introduced.
+func (kb *KeyBundle) ExportedEncryptV0(auth string, scryptParams utils.ScryptParams) (*EncryptedKeyBundle, error) {
+	raw := kb.rawData()
+	raw.Version = 0
+	b, err := json.Marshal(&raw)
+	if err != nil {
+		return nil, err
+	}
+	return kb.encryptData(b, auth, scryptParams)
+}
+
+// ExportedEncryptCBOR encrypts kb encoded with MarshalCBOR.
+func (kb *KeyBundle) ExportedEncryptCBOR(auth string, scryptParams utils.ScryptParams) (*EncryptedKeyBundle, error) {
+	b, err := kb.MarshalCBOR()
+	if err != nil {
+		return nil, err
+	}
+	return kb.encryptData(b, auth, scryptParams)
+}
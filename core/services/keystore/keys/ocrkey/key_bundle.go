@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/keystore"
@@ -87,11 +88,25 @@ func NewFrom(onChainSigning io.Reader, offChainSigning io.Reader, offChainEncryp
 		offChainSigning:    (*offChainPrivateKey)(&offChainPriv),
 		offChainEncryption: &encryptionPriv,
 	}
-	marshalledPrivK, err := json.Marshal(k)
-	if err != nil {
-		return nil, err
+	k.ID = k.rawData().id(nil)
+	return k, nil
+}
+
+// RotateOffChainEncryption returns a new KeyBundle with a newly generated
+// off-chain encryption scalar, and the same on-chain and off-chain signing
+// keys as pk. The bundle ID is recomputed, since it covers the encryption
+// scalar.
+func (pk *KeyBundle) RotateOffChainEncryption() (*KeyBundle, error) {
+	var encryptionPriv [curve25519.ScalarSize]byte
+	if _, err := cryptorand.Reader.Read(encryptionPriv[:]); err != nil {
+		return nil, errors.Wrap(err, "failed to generate off-chain encryption key")
 	}
-	k.ID = sha256.Sum256(marshalledPrivK)
+	k := &KeyBundle{
+		onChainSigning:     pk.onChainSigning,
+		offChainSigning:    pk.offChainSigning,
+		offChainEncryption: &encryptionPriv,
+	}
+	k.ID = k.rawData().id(nil)
 	return k, nil
 }
 
@@ -100,6 +115,13 @@ func (pk *KeyBundle) SignOnChain(msg []byte) (signature []byte, err error) {
 	return pk.onChainSigning.Sign(msg)
 }
 
+// SignOnChainWithNonce returns an ethereum-style ECDSA secp256k1 signature on
+// msg, bound to nonce and chainID so that it cannot be replayed in another
+// context. Check it with VerifyOnChainWithNonce.
+func (pk *KeyBundle) SignOnChainWithNonce(msg []byte, nonce uint64, chainID *big.Int) (signature []byte, err error) {
+	return pk.onChainSigning.SignWithNonce(msg, nonce, chainID)
+}
+
 // SignOffChain returns an EdDSA-Ed25519 signature on msg.
 func (pk *KeyBundle) SignOffChain(msg []byte) (signature []byte, err error) {
 	return pk.offChainSigning.Sign(msg)
@@ -156,6 +178,11 @@ func (pk *KeyBundle) encrypt(auth string, scryptParams utils.ScryptParams) (*Enc
 	if err != nil {
 		return nil, err
 	}
+	return pk.encryptData(marshalledPrivK, auth, scryptParams)
+}
+
+// encryptData encrypts the json encoded private keys of pk
+func (pk *KeyBundle) encryptData(marshalledPrivK []byte, auth string, scryptParams utils.ScryptParams) (*EncryptedKeyBundle, error) {
 	cryptoJSON, err := keystore.EncryptDataV3(
 		marshalledPrivK,
 		[]byte(adulteratedPassword(auth)),
@@ -180,40 +207,96 @@ func (pk *KeyBundle) encrypt(auth string, scryptParams utils.ScryptParams) (*Enc
 
 // Decrypt returns the PrivateKeys in e, decrypted via auth, or an error
 func (ekb *EncryptedKeyBundle) Decrypt(auth string) (*KeyBundle, error) {
+	marshalledPrivK, _, err := ekb.decrypt(auth)
+	if err != nil {
+		return nil, err
+	}
+	var pk KeyBundle
+	err = json.Unmarshal(marshalledPrivK, &pk)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal OCR key bundle")
+	}
+	return &pk, nil
+}
+
+// decrypt returns the json encoded private keys in ekb, and the scrypt params
+// they were encrypted with.
+func (ekb *EncryptedKeyBundle) decrypt(auth string) ([]byte, utils.ScryptParams, error) {
 	var cryptoJSON keystore.CryptoJSON
 	err := json.Unmarshal(ekb.EncryptedPrivateKeys, &cryptoJSON)
 	if err != nil {
-		return nil, errors.Wrapf(err, "invalid cryptoJSON for OCR key bundle")
+		return nil, utils.ScryptParams{}, errors.Wrapf(err, "invalid cryptoJSON for OCR key bundle")
 	}
 	marshalledPrivK, err := keystore.DecryptDataV3(cryptoJSON, adulteratedPassword(auth))
 	if err != nil {
-		return nil, errors.Wrapf(err, "could not decrypt OCR key bundle")
+		return nil, utils.ScryptParams{}, errors.Wrapf(err, "could not decrypt OCR key bundle")
 	}
-	var pk KeyBundle
-	err = json.Unmarshal(marshalledPrivK, &pk)
+	scryptParams := utils.DefaultScryptParams
+	if n, ok := cryptoJSON.KDFParams["n"].(float64); ok {
+		scryptParams.N = int(n)
+	}
+	if p, ok := cryptoJSON.KDFParams["p"].(float64); ok {
+		scryptParams.P = int(p)
+	}
+	return marshalledPrivK, scryptParams, nil
+}
+
+// MigrateEncryptedKey upgrades encKey to the current serialisation format by
+// decrypting it and re-encrypting it with auth and the same scrypt params.
+// The bundle ID is preserved, and keys which are already current are returned
+// as is.
+func MigrateEncryptedKey(encKey *EncryptedKeyBundle, auth string) (*EncryptedKeyBundle, error) {
+	marshalledPrivK, scryptParams, err := encKey.decrypt(auth)
 	if err != nil {
+		return nil, err
+	}
+	version, err := keyVersion(marshalledPrivK)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read OCR key bundle version")
+	}
+	if version == currentKeyVersion {
+		return encKey, nil
+	}
+	var pk KeyBundle
+	if err = json.Unmarshal(marshalledPrivK, &pk); err != nil {
 		return nil, errors.Wrapf(err, "could not unmarshal OCR key bundle")
 	}
-	return &pk, nil
+	migrated, err := pk.encrypt(auth, scryptParams)
+	if err != nil {
+		return nil, err
+	}
+	migrated.CreatedAt = encKey.CreatedAt
+	migrated.UpdatedAt = encKey.UpdatedAt
+	migrated.DeletedAt = encKey.DeletedAt
+	return migrated, nil
 }
 
-// MarshalJSON marshals the private keys into json
-func (pk *KeyBundle) MarshalJSON() ([]byte, error) {
-	rawKeyData := keyBundleRawData{
+func (pk *KeyBundle) rawData() keyBundleRawData {
+	return keyBundleRawData{
+		Version:            currentKeyVersion,
 		EcdsaD:             *pk.onChainSigning.D,
 		Ed25519PrivKey:     []byte(*pk.offChainSigning),
 		OffChainEncryption: *pk.offChainEncryption,
 	}
+}
+
+// MarshalJSON marshals the private keys into json
+func (pk *KeyBundle) MarshalJSON() ([]byte, error) {
+	rawKeyData := pk.rawData()
 	return json.Marshal(&rawKeyData)
 }
 
 // UnmarshalJSON constructs KeyBundle from raw json
 func (pk *KeyBundle) UnmarshalJSON(b []byte) (err error) {
-	var rawKeyData keyBundleRawData
-	err = json.Unmarshal(b, &rawKeyData)
+	rawKeyData, err := unmarshalRawData(b)
 	if err != nil {
 		return err
 	}
+	return pk.setRawData(rawKeyData, rawKeyData.id(b))
+}
+
+// setRawData sets the private keys of pk from validated raw key data
+func (pk *KeyBundle) setRawData(rawKeyData keyBundleRawData, id [sha256.Size]byte) error {
 	ecdsaDSize := len(rawKeyData.EcdsaD.Bytes())
 	if ecdsaDSize > curve25519.PointSize {
 		return errors.Wrapf(ErrScalarTooBig, "got %d byte ecdsa scalar", ecdsaDSize)
@@ -221,6 +304,9 @@ func (pk *KeyBundle) UnmarshalJSON(b []byte) (err error) {
 
 	publicKey := ecdsa.PublicKey{Curve: curve}
 	publicKey.X, publicKey.Y = curve.ScalarBaseMult(rawKeyData.EcdsaD.Bytes())
+	if err := validateOnCurve(publicKey); err != nil {
+		return err
+	}
 	privateKey := ecdsa.PrivateKey{
 		PublicKey: publicKey,
 		D:         &rawKeyData.EcdsaD,
@@ -230,7 +316,7 @@ func (pk *KeyBundle) UnmarshalJSON(b []byte) (err error) {
 	pk.onChainSigning = &onChainSigning
 	pk.offChainSigning = &offChainSigning
 	pk.offChainEncryption = &rawKeyData.OffChainEncryption
-	pk.ID = sha256.Sum256(b)
+	pk.ID = id
 	return nil
 }
 
@@ -0,0 +1,88 @@
+package ocrkey
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/curve25519"
+)
+
+// secp256k1ScalarSize is the size in bytes of a secp256k1 private key scalar
+const secp256k1ScalarSize = 32
+
+// keyBundleCBORData is the CBOR encoding of keyBundleRawData. It is encoded
+// as an array, so that field names are not repeated in every key, and the
+// ecdsa scalar and off-chain encryption key are encoded as byte strings.
+type keyBundleCBORData struct {
+	_                  struct{} `cbor:",toarray"`
+	Version            uint8
+	EcdsaD             []byte
+	Ed25519PrivKey     []byte
+	OffChainEncryption []byte
+}
+
+// MarshalCBOR marshals the private keys into CBOR, which is more compact
+// than json
+func (pk *KeyBundle) MarshalCBOR() ([]byte, error) {
+	raw := pk.rawData()
+	return cbor.Marshal(keyBundleCBORData{
+		Version:            raw.Version,
+		EcdsaD:             raw.EcdsaD.Bytes(),
+		Ed25519PrivKey:     raw.Ed25519PrivKey,
+		OffChainEncryption: raw.OffChainEncryption[:],
+	})
+}
+
+// UnmarshalCBOR constructs KeyBundle from CBOR written by MarshalCBOR. The
+// bundle ID is the same as if it had been encoded as json.
+func (pk *KeyBundle) UnmarshalCBOR(b []byte) error {
+	var data keyBundleCBORData
+	if err := cbor.Unmarshal(b, &data); err != nil {
+		return errors.Wrap(err, "could not unmarshal OCR key bundle CBOR")
+	}
+	if data.Version != currentKeyVersion {
+		return errors.Errorf("unsupported OCR key version %d, expected %d", data.Version, currentKeyVersion)
+	}
+	if len(data.EcdsaD) > secp256k1ScalarSize {
+		return errors.Wrapf(ErrScalarTooBig, "got %d byte ecdsa scalar", len(data.EcdsaD))
+	}
+	if len(data.Ed25519PrivKey) != ed25519.PrivateKeySize {
+		return fmt.Errorf("invalid ed25519 private key: expected %d bytes, got %d", ed25519.PrivateKeySize, len(data.Ed25519PrivKey))
+	}
+	if len(data.OffChainEncryption) != curve25519.ScalarSize {
+		return fmt.Errorf("invalid off-chain encryption key: expected %d bytes, got %d", curve25519.ScalarSize, len(data.OffChainEncryption))
+	}
+	raw := keyBundleRawData{
+		Version:        data.Version,
+		EcdsaD:         *new(big.Int).SetBytes(data.EcdsaD),
+		Ed25519PrivKey: data.Ed25519PrivKey,
+	}
+	copy(raw.OffChainEncryption[:], data.OffChainEncryption)
+	return pk.setRawData(raw, raw.id(nil))
+}
+
+// DecryptCBOR is like Decrypt, but also accepts private keys which were
+// encoded with MarshalCBOR before they were encrypted
+func (ekb *EncryptedKeyBundle) DecryptCBOR(auth string) (*KeyBundle, error) {
+	marshalledPrivK, _, err := ekb.decrypt(auth)
+	if err != nil {
+		return nil, err
+	}
+	var pk KeyBundle
+	// json keys are objects, whereas CBOR keys are arrays, which never
+	// start with '{'
+	if bytes.HasPrefix(bytes.TrimSpace(marshalledPrivK), []byte("{")) {
+		err = json.Unmarshal(marshalledPrivK, &pk)
+	} else {
+		err = pk.UnmarshalCBOR(marshalledPrivK)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal OCR key bundle")
+	}
+	return &pk, nil
+}
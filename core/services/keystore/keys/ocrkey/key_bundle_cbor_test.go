@@ -0,0 +1,119 @@
+package ocrkey_test
+
+import (
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestOCRKeys_BundleMarshallingCBOR(t *testing.T) {
+	t.Parallel()
+
+	k, err := ocrkey.New()
+	require.NoError(t, err)
+
+	b, err := k.MarshalCBOR()
+	require.NoError(t, err)
+	j, err := k.MarshalJSON()
+	require.NoError(t, err)
+	assert.Less(t, len(b), len(j))
+
+	var k2 ocrkey.KeyBundle
+	require.NoError(t, k2.UnmarshalCBOR(b))
+	assert.Equal(t, k.GoString(), k2.GoString())
+	assert.Equal(t, k.ID, k2.ID)
+
+	msg := []byte("hello")
+	sig, err := k.SignOffChain(msg)
+	require.NoError(t, err)
+	sig2, err := k2.SignOffChain(msg)
+	require.NoError(t, err)
+	assert.Equal(t, sig, sig2)
+}
+
+func TestOCRKeys_UnmarshalCBOR_Invalid(t *testing.T) {
+	t.Parallel()
+
+	k, err := ocrkey.New()
+	require.NoError(t, err)
+	b, err := k.MarshalCBOR()
+	require.NoError(t, err)
+
+	corrupt := func(t *testing.T, f func(fields []interface{})) []byte {
+		var fields []interface{}
+		require.NoError(t, cbor.Unmarshal(b, &fields))
+		require.Len(t, fields, 4)
+		f(fields)
+		corrupted, err := cbor.Marshal(fields)
+		require.NoError(t, err)
+		return corrupted
+	}
+
+	for _, tt := range []struct {
+		name   string
+		modify func(fields []interface{})
+		errMsg string
+	}{
+		{"unsupported version", func(fields []interface{}) {
+			fields[0] = 2
+		}, "unsupported OCR key version 2, expected 1"},
+		{"oversized ecdsa scalar", func(fields []interface{}) {
+			fields[1] = make([]byte, 33)
+		}, "got 33 byte ecdsa scalar"},
+		{"zero ecdsa scalar", func(fields []interface{}) {
+			fields[1] = []byte{}
+		}, "is not on the secp256k1 curve"},
+		{"truncated ed25519 key", func(fields []interface{}) {
+			fields[2] = fields[2].([]byte)[1:]
+		}, "invalid ed25519 private key: expected 64 bytes, got 63"},
+		{"truncated encryption key", func(fields []interface{}) {
+			fields[3] = fields[3].([]byte)[1:]
+		}, "invalid off-chain encryption key: expected 32 bytes, got 31"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var kb ocrkey.KeyBundle
+			assert.ErrorContains(t, kb.UnmarshalCBOR(corrupt(t, tt.modify)), tt.errMsg)
+		})
+	}
+
+	t.Run("not CBOR", func(t *testing.T) {
+		var kb ocrkey.KeyBundle
+		assert.Error(t, kb.UnmarshalCBOR([]byte("{}")))
+	})
+}
+
+func TestOCRKeys_BundleDecryptCBOR(t *testing.T) {
+	t.Parallel()
+
+	k, err := ocrkey.New()
+	require.NoError(t, err)
+
+	t.Run("CBOR", func(t *testing.T) {
+		ek, err := k.ExportedEncryptCBOR("test", utils.FastScryptParams)
+		require.NoError(t, err)
+
+		_, err = ek.DecryptCBOR("wrongpass")
+		assert.Error(t, err)
+
+		dk, err := ek.DecryptCBOR("test")
+		require.NoError(t, err)
+		assert.Equal(t, k.GoString(), dk.GoString())
+		assert.Equal(t, k.ID, dk.ID)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		ek, err := k.Encrypt("test", utils.FastScryptParams)
+		require.NoError(t, err)
+
+		dk, err := ek.DecryptCBOR("test")
+		require.NoError(t, err)
+		assert.Equal(t, k.GoString(), dk.GoString())
+		assert.Equal(t, k.ID, dk.ID)
+	})
+}
@@ -1,11 +1,16 @@
 package ocrkey_test
 
 import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
 	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
 
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -47,6 +52,19 @@ func TestOCRKeys_Raw_Key(t *testing.T) {
 	require.Equal(t, key.ID(), key.Raw().Key().ID())
 }
 
+func TestOCRKeys_ConfigEncryptionPublicKey(t *testing.T) {
+	t.Parallel()
+	key := ocrkey.MustNewV2XXXTestingOnly(big.NewInt(1))
+
+	pk, err := key.ConfigEncryptionPublicKey()
+	require.NoError(t, err)
+
+	expected, err := curve25519.X25519(key.ExportedOffChainEncryption()[:], curve25519.Basepoint)
+	require.NoError(t, err)
+	assert.Equal(t, expected, pk[:])
+	assert.Equal(t, pk, key.PublicKeyConfig())
+}
+
 func TestOCRKeys_BundleSetID(t *testing.T) {
 	t.Parallel()
 
@@ -100,3 +118,176 @@ func TestOCRKeys_BundleMarshalling(t *testing.T) {
 
 	assert.Equal(t, k.String(), k2.String())
 }
+
+func TestOCRKeys_UnmarshalJSON_Invalid(t *testing.T) {
+	t.Parallel()
+
+	k, err := ocrkey.NewV2()
+	require.NoError(t, err)
+	b, err := k.MarshalJSON()
+	require.NoError(t, err)
+
+	corrupt := func(t *testing.T, f func(raw map[string]interface{})) []byte {
+		var raw map[string]interface{}
+		d := json.NewDecoder(bytes.NewReader(b))
+		d.UseNumber() // preserve EcdsaD
+		require.NoError(t, d.Decode(&raw))
+		f(raw)
+		corrupted, err := json.Marshal(raw)
+		require.NoError(t, err)
+		return corrupted
+	}
+
+	for _, tt := range []struct {
+		name   string
+		modify func(raw map[string]interface{})
+		errMsg string
+	}{
+		{"truncated ed25519 key", func(raw map[string]interface{}) {
+			raw["Ed25519PrivKey"] = base64.StdEncoding.EncodeToString(make([]byte, ed25519.PrivateKeySize-1))
+		}, "invalid ed25519 private key: expected 64 bytes, got 63"},
+		{"truncated encryption key", func(raw map[string]interface{}) {
+			raw["OffChainEncryption"] = raw["OffChainEncryption"].([]interface{})[1:]
+		}, "invalid off-chain encryption key: expected 32 bytes, got 31"},
+		{"oversized encryption key", func(raw map[string]interface{}) {
+			raw["OffChainEncryption"] = append(raw["OffChainEncryption"].([]interface{}), 1)
+		}, "invalid off-chain encryption key: expected 32 bytes, got 33"},
+		{"zero ecdsa scalar", func(raw map[string]interface{}) {
+			raw["EcdsaD"] = 0
+		}, "is not on the secp256k1 curve"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			corrupted := corrupt(t, tt.modify)
+
+			var k2 ocrkey.KeyV2
+			assert.ErrorContains(t, k2.UnmarshalJSON(corrupted), tt.errMsg)
+
+			var kb ocrkey.KeyBundle
+			assert.ErrorContains(t, kb.UnmarshalJSON(corrupted), tt.errMsg)
+		})
+	}
+}
+
+func TestOCRKeys_UnmarshalJSON_Versions(t *testing.T) {
+	t.Parallel()
+
+	k, err := ocrkey.New()
+	require.NoError(t, err)
+	b, err := k.MarshalJSON()
+	require.NoError(t, err)
+
+	var raw map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(b, &raw))
+	assert.Equal(t, "1", string(raw["Version"]))
+
+	t.Run("unversioned keys keep their ID", func(t *testing.T) {
+		delete(raw, "Version")
+		v0, err := json.Marshal(raw)
+		require.NoError(t, err)
+
+		var kb ocrkey.KeyBundle
+		require.NoError(t, kb.UnmarshalJSON(v0))
+		assert.Equal(t, k.ID, kb.ID)
+
+		var k2 ocrkey.KeyV2
+		require.NoError(t, k2.UnmarshalJSON(v0))
+		assert.Equal(t, k.ID.String(), k2.ID())
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		raw["Version"] = json.RawMessage("2")
+		v2, err := json.Marshal(raw)
+		require.NoError(t, err)
+
+		var kb ocrkey.KeyBundle
+		assert.EqualError(t, kb.UnmarshalJSON(v2), "unsupported OCR key version 2, expected at most 1")
+	})
+}
+
+func TestOCRKeys_MigrateEncryptedKey(t *testing.T) {
+	t.Parallel()
+
+	k, err := ocrkey.New()
+	require.NoError(t, err)
+
+	v0, err := k.ExportedEncryptV0("test", utils.FastScryptParams)
+	require.NoError(t, err)
+
+	_, err = ocrkey.MigrateEncryptedKey(v0, "wrongpass")
+	assert.Error(t, err)
+
+	migrated, err := ocrkey.MigrateEncryptedKey(v0, "test")
+	require.NoError(t, err)
+	assert.Equal(t, v0.ID, migrated.ID)
+	assert.NotEqual(t, v0.EncryptedPrivateKeys, migrated.EncryptedPrivateKeys)
+
+	dk, err := migrated.Decrypt("test")
+	require.NoError(t, err)
+	assert.Equal(t, k.GoString(), dk.GoString())
+	assert.Equal(t, k.ID, dk.ID)
+
+	// already current keys are returned as is
+	again, err := ocrkey.MigrateEncryptedKey(migrated, "test")
+	require.NoError(t, err)
+	assert.Same(t, migrated, again)
+}
+
+func TestOCRKeys_RotateOffChainEncryption(t *testing.T) {
+	t.Parallel()
+
+	t.Run("KeyV2", func(t *testing.T) {
+		key, err := ocrkey.NewV2()
+		require.NoError(t, err)
+		rotated, err := key.RotateOffChainEncryption()
+		require.NoError(t, err)
+
+		assert.Equal(t, key.PublicKeyAddressOnChain(), rotated.PublicKeyAddressOnChain())
+		assert.Equal(t, key.PublicKeyOffChain(), rotated.PublicKeyOffChain())
+		assert.NotEqual(t, key.ExportedOffChainEncryption(), rotated.ExportedOffChainEncryption())
+		assert.NotEqual(t, key.PublicKeyConfig(), rotated.PublicKeyConfig())
+		assert.NotEqual(t, key.ID(), rotated.ID())
+		assert.Equal(t, rotated.ID(), rotated.Raw().Key().ID())
+	})
+
+	t.Run("KeyBundle", func(t *testing.T) {
+		key, err := ocrkey.New()
+		require.NoError(t, err)
+		rotated, err := key.RotateOffChainEncryption()
+		require.NoError(t, err)
+
+		assert.Equal(t, key.PublicKeyAddressOnChain(), rotated.PublicKeyAddressOnChain())
+		assert.Equal(t, key.PublicKeyOffChain(), rotated.PublicKeyOffChain())
+		assert.NotEqual(t, key.PublicKeyConfig(), rotated.PublicKeyConfig())
+		assert.NotEqual(t, key.ID, rotated.ID)
+		assert.Equal(t, rotated.ID.String(), rotated.ToV2().ID())
+	})
+}
+
+func TestOCRKeys_SignOnChainWithNonce(t *testing.T) {
+	t.Parallel()
+	key := ocrkey.MustNewV2XXXTestingOnly(big.NewInt(1))
+	other := ocrkey.MustNewV2XXXTestingOnly(big.NewInt(2))
+	address := ocrkey.OnChainSigningAddress(key.PublicKeyAddressOnChain())
+	msg := []byte("hello world")
+	chainID := big.NewInt(1)
+
+	sig, err := key.SignOnChainWithNonce(msg, 42, chainID)
+	require.NoError(t, err)
+
+	assert.True(t, ocrkey.VerifyOnChainWithNonce(address, msg, 42, chainID, sig))
+	assert.False(t, ocrkey.VerifyOnChainWithNonce(address, []byte("goodbye world"), 42, chainID, sig), "different message")
+	assert.False(t, ocrkey.VerifyOnChainWithNonce(address, msg, 43, chainID, sig), "replayed with another nonce")
+	assert.False(t, ocrkey.VerifyOnChainWithNonce(address, msg, 42, big.NewInt(5), sig), "replayed on another chain")
+	assert.False(t, ocrkey.VerifyOnChainWithNonce(ocrkey.OnChainSigningAddress(other.PublicKeyAddressOnChain()), msg, 42, chainID, sig), "different signer")
+	assert.False(t, ocrkey.VerifyOnChainWithNonce(address, msg, 42, chainID, sig[:10]), "truncated signature")
+
+	plain, err := key.SignOnChain(msg)
+	require.NoError(t, err)
+	assert.NotEqual(t, plain, sig)
+
+	_, err = key.SignOnChainWithNonce(msg, 42, nil)
+	assert.EqualError(t, err, "invalid chain ID: <nil>")
+	_, err = key.SignOnChainWithNonce(msg, 42, big.NewInt(-1))
+	assert.EqualError(t, err, "invalid chain ID: -1")
+}
@@ -22,12 +22,107 @@ var (
 	curve           = secp256k1.S256()
 )
 
+// currentKeyVersion is the version of the serialisation format written by
+// MarshalJSON. Keys written before the format was versioned are version 0.
+const currentKeyVersion uint8 = 1
+
 type keyBundleRawData struct {
+	Version            uint8 `json:",omitempty"`
 	EcdsaD             big.Int
 	Ed25519PrivKey     []byte
 	OffChainEncryption [curve25519.ScalarSize]byte
 }
 
+// keyVersion returns the serialisation format version of the key json b.
+func keyVersion(b []byte) (uint8, error) {
+	var v struct{ Version uint8 }
+	if err := json.Unmarshal(b, &v); err != nil {
+		return 0, err
+	}
+	return v.Version, nil
+}
+
+// unmarshalRawData decodes and validates b with the decoder for its version.
+func unmarshalRawData(b []byte) (keyBundleRawData, error) {
+	version, err := keyVersion(b)
+	if err != nil {
+		return keyBundleRawData{}, err
+	}
+	switch version {
+	case 0:
+		return unmarshalV0(b)
+	case 1:
+		return unmarshalV1(b)
+	}
+	return keyBundleRawData{}, errors.Errorf("unsupported OCR key version %d, expected at most %d", version, currentKeyVersion)
+}
+
+// unmarshalV0 decodes keys written before the format was versioned.
+func unmarshalV0(b []byte) (raw keyBundleRawData, err error) {
+	if err = json.Unmarshal(b, &raw); err != nil {
+		return
+	}
+	err = raw.validate(b)
+	return
+}
+
+// unmarshalV1 decodes version 1 keys, which add the Version field to version 0.
+func unmarshalV1(b []byte) (raw keyBundleRawData, err error) {
+	if err = json.Unmarshal(b, &raw); err != nil {
+		return
+	}
+	if raw.Version != 1 {
+		return raw, errors.Errorf("expected OCR key version 1, got %d", raw.Version)
+	}
+	err = raw.validate(b)
+	return
+}
+
+// id returns the bundle ID, which is the hash of the version 0 encoding so
+// that it does not change when keys are migrated. b is the json that raw was
+// decoded from, if any, and is hashed as is for version 0 keys.
+func (raw keyBundleRawData) id(b []byte) [sha256.Size]byte {
+	if raw.Version == 0 && b != nil {
+		return sha256.Sum256(b)
+	}
+	raw.Version = 0
+	v0, err := json.Marshal(&raw)
+	if err != nil {
+		panic(errors.Wrap(err, "while calculating OCR key ID"))
+	}
+	return sha256.Sum256(v0)
+}
+
+// validate rejects truncated or corrupted key material, which would otherwise
+// silently produce bad signatures. b is the json that raw was decoded from.
+func (raw *keyBundleRawData) validate(b []byte) error {
+	if len(raw.Ed25519PrivKey) != ed25519.PrivateKeySize {
+		return fmt.Errorf("invalid ed25519 private key: expected %d bytes, got %d", ed25519.PrivateKeySize, len(raw.Ed25519PrivKey))
+	}
+	// encoding/json zero-pads or truncates fixed size arrays, so the length has
+	// to be checked against the encoded value instead.
+	var encryption struct {
+		OffChainEncryption []json.RawMessage
+	}
+	if err := json.Unmarshal(b, &encryption); err != nil {
+		return err
+	}
+	if n := len(encryption.OffChainEncryption); n != curve25519.ScalarSize {
+		return fmt.Errorf("invalid off-chain encryption key: expected %d bytes, got %d", curve25519.ScalarSize, n)
+	}
+	return nil
+}
+
+// validateOnCurve checks that the public key derived from the on-chain signing
+// scalar is a valid secp256k1 point. ScalarBaseMult returns nil coordinates for
+// a zero or overflowing scalar.
+func validateOnCurve(publicKey ecdsa.PublicKey) error {
+	if publicKey.X == nil || publicKey.Y == nil || !curve.IsOnCurve(publicKey.X, publicKey.Y) {
+		return fmt.Errorf("invalid ecdsa private key: public key is not on the secp256k1 curve")
+	}
+	return nil
+}
+
 type Raw []byte
 
 func (raw Raw) Key() KeyV2 {
@@ -95,7 +190,7 @@ func MustNewV2XXXTestingOnly(k *big.Int) KeyV2 {
 }
 
 func (key KeyV2) ID() string {
-	sha := sha256.Sum256(key.Raw())
+	sha := key.rawData().id(nil)
 	return hex.EncodeToString(sha[:])
 }
 
@@ -112,6 +207,13 @@ func (key KeyV2) SignOnChain(msg []byte) (signature []byte, err error) {
 	return key.OnChainSigning.Sign(msg)
 }
 
+// SignOnChainWithNonce returns an ethereum-style ECDSA secp256k1 signature on
+// msg, bound to nonce and chainID so that it cannot be replayed in another
+// context. Check it with VerifyOnChainWithNonce.
+func (key KeyV2) SignOnChainWithNonce(msg []byte, nonce uint64, chainID *big.Int) (signature []byte, err error) {
+	return key.OnChainSigning.SignWithNonce(msg, nonce, chainID)
+}
+
 // SignOffChain returns an EdDSA-Ed25519 signature on msg.
 func (key KeyV2) SignOffChain(msg []byte) (signature []byte, err error) {
 	return key.OffChainSigning.Sign(msg)
@@ -142,15 +244,43 @@ func (key KeyV2) PublicKeyOffChain() ocrtypes.OffchainPublicKey {
 	return ocrtypes.OffchainPublicKey(key.OffChainSigning.PublicKey())
 }
 
+// ConfigEncryptionPublicKey returns the public component of the keypair used
+// in ConfigKeyShare
+func (key KeyV2) ConfigEncryptionPublicKey() (pk [curve25519.PointSize]byte, err error) {
+	rv, err := curve25519.X25519(key.OffChainEncryption[:], curve25519.Basepoint)
+	if err != nil {
+		return pk, errors.Wrap(err, "failed to compute config encryption public key")
+	}
+	copy(pk[:], rv)
+	return pk, nil
+}
+
 // PublicKeyConfig returns the public component of the keypair used in ConfigKeyShare
+//
+// Deprecated: use ConfigEncryptionPublicKey, which returns the error instead
+// of logging it.
 func (key KeyV2) PublicKeyConfig() [curve25519.PointSize]byte {
-	rv, err := curve25519.X25519(key.OffChainEncryption[:], curve25519.Basepoint)
+	pk, err := key.ConfigEncryptionPublicKey()
 	if err != nil {
-		log.Println("failure while computing public key: " + err.Error())
+		log.Println(err.Error())
+	}
+	return pk
+}
+
+// RotateOffChainEncryption returns a copy of key with a newly generated
+// off-chain encryption scalar. The on-chain and off-chain signing keys, and
+// therefore the on-chain signing address, are unchanged, but the key ID is
+// not, since it covers the encryption scalar.
+func (key KeyV2) RotateOffChainEncryption() (KeyV2, error) {
+	var encryptionPriv [curve25519.ScalarSize]byte
+	if _, err := rand.Reader.Read(encryptionPriv[:]); err != nil {
+		return KeyV2{}, errors.Wrap(err, "failed to generate off-chain encryption key")
 	}
-	var rvFixed [curve25519.PointSize]byte
-	copy(rvFixed[:], rv)
-	return rvFixed
+	return KeyV2{
+		OnChainSigning:     key.OnChainSigning,
+		OffChainSigning:    key.OffChainSigning,
+		OffChainEncryption: &encryptionPriv,
+	}, nil
 }
 
 func (key KeyV2) GetID() string {
@@ -165,19 +295,23 @@ func (key KeyV2) GoString() string {
 	return key.String()
 }
 
-// MarshalJSON marshals the private keys into json
-func (key KeyV2) MarshalJSON() ([]byte, error) {
-	rawKeyData := keyBundleRawData{
+func (key KeyV2) rawData() keyBundleRawData {
+	return keyBundleRawData{
+		Version:            currentKeyVersion,
 		EcdsaD:             *key.OnChainSigning.D,
 		Ed25519PrivKey:     []byte(*key.OffChainSigning),
 		OffChainEncryption: *key.OffChainEncryption,
 	}
+}
+
+// MarshalJSON marshals the private keys into json
+func (key KeyV2) MarshalJSON() ([]byte, error) {
+	rawKeyData := key.rawData()
 	return json.Marshal(&rawKeyData)
 }
 
 func (key *KeyV2) UnmarshalJSON(b []byte) (err error) {
-	var rawKeyData keyBundleRawData
-	err = json.Unmarshal(b, &rawKeyData)
+	rawKeyData, err := unmarshalRawData(b)
 	if err != nil {
 		return err
 	}
@@ -188,6 +322,9 @@ func (key *KeyV2) UnmarshalJSON(b []byte) (err error) {
 
 	publicKey := ecdsa.PublicKey{Curve: curve}
 	publicKey.X, publicKey.Y = curve.ScalarBaseMult(rawKeyData.EcdsaD.Bytes())
+	if err = validateOnCurve(publicKey); err != nil {
+		return err
+	}
 	privateKey := ecdsa.PrivateKey{
 		PublicKey: publicKey,
 		D:         &rawKeyData.EcdsaD,
@@ -1,9 +1,14 @@
 package ocrkey
 
 import (
+	"bytes"
 	"crypto/ecdsa"
+	"encoding/binary"
+	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
 )
 
 type onChainPrivateKey ecdsa.PrivateKey
@@ -14,6 +19,16 @@ func (k *onChainPrivateKey) Sign(msg []byte) (signature []byte, err error) {
 	return sig, err
 }
 
+// SignWithNonce returns the signature with k on msg, bound to nonce and
+// chainID. See onChainNoncePreimage.
+func (k *onChainPrivateKey) SignWithNonce(msg []byte, nonce uint64, chainID *big.Int) (signature []byte, err error) {
+	preimage, err := onChainNoncePreimage(msg, nonce, chainID)
+	if err != nil {
+		return nil, err
+	}
+	return k.Sign(preimage)
+}
+
 func (k onChainPrivateKey) Address() OnChainSigningAddress {
 	return OnChainSigningAddress(crypto.PubkeyToAddress(k.PublicKey))
 }
@@ -21,3 +36,33 @@ func (k onChainPrivateKey) Address() OnChainSigningAddress {
 func onChainHash(msg []byte) []byte {
 	return crypto.Keccak256(msg)
 }
+
+// onChainNoncePreimage returns the bytes signed by SignOnChainWithNonce: the
+// chain ID as a 32 byte big-endian integer, followed by the nonce as an 8
+// byte big-endian integer, followed by msg. Binding the chain ID and nonce
+// prevents a signature from being replayed on another chain, or again on the
+// same one.
+func onChainNoncePreimage(msg []byte, nonce uint64, chainID *big.Int) ([]byte, error) {
+	if chainID == nil || chainID.Sign() < 0 || chainID.BitLen() > 256 {
+		return nil, errors.Errorf("invalid chain ID: %v", chainID)
+	}
+	preimage := make([]byte, 0, 32+8+len(msg))
+	preimage = append(preimage, common.LeftPadBytes(chainID.Bytes(), 32)...)
+	preimage = binary.BigEndian.AppendUint64(preimage, nonce)
+	return append(preimage, msg...), nil
+}
+
+// VerifyOnChainWithNonce returns true if signature was produced by
+// SignOnChainWithNonce with msg, nonce and chainID, by the key with address.
+func VerifyOnChainWithNonce(address OnChainSigningAddress, msg []byte, nonce uint64, chainID *big.Int, signature []byte) bool {
+	preimage, err := onChainNoncePreimage(msg, nonce, chainID)
+	if err != nil {
+		return false
+	}
+	pubKey, err := crypto.SigToPub(onChainHash(preimage), signature)
+	if err != nil {
+		return false
+	}
+	signer := crypto.PubkeyToAddress(*pubKey)
+	return bytes.Equal(address[:], signer[:])
+}
@@ -0,0 +1,129 @@
+package ocrkey
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// PEM block types for the on-chain signing key. The standard library's x509
+// package does not support secp256k1, so the ASN.1 is encoded here.
+const (
+	pemTypePKCS8 = "PRIVATE KEY"
+	pemTypeSEC1  = "EC PRIVATE KEY"
+)
+
+var (
+	oidPublicKeyECDSA = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidSecp256k1      = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+// pkcs8 is the PKCS#8 PrivateKeyInfo structure, see RFC 5208
+type pkcs8 struct {
+	Version    int
+	Algo       pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+// ecPrivateKey is the SEC1 ECPrivateKey structure, see RFC 5915
+type ecPrivateKey struct {
+	Version       int
+	PrivateKey    []byte
+	NamedCurveOID asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	PublicKey     asn1.BitString        `asn1:"optional,explicit,tag:1"`
+}
+
+// ExportOnChainPEM returns the on-chain signing key as a PKCS#8 "PRIVATE KEY"
+// PEM block, which can be read by standard tools such as openssl.
+//
+// CAUTION: the result is not encrypted.
+func (key KeyV2) ExportOnChainPEM() ([]byte, error) {
+	return key.OnChainSigning.marshalPEM()
+}
+
+// ImportOnChainKeyPEM parses an on-chain signing key from a PKCS#8
+// "PRIVATE KEY" or SEC1 "EC PRIVATE KEY" PEM block. The key must be on the
+// secp256k1 curve.
+func ImportOnChainKeyPEM(pemBlock []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBlock)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	switch block.Type {
+	case pemTypePKCS8:
+		var info pkcs8
+		if _, err := asn1.Unmarshal(block.Bytes, &info); err != nil {
+			return nil, errors.Wrap(err, "failed to parse PKCS#8 private key")
+		}
+		if !info.Algo.Algorithm.Equal(oidPublicKeyECDSA) {
+			return nil, errors.Errorf("unsupported PKCS#8 key algorithm %s: must be ECDSA", info.Algo.Algorithm)
+		}
+		var curveOID asn1.ObjectIdentifier
+		if _, err := asn1.Unmarshal(info.Algo.Parameters.FullBytes, &curveOID); err != nil {
+			return nil, errors.Wrap(err, "failed to parse PKCS#8 curve")
+		}
+		return parseECPrivateKey(curveOID, info.PrivateKey)
+	case pemTypeSEC1:
+		return parseECPrivateKey(nil, block.Bytes)
+	default:
+		return nil, errors.Errorf("unsupported PEM block type %q: must be %q or %q", block.Type, pemTypePKCS8, pemTypeSEC1)
+	}
+}
+
+func (k *onChainPrivateKey) marshalPEM() ([]byte, error) {
+	ecdsaKey := (*ecdsa.PrivateKey)(k)
+	pub := crypto.FromECDSAPub(&ecdsaKey.PublicKey)
+	inner, err := asn1.Marshal(ecPrivateKey{
+		Version:    1,
+		PrivateKey: crypto.FromECDSA(ecdsaKey),
+		PublicKey:  asn1.BitString{Bytes: pub, BitLength: 8 * len(pub)},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal EC private key")
+	}
+	params, err := asn1.Marshal(oidSecp256k1)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal curve")
+	}
+	der, err := asn1.Marshal(pkcs8{
+		Algo: pkix.AlgorithmIdentifier{
+			Algorithm:  oidPublicKeyECDSA,
+			Parameters: asn1.RawValue{FullBytes: params},
+		},
+		PrivateKey: inner,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal PKCS#8 private key")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypePKCS8, Bytes: der}), nil
+}
+
+// parseECPrivateKey parses a SEC1 ECPrivateKey. The curve is given by
+// curveOID, for PKCS#8, or by the key itself.
+func parseECPrivateKey(curveOID asn1.ObjectIdentifier, der []byte) (*ecdsa.PrivateKey, error) {
+	var k ecPrivateKey
+	if _, err := asn1.Unmarshal(der, &k); err != nil {
+		return nil, errors.Wrap(err, "failed to parse EC private key")
+	}
+	if k.Version != 1 {
+		return nil, errors.Errorf("unsupported EC private key version %d", k.Version)
+	}
+	if len(k.NamedCurveOID) > 0 {
+		curveOID = k.NamedCurveOID
+	}
+	if !curveOID.Equal(oidSecp256k1) {
+		return nil, errors.Errorf("unsupported curve %s: must be secp256k1", curveOID)
+	}
+	ecdsaKey, err := crypto.ToECDSA(k.PrivateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid EC private key")
+	}
+	if err := validateOnCurve(ecdsaKey.PublicKey); err != nil {
+		return nil, err
+	}
+	return ecdsaKey, nil
+}
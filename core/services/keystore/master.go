@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	starkkey "github.com/smartcontractkit/chainlink-starknet/relayer/pkg/chainlink/keys"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/dkgencryptkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/dkgsignkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocr2key"
@@ -50,6 +51,7 @@ type Master interface {
 	Unlock(password string) error
 	Migrate(vrfPassword string, f DefaultEVMChainIDFunc) error
 	IsEmpty() (bool, error)
+	RotateOCRKeys(chainType chaintype.ChainType) (ocrkey.KeyV2, ocr2key.KeyBundle, error)
 }
 
 type master struct {
@@ -148,6 +150,33 @@ func (ks *master) IsEmpty() (bool, error) {
 	return count == 0, nil
 }
 
+// RotateOCRKeys creates a new OCR key and a new OCR2 key bundle of the given
+// chain type, and stores both in a single write so that either both or neither
+// are persisted. Existing keys are kept, since jobs reference them by ID.
+func (ks *master) RotateOCRKeys(chainType chaintype.ChainType) (ocrkey.KeyV2, ocr2key.KeyBundle, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ocrkey.KeyV2{}, nil, ErrLocked
+	}
+	if !chaintype.IsSupportedChainType(chainType) {
+		return ocrkey.KeyV2{}, nil, chaintype.NewErrInvalidChainType(chainType)
+	}
+	ocrKey, err := ocrkey.NewV2()
+	if err != nil {
+		return ocrkey.KeyV2{}, nil, err
+	}
+	ocr2Key, err := ocr2key.New(chainType)
+	if err != nil {
+		return ocrkey.KeyV2{}, nil, err
+	}
+	if err = ks.safeAddKeys([]Key{ocrKey, ocr2Key}); err != nil {
+		return ocrkey.KeyV2{}, nil, errors.Wrap(err, "failed to rotate OCR keys")
+	}
+	ks.logger.Infow("Rotated OCR keys", "ocrKeyID", ocrKey.ID(), "ocr2KeyID", ocr2Key.ID(), "chainType", chainType)
+	return ocrKey, ocr2Key, nil
+}
+
 func (ks *master) Migrate(vrfPssword string, f DefaultEVMChainIDFunc) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
@@ -307,6 +336,41 @@ func (km *keyManager) safeAddKey(unknownKey Key, callbacks ...func(pg.Queryer) e
 	return nil
 }
 
+// safeAddKeys adds all the given keys and saves the keyRing once, so that
+// either all or none of them are persisted.
+// caller must hold lock!
+func (km *keyManager) safeAddKeys(unknownKeys []Key, callbacks ...func(pg.Queryer) error) error {
+	type added struct {
+		keyMap reflect.Value
+		id     reflect.Value
+	}
+	var adds []added
+	rollback := func() {
+		for _, a := range adds {
+			a.keyMap.SetMapIndex(a.id, reflect.Value{})
+		}
+	}
+	keyRing := reflect.Indirect(reflect.ValueOf(km.keyRing))
+	for _, unknownKey := range unknownKeys {
+		fieldName, err := GetFieldNameForKey(unknownKey)
+		if err != nil {
+			rollback()
+			return err
+		}
+		id := reflect.ValueOf(unknownKey.ID())
+		keyMap := keyRing.FieldByName(fieldName)
+		keyMap.SetMapIndex(id, reflect.ValueOf(unknownKey))
+		adds = append(adds, added{keyMap, id})
+	}
+	// save keyring to DB
+	if err := km.save(callbacks...); err != nil {
+		// if save fails, remove keys from keyring
+		rollback()
+		return err
+	}
+	return nil
+}
+
 // caller must hold lock!
 func (km *keyManager) safeRemoveKey(unknownKey Key, callbacks ...func(pg.Queryer) error) (err error) {
 	fieldName, err := GetFieldNameForKey(unknownKey)
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/chaintype"
 )
 
 func TestMasterKeystore_Unlock_Save(t *testing.T) {
@@ -61,3 +63,41 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 		require.NoError(t, keyStore.Unlock(cltest.Password))
 	})
 }
+
+func TestMasterKeystore_RotateOCRKeys(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+
+	_, _, err := keyStore.RotateOCRKeys(chaintype.EVM)
+	require.ErrorIs(t, err, keystore.ErrLocked)
+
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+
+	t.Run("rejects unsupported chain types", func(t *testing.T) {
+		_, _, err := keyStore.RotateOCRKeys("foo")
+		require.Error(t, err)
+		ocrKeys, err := keyStore.OCR().GetAll()
+		require.NoError(t, err)
+		assert.Len(t, ocrKeys, 0)
+	})
+
+	t.Run("stores both keys", func(t *testing.T) {
+		ocrKey, ocr2Key, err := keyStore.RotateOCRKeys(chaintype.EVM)
+		require.NoError(t, err)
+
+		// reload from the database
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(cltest.Password))
+
+		gotOCR, err := keyStore.OCR().Get(ocrKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, ocrKey.ID(), gotOCR.ID())
+		gotOCR2, err := keyStore.OCR2().Get(ocr2Key.ID())
+		require.NoError(t, err)
+		assert.Equal(t, ocr2Key.ID(), gotOCR2.ID())
+		assert.Equal(t, chaintype.EVM, gotOCR2.ChainType())
+	})
+}
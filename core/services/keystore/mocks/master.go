@@ -4,7 +4,13 @@ package mocks
 
 import (
 	keystore "github.com/smartcontractkit/chainlink/core/services/keystore"
+	chaintype "github.com/smartcontractkit/chainlink/core/services/keystore/chaintype"
+
 	mock "github.com/stretchr/testify/mock"
+
+	ocr2key "github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocr2key"
+
+	ocrkey "github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
 )
 
 // Master is an autogenerated mock type for the Master type
@@ -159,6 +165,36 @@ func (_m *Master) P2P() keystore.P2P {
 	return r0
 }
 
+// RotateOCRKeys provides a mock function with given fields: chainType
+func (_m *Master) RotateOCRKeys(chainType chaintype.ChainType) (ocrkey.KeyV2, ocr2key.KeyBundle, error) {
+	ret := _m.Called(chainType)
+
+	var r0 ocrkey.KeyV2
+	if rf, ok := ret.Get(0).(func(chaintype.ChainType) ocrkey.KeyV2); ok {
+		r0 = rf(chainType)
+	} else {
+		r0 = ret.Get(0).(ocrkey.KeyV2)
+	}
+
+	var r1 ocr2key.KeyBundle
+	if rf, ok := ret.Get(1).(func(chaintype.ChainType) ocr2key.KeyBundle); ok {
+		r1 = rf(chainType)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(ocr2key.KeyBundle)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(chaintype.ChainType) error); ok {
+		r2 = rf(chainType)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Solana provides a mock function with given fields:
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
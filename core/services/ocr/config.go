@@ -24,6 +24,9 @@ func toLocalConfig(cfg ValidationConfig, spec job.OCROracleSpec) ocrtypes.LocalC
 		DataSourceTimeout:                      concreteSpec.ObservationTimeout.Duration(),
 		DataSourceGracePeriod:                  concreteSpec.ObservationGracePeriod.Duration(),
 	}
+	if min := cfg.EvmOCRMinObservationTimeout(); lc.DataSourceTimeout < min {
+		lc.DataSourceTimeout = min
+	}
 	if cfg.Dev() {
 		// Skips config validation so we can use any config parameters we want.
 		// For example to lower contractConfigTrackerPollInterval to speed up tests.
@@ -0,0 +1,52 @@
+package ocr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/config/mocks"
+	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func TestToLocalConfig_MinObservationTimeout(t *testing.T) {
+	t.Parallel()
+
+	spec := func(observationTimeout time.Duration) job.OCROracleSpec {
+		return job.OCROracleSpec{
+			ObservationTimeout:                     models.Interval(observationTimeout),
+			BlockchainTimeout:                      models.Interval(20 * time.Second),
+			ContractConfigTrackerSubscribeInterval: models.Interval(2 * time.Minute),
+			ContractConfigTrackerPollInterval:      models.Interval(time.Minute),
+			ContractConfigConfirmations:            3,
+			DatabaseTimeout:                        models.NewInterval(10 * time.Second),
+			ObservationGracePeriod:                 models.NewInterval(time.Second),
+			ContractTransmitterTransmitTimeout:     models.NewInterval(10 * time.Second),
+		}
+	}
+	newConfig := func(t *testing.T, minObservationTimeout time.Duration) *mocks.ChainScopedConfig {
+		cfg := mocks.NewChainScopedConfig(t)
+		cfg.On("ChainType").Return(config.ChainType(""))
+		cfg.On("Dev").Return(false)
+		cfg.On("EvmOCRMinObservationTimeout").Return(minObservationTimeout)
+		return cfg
+	}
+
+	t.Run("raises the observation timeout to the chain minimum", func(t *testing.T) {
+		lc := toLocalConfig(newConfig(t, 20*time.Second), spec(5*time.Second))
+		assert.Equal(t, 20*time.Second, lc.DataSourceTimeout)
+	})
+
+	t.Run("keeps an observation timeout above the chain minimum", func(t *testing.T) {
+		lc := toLocalConfig(newConfig(t, 5*time.Second), spec(10*time.Second))
+		assert.Equal(t, 10*time.Second, lc.DataSourceTimeout)
+	})
+
+	t.Run("keeps the observation timeout without a chain minimum", func(t *testing.T) {
+		lc := toLocalConfig(newConfig(t, 0), spec(time.Second))
+		assert.Equal(t, time.Second, lc.DataSourceTimeout)
+	})
+}
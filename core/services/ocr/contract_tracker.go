@@ -3,6 +3,7 @@ package ocr
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"strings"
 	"sync"
 	"time"
@@ -90,6 +91,7 @@ type (
 	OCRContractTrackerDB interface {
 		SaveLatestRoundRequested(tx pg.Queryer, rr offchainaggregator.OffchainAggregatorRoundRequested) error
 		LoadLatestRoundRequested() (rr offchainaggregator.OffchainAggregatorRoundRequested, err error)
+		ReadConfig(ctx context.Context) (c *ocrtypes.ContractConfig, err error)
 	}
 )
 
@@ -137,7 +139,7 @@ func NewOCRContractTracker(
 
 // Start must be called before logs can be delivered
 // It ought to be called before starting OCR
-func (t *OCRContractTracker) Start(context.Context) error {
+func (t *OCRContractTracker) Start(ctx context.Context) error {
 	return t.StartOnce("OCRContractTracker", func() (err error) {
 		t.latestRoundRequested, err = t.ocrDB.LoadLatestRoundRequested()
 		if err != nil {
@@ -158,6 +160,9 @@ func (t *OCRContractTracker) Start(context.Context) error {
 		latestHead, t.unsubscribeHeads = t.headBroadcaster.Subscribe(t)
 		if latestHead != nil {
 			t.setLatestBlockHeight(latestHead)
+			if t.latestRoundRequested.Raw.BlockNumber == 0 {
+				t.scanLatestRoundRequested(ctx, latestHead.Number)
+			}
 		}
 
 		t.wg.Add(1)
@@ -166,6 +171,56 @@ func (t *OCRContractTracker) Start(context.Context) error {
 	})
 }
 
+// scanLatestRoundRequested searches the most recent blocks for RoundRequested
+// logs, so that a new job is aware of rounds requested before it subscribed to
+// the contract's logs. Once a job has persisted a contract config it has been
+// subscribed before, so the search is skipped rather than repeated on every
+// start. A round found by the search is persisted.
+func (t *OCRContractTracker) scanLatestRoundRequested(ctx context.Context, latestBlock int64) {
+	lookback := int64(t.cfg.EvmOCRContractLookbackBlocks())
+	if lookback == 0 {
+		return
+	}
+	if cfg, err := t.ocrDB.ReadConfig(ctx); err != nil {
+		t.logger.Warnw("Failed to read persisted contract config, skipping search for recent RoundRequested logs", "err", err)
+		return
+	} else if cfg != nil {
+		return
+	}
+	fromBlock := latestBlock - lookback
+	if fromBlock < 0 {
+		fromBlock = 0
+	}
+	logs, err := t.ethClient.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: big.NewInt(fromBlock),
+		ToBlock:   big.NewInt(latestBlock),
+		Addresses: []gethCommon.Address{t.contract.Address()},
+		Topics: [][]gethCommon.Hash{
+			{OCRContractLatestRoundRequested},
+		},
+	})
+	if err != nil {
+		t.logger.Warnw("Failed to search for recent RoundRequested logs", "fromBlock", fromBlock, "toBlock", latestBlock, "err", err)
+		return
+	}
+	for _, raw := range logs {
+		rr, err := t.contractFilterer.ParseRoundRequested(raw)
+		if err != nil {
+			t.logger.Errorw("could not parse round requested", "err", err)
+			continue
+		}
+		if IsLaterThan(raw, t.latestRoundRequested.Raw) {
+			t.latestRoundRequested = *rr
+		}
+	}
+	if t.latestRoundRequested.Raw.BlockNumber > 0 {
+		if err = t.ocrDB.SaveLatestRoundRequested(t.q.WithOpts(pg.WithParentCtx(ctx)), t.latestRoundRequested); err != nil {
+			t.logger.Errorw("Failed to save latest RoundRequested found in recent logs", "err", err)
+		}
+		t.logger.Infow("Restored latest RoundRequested from recent logs", "latestRoundRequested", t.latestRoundRequested, "fromBlock", fromBlock, "toBlock", latestBlock)
+	}
+}
+
 // Close should be called after teardown of the OCR job relying on this tracker
 func (t *OCRContractTracker) Close() error {
 	return t.StopOnce("OCRContractTracker", func() error {
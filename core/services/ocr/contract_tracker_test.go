@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
@@ -150,6 +151,8 @@ func Test_OCRContractTracker_LatestBlockHeight(t *testing.T) {
 		uni.hb.On("Subscribe", uni.tracker).Return(&evmtypes.Head{Number: 42}, func() {})
 		uni.db.On("LoadLatestRoundRequested").Return(offchainaggregator.OffchainAggregatorRoundRequested{}, nil)
 		uni.lb.On("Register", uni.tracker, mock.Anything).Return(func() {})
+		uni.db.On("ReadConfig", mock.Anything).Return(nil, nil)
+		uni.ec.On("FilterLogs", mock.Anything, mock.Anything).Return(nil, nil)
 
 		require.NoError(t, uni.tracker.Start(testutils.Context(t)))
 
@@ -349,6 +352,51 @@ func Test_OCRContractTracker_HandleLog_OCRContractLatestRoundRequested(t *testin
 		eventuallyCloseHeadBroadcaster.AssertHappened(t, true)
 		eventuallyCloseLogBroadcaster.AssertHappened(t, true)
 	})
+
+	t.Run("searches recent logs for the latest round requested on start if none was persisted", func(t *testing.T) {
+		uni := newContractTrackerUni(t, fixtureFilterer, fixtureContract)
+
+		rawLog := cltest.LogFromFixture(t, "../../testdata/jsonrpc/round_requested_log_1_1.json")
+		latestBlock := int64(rawLog.BlockNumber) + 10
+
+		uni.lb.On("Register", uni.tracker, mock.Anything).Return(func() {})
+		uni.hb.On("Subscribe", uni.tracker).Return(&evmtypes.Head{Number: latestBlock}, func() {})
+		uni.db.On("LoadLatestRoundRequested").Return(offchainaggregator.OffchainAggregatorRoundRequested{}, nil)
+		uni.db.On("ReadConfig", mock.Anything).Return(nil, nil)
+		uni.db.On("SaveLatestRoundRequested", mock.Anything, mock.MatchedBy(func(rr offchainaggregator.OffchainAggregatorRoundRequested) bool {
+			return rr.Raw.BlockNumber == rawLog.BlockNumber
+		})).Return(nil).Once()
+		uni.ec.On("FilterLogs", mock.Anything, mock.MatchedBy(func(q ethereum.FilterQuery) bool {
+			return q.FromBlock.Int64() == latestBlock-100 &&
+				q.ToBlock.Int64() == latestBlock &&
+				assert.Equal(t, []gethCommon.Address{fixtureContract.Address()}, q.Addresses) &&
+				assert.Equal(t, [][]gethCommon.Hash{{ocr.OCRContractLatestRoundRequested}}, q.Topics)
+		})).Return([]types.Log{rawLog}, nil).Once()
+
+		require.NoError(t, uni.tracker.Start(testutils.Context(t)))
+
+		configDigest, epoch, round, err := uni.tracker.LatestRoundRequested(testutils.Context(t), 0)
+		require.NoError(t, err)
+		assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", configDigest.Hex())
+		assert.Equal(t, 1, int(epoch))
+		assert.Equal(t, 1, int(round))
+
+		require.NoError(t, uni.tracker.Close())
+	})
+
+	t.Run("does not search recent logs on start if the job has persisted a contract config", func(t *testing.T) {
+		uni := newContractTrackerUni(t, fixtureFilterer, fixtureContract)
+
+		uni.lb.On("Register", uni.tracker, mock.Anything).Return(func() {})
+		uni.hb.On("Subscribe", uni.tracker).Return(&evmtypes.Head{Number: 42}, func() {})
+		uni.db.On("LoadLatestRoundRequested").Return(offchainaggregator.OffchainAggregatorRoundRequested{}, nil)
+		uni.db.On("ReadConfig", mock.Anything).Return(&ocrtypes.ContractConfig{}, nil)
+
+		require.NoError(t, uni.tracker.Start(testutils.Context(t)))
+
+		uni.ec.AssertNotCalled(t, "FilterLogs", mock.Anything, mock.Anything)
+		require.NoError(t, uni.tracker.Close())
+	})
 }
 
 func Test_OCRContractTracker_IsLaterThan(t *testing.T) {
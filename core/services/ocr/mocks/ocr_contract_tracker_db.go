@@ -3,11 +3,15 @@
 package mocks
 
 import (
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
 
 	offchainaggregator "github.com/smartcontractkit/libocr/gethwrappers/offchainaggregator"
 
 	pg "github.com/smartcontractkit/chainlink/core/services/pg"
+
+	types "github.com/smartcontractkit/libocr/offchainreporting/types"
 )
 
 // OCRContractTrackerDB is an autogenerated mock type for the OCRContractTrackerDB type
@@ -36,6 +40,29 @@ func (_m *OCRContractTrackerDB) LoadLatestRoundRequested() (offchainaggregator.O
 	return r0, r1
 }
 
+// ReadConfig provides a mock function with given fields: ctx
+func (_m *OCRContractTrackerDB) ReadConfig(ctx context.Context) (*types.ContractConfig, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *types.ContractConfig
+	if rf, ok := ret.Get(0).(func(context.Context) *types.ContractConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ContractConfig)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SaveLatestRoundRequested provides a mock function with given fields: tx, rr
 func (_m *OCRContractTrackerDB) SaveLatestRoundRequested(tx pg.Queryer, rr offchainaggregator.OffchainAggregatorRoundRequested) error {
 	ret := _m.Called(tx, rr)
@@ -20,6 +20,8 @@ import (
 type ValidationConfig interface {
 	ChainType() config.ChainType
 	Dev() bool
+	EvmOCRKeyBundleID() string
+	EvmOCRMinObservationTimeout() time.Duration
 	OCRBlockchainTimeout() time.Duration
 	OCRContractConfirmations() uint16
 	OCRContractPollInterval() time.Duration
@@ -142,6 +144,9 @@ func validateNonBootstrapSpec(tree *toml.Tree, config ValidationConfig, spec job
 	} else {
 		observationTimeout = config.OCRObservationTimeout()
 	}
+	if min := config.EvmOCRMinObservationTimeout(); observationTimeout < min {
+		observationTimeout = min
+	}
 	if time.Duration(spec.MaxTaskDuration) > observationTimeout {
 		return errors.Errorf("max task duration must be < observation timeout")
 	}
@@ -18,6 +18,7 @@ type Config interface {
 	JobPipelineResultWriteQueueDepth() uint64
 	OCRBlockchainTimeout() time.Duration
 	OCRContractConfirmations() uint16
+	EvmOCRContractLookbackBlocks() uint64
 	OCRContractPollInterval() time.Duration
 	OCRContractSubscribeInterval() time.Duration
 	OCRContractTransmitterTransmitTimeout() time.Duration
@@ -98,6 +98,14 @@ func WithLongQueryTimeout() func(q *Q) {
 	}
 }
 
+// WithDefaultQueryTimeout replaces the `DefaultQueryTimeout` duration for q and every Q derived from it with WithOpts, e.g. to apply a chain specific deadline
+// A QueryTimeout set by another QOpt takes precedence for that Q only
+func WithDefaultQueryTimeout(timeout time.Duration) func(q *Q) {
+	return func(q *Q) {
+		q.defaultQueryTimeout = timeout
+	}
+}
+
 var _ Queryer = Q{}
 
 // Q wraps an underlying queryer (either a *sqlx.DB or a *sqlx.Tx)
@@ -120,6 +128,8 @@ type Q struct {
 	logger       logger.Logger
 	config       LogConfig
 	QueryTimeout time.Duration
+
+	defaultQueryTimeout time.Duration
 }
 
 func NewQ(db *sqlx.DB, logger logger.Logger, config LogConfig, qopts ...QOpt) (q Q) {
@@ -148,16 +158,23 @@ func PrepareQueryRowx(q Queryer, sql string, dest interface{}, arg interface{})
 }
 
 func (q Q) WithOpts(qopts ...QOpt) Q {
+	if q.defaultQueryTimeout > 0 {
+		qopts = append([]QOpt{WithDefaultQueryTimeout(q.defaultQueryTimeout)}, qopts...)
+	}
 	return NewQ(q.db, q.originalLogger(), q.config, qopts...)
 }
 
 func (q Q) Context() (context.Context, context.CancelFunc) {
-	if q.QueryTimeout > 0 {
+	timeout := q.QueryTimeout
+	if timeout <= 0 {
+		timeout = q.defaultQueryTimeout
+	}
+	if timeout > 0 {
 		ctx := q.ParentCtx
 		if ctx == nil {
 			ctx = context.Background()
 		}
-		return context.WithTimeout(ctx, q.QueryTimeout)
+		return context.WithTimeout(ctx, timeout)
 	}
 
 	if q.ParentCtx == nil {
@@ -1,10 +1,14 @@
 package pg
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
 )
 
 func Test_sprintQ(t *testing.T) {
@@ -51,3 +55,28 @@ func Test_sprintQ(t *testing.T) {
 		})
 	}
 }
+
+func TestQ_WithOpts_DefaultQueryTimeout(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	q := NewQ(nil, lggr, nil, WithDefaultQueryTimeout(time.Minute))
+
+	requireTimeout := func(t *testing.T, q Q, exp time.Duration) {
+		ctx, cancel := q.Context()
+		defer cancel()
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		require.InDelta(t, exp, time.Until(deadline), float64(time.Second))
+	}
+
+	requireTimeout(t, q, time.Minute)
+	requireTimeout(t, q.WithOpts(WithParentCtx(context.Background())), time.Minute)
+	requireTimeout(t, q.WithOpts(WithLongQueryTimeout()), LongQueryTimeout)
+
+	// a QueryTimeout set by an option is not kept by derived Qs
+	parent, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	inherited := q.WithOpts(WithParentCtxInheritTimeout(parent))
+	requireTimeout(t, inherited.WithOpts(), time.Minute)
+
+	requireTimeout(t, NewQ(nil, lggr, nil).WithOpts(), DefaultQueryTimeout)
+}
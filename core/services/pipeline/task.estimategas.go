@@ -90,7 +90,9 @@ func (t *EstimateGasLimitTask) Run(ctx context.Context, lggr logger.Logger, vars
 	if newExp > math.MaxInt32 || newExp < math.MinInt32 {
 		return Result{Error: ErrMultiplyOverlow}, retryableRunInfo()
 	}
-	gasLimitWithMultiplier := gasLimitDecimal.Mul(multiplier.Decimal()).Truncate(0).BigInt()
+	// Leave some headroom for code paths that use more gas than the estimated call
+	buffer := decimal.NewFromFloat(chain.Config().EvmGasCapacityBuffer())
+	gasLimitWithMultiplier := gasLimitDecimal.Mul(multiplier.Decimal()).Mul(buffer).Truncate(0).BigInt()
 	if !gasLimitWithMultiplier.IsUint64() {
 		return Result{Error: ErrInvalidMultiplier}, retryableRunInfo()
 	}
@@ -120,8 +120,11 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		With("gasTipCap", call.GasTipCap).
 		With("gasFeeCap", call.GasFeeCap)
 
+	callCtx, cancel := context.WithTimeout(ctx, chain.Config().EvmContractCallTimeout())
+	defer cancel()
+
 	start := time.Now()
-	resp, err := chain.Client().CallContract(ctx, call, nil)
+	resp, err := chain.Client().CallContract(callCtx, call, nil)
 	elapsed := time.Since(start)
 	if err != nil {
 		if t.ExtractRevertReason {
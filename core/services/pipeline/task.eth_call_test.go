@@ -1,8 +1,10 @@
 package pipeline_test
 
 import (
+	"context"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -24,6 +26,7 @@ import (
 	keystoremocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 func TestETHCallTask(t *testing.T) {
@@ -33,6 +36,7 @@ func TestETHCallTask(t *testing.T) {
 	var specGasLimit uint32 = 123
 	const gasLimit uint32 = 500_000
 	const drJobTypeGasLimit uint32 = 789
+	const contractCallTimeout = 3 * time.Second
 
 	tests := []struct {
 		name                  string
@@ -69,6 +73,29 @@ func TestETHCallTask(t *testing.T) {
 			},
 			[]byte("baz quux"), nil, "",
 		},
+		{
+			"happy with contract call deadline",
+			"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			"",
+			"$(foo)",
+			"",
+			"",
+			nil,
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"foo": []byte("foo bar"),
+			}),
+			nil,
+			func(ethClient *evmmocks.Client, config *pipelinemocks.Config) {
+				contractAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+				ethClient.
+					On("CallContract", mock.MatchedBy(func(ctx context.Context) bool {
+						deadline, ok := ctx.Deadline()
+						return ok && time.Until(deadline) <= contractCallTimeout
+					}), ethereum.CallMsg{To: &contractAddr, Gas: uint64(drJobTypeGasLimit), Data: []byte("foo bar")}, (*big.Int)(nil)).
+					Return([]byte("baz quux"), nil)
+			},
+			[]byte("baz quux"), nil, "",
+		},
 		{
 			"happy with gas limit per task",
 			"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
@@ -248,6 +275,7 @@ func TestETHCallTask(t *testing.T) {
 			cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
 				c.EVM[0].GasEstimator.LimitDefault = ptr(gasLimit)
 				c.EVM[0].GasEstimator.LimitJobType.DR = ptr(drJobTypeGasLimit)
+				c.EVM[0].ContractCallTimeout = models.MustNewDuration(contractCallTimeout)
 			})
 			lggr := logger.TestLogger(t)
 
@@ -1,6 +1,7 @@
 package pipeline_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -139,6 +140,49 @@ func TestETHTxTask(t *testing.T) {
 			},
 			nil, nil, "", pipeline.RunInfo{},
 		},
+		{
+			"happy (with estimated revenue)",
+			`[ $(fromAddr) ]`,
+			"$(toAddr)",
+			"$(data)",
+			"$(gasLimit)",
+			`{ "jobID": $(jobID), "estimatedRevenue": $(revenue) }`,
+			`0`,
+			"",
+			"",
+			nil,
+			false,
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"fromAddr": common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c"),
+				"toAddr":   common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF"),
+				"data":     []byte("foobar"),
+				"gasLimit": uint64(12345),
+				"jobID":    int32(321),
+				"revenue":  big.NewInt(4200),
+			}),
+			nil,
+			func(keyStore *keystoremocks.Eth, txManager *txmmocks.TxManager) {
+				from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+				to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+				data := []byte("foobar")
+				gasLimit := uint32(12345)
+				txMeta := &txmgr.EthTxMeta{
+					JobID:            &jid,
+					EstimatedRevenue: big.NewInt(4200),
+					FailOnRevert:     null.BoolFrom(false),
+				}
+				keyStore.On("GetRoundRobinAddress", testutils.FixtureChainID, from).Return(from, nil)
+				txManager.On("CreateEthTransaction", txmgr.NewTx{
+					FromAddress:    from,
+					ToAddress:      to,
+					EncodedPayload: data,
+					GasLimit:       gasLimit,
+					Meta:           txMeta,
+					Strategy:       txmgr.SendEveryStrategy{},
+				}).Return(txmgr.EthTx{}, nil)
+			},
+			nil, nil, "", pipeline.RunInfo{},
+		},
 		{
 			"happy (with vars 2)",
 			`$(fromAddrs)`,
@@ -64,7 +64,7 @@ func buildVrfUni(t *testing.T, db *sqlx.DB, cfg corecfg.GeneralConfig) vrfUniver
 	ec := eth_mocks.NewClient(t)
 	ec.On("ChainID").Return(testutils.FixtureChainID)
 	lggr := logger.TestLogger(t)
-	hb := headtracker.NewHeadBroadcaster(lggr)
+	hb := headtracker.NewHeadBroadcaster(lggr, evmtest.NewChainScopedConfig(t, cfg))
 
 	// Don't mock db interactions
 	prm := pipeline.NewORM(db, lggr, cfg)
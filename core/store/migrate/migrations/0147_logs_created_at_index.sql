@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX logs_idx_evm_id_created_at ON logs (evm_chain_id, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS logs_idx_evm_id_created_at;
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE evm_chain_config_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE,
+    key text NOT NULL,
+    old_value text,
+    new_value text,
+    changed_at timestamptz NOT NULL
+);
+CREATE INDEX idx_evm_chain_config_audit_log_chain_key ON evm_chain_config_audit_log (evm_chain_id, key, changed_at);
+CREATE TABLE solana_chain_config_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    solana_chain_id text NOT NULL REFERENCES solana_chains (id) ON DELETE CASCADE,
+    key text NOT NULL,
+    old_value text,
+    new_value text,
+    changed_at timestamptz NOT NULL
+);
+CREATE INDEX idx_solana_chain_config_audit_log_chain_key ON solana_chain_config_audit_log (solana_chain_id, key, changed_at);
+CREATE TABLE starknet_chain_config_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    starknet_chain_id text NOT NULL REFERENCES starknet_chains (id) ON DELETE CASCADE,
+    key text NOT NULL,
+    old_value text,
+    new_value text,
+    changed_at timestamptz NOT NULL
+);
+CREATE INDEX idx_starknet_chain_config_audit_log_chain_key ON starknet_chain_config_audit_log (starknet_chain_id, key, changed_at);
+CREATE TABLE terra_chain_config_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    terra_chain_id text NOT NULL REFERENCES terra_chains (id) ON DELETE CASCADE,
+    key text NOT NULL,
+    old_value text,
+    new_value text,
+    changed_at timestamptz NOT NULL
+);
+CREATE INDEX idx_terra_chain_config_audit_log_chain_key ON terra_chain_config_audit_log (terra_chain_id, key, changed_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE terra_chain_config_audit_log;
+DROP TABLE starknet_chain_config_audit_log;
+DROP TABLE solana_chain_config_audit_log;
+DROP TABLE evm_chain_config_audit_log;
+-- +goose StatementEnd
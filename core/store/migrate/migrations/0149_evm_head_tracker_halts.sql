@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE TABLE evm_head_tracker_halts (
+    evm_chain_id numeric(78,0) PRIMARY KEY REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    reason text NOT NULL,
+    created_at timestamptz NOT NULL
+);
+
+-- +goose Down
+DROP TABLE evm_head_tracker_halts;
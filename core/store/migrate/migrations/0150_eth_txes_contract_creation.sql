@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN contract_creation boolean NOT NULL DEFAULT FALSE;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_contract_creation_to_address CHECK (NOT contract_creation OR to_address = '\x0000000000000000000000000000000000000000');
+
+-- +goose Down
+ALTER TABLE eth_txes DROP CONSTRAINT chk_contract_creation_to_address;
+ALTER TABLE eth_txes DROP COLUMN contract_creation;
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"net/http"
 
+	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/gin-gonic/gin"
 )
@@ -28,6 +30,36 @@ func (cc *ConfigController) Show(c *gin.Context) {
 	jsonAPIResponse(c, cw, "config")
 }
 
+// ShowEVM returns the effective config of an EVM chain, with the source of
+// each value
+// Example:
+//
+//	"<application>/config/evm?evmChainID=1"
+func (cc *ConfigController) ShowEVM(c *gin.Context) {
+	chain, err := getChain(cc.App.GetChains().EVM, c.Query("evmChainID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	fields, err := evmconfig.EffectiveConfig(chain.Config())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	resources := make([]presenters.EVMChainConfigFieldResource, len(fields))
+	for i, f := range fields {
+		resources[i] = presenters.NewEVMChainConfigFieldResource(f)
+	}
+	jsonAPIResponse(c, resources, "evm_chain_config_field")
+}
+
 type ConfigV2Resource struct {
 	Config string `json:"config"`
 }
@@ -59,6 +59,7 @@ func (ekc *ETHKeysController) Index(c *gin.Context) {
 			ekc.setEthBalance(c.Request.Context(), state),
 			ekc.setLinkBalance(c.Request.Context(), state),
 			ekc.setKeyMaxGasPriceWei(state, key.Address),
+			ekc.setMaxOutstandingBalance(state),
 		)
 		if err != nil {
 			jsonAPIError(c, http.StatusInternalServerError, err)
@@ -126,6 +127,7 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 		ekc.setEthBalance(c.Request.Context(), state),
 		ekc.setLinkBalance(c.Request.Context(), state),
 		ekc.setKeyMaxGasPriceWei(state, key.Address),
+		ekc.setMaxOutstandingBalance(state),
 	)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
@@ -190,6 +192,7 @@ func (ekc *ETHKeysController) Update(c *gin.Context) {
 		ekc.setEthBalance(c.Request.Context(), state),
 		ekc.setLinkBalance(c.Request.Context(), state),
 		ekc.setKeyMaxGasPriceWei(state, key.Address),
+		ekc.setMaxOutstandingBalance(state),
 	)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
@@ -490,3 +493,27 @@ func (ekc *ETHKeysController) setKeyMaxGasPriceWei(state ethkey.State, keyAddres
 		return nil
 	}
 }
+
+// setMaxOutstandingBalance is a custom functional option for NewEthKeyResource
+// which gets the maximum outstanding balance from the chain config and sets it
+// on the resource.
+func (ekc *ETHKeysController) setMaxOutstandingBalance(state ethkey.State) presenters.NewETHKeyOption {
+	var maxBal *assets.Eth
+	chain, err := ekc.App.GetChains().EVM.Get(state.EVMChainID.ToInt())
+	if err == nil {
+		maxBal = chain.Config().EvmMaxOutstandingBalance()
+	}
+
+	return func(r *presenters.ETHKeyResource) error {
+		if errors.Is(errors.Cause(err), evm.ErrNoChains) {
+			return nil
+		}
+		if err != nil {
+			return errors.Errorf("error getting EVM Chain: %v", err)
+		}
+
+		r.MaxOutstandingBalance = maxBal
+
+		return nil
+	}
+}
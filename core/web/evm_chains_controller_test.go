@@ -41,6 +41,7 @@ func Test_EVMChainsController_Create(t *testing.T) {
 			BlockHistoryEstimatorBlockHistorySize: null.IntFrom(12),
 			EvmEIP1559DynamicFees:                 null.BoolFrom(false),
 			MinIncomingConfirmations:              null.IntFrom(10),
+			ChainAlias:                            null.StringFrom("Test Chain"),
 		}))
 	require.NoError(t, err)
 
@@ -61,6 +62,29 @@ func Test_EVMChainsController_Create(t *testing.T) {
 	assert.Equal(t, resource.Config.BlockHistoryEstimatorBlockHistorySize, dbChain.Cfg.BlockHistoryEstimatorBlockHistorySize)
 	assert.Equal(t, resource.Config.EvmEIP1559DynamicFees, dbChain.Cfg.EvmEIP1559DynamicFees)
 	assert.Equal(t, resource.Config.MinIncomingConfirmations, dbChain.Cfg.MinIncomingConfirmations)
+	assert.Equal(t, "Test Chain", resource.Alias)
+}
+
+func Test_EVMChainsController_Create_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	controller := setupEVMChainsControllerTestLegacy(t)
+
+	newChainId := utils.NewBig(testutils.NewRandomEVMChainID())
+
+	body, err := json.Marshal(web.NewCreateChainRequest(newChainId,
+		&types.ChainCfg{
+			ChainType:        null.StringFrom(string(corecfg.ChainOptimism)),
+			GasEstimatorMode: null.StringFrom("BlockHistory"),
+		}))
+	require.NoError(t, err)
+
+	resp, cleanup := controller.client.Post("/v2/chains/evm", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	_, err = controller.app.GetChains().EVM.ORM().Chain(*newChainId)
+	require.ErrorIs(t, err, sql.ErrNoRows)
 }
 
 func Test_EVMChainsController_Show(t *testing.T) {
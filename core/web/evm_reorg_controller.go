@@ -0,0 +1,45 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// EVMReorgController manages the head trackers of EVM chains which halted
+// after a re-org deeper than EvmMaxReorgDepth.
+type EVMReorgController struct {
+	App chainlink.Application
+}
+
+// Acknowledge resumes the head tracker of the chain with the given ID.
+// Example:
+// "POST <application>/chains/evm/:ID/reorg/acknowledge"
+func (rc *EVMReorgController) Acknowledge(c *gin.Context) {
+	chainSet := rc.App.GetChains().EVM
+	if chainSet == nil {
+		jsonAPIError(c, http.StatusBadRequest, ErrEVMNotEnabled)
+		return
+	}
+	var id utils.Big
+	if err := id.UnmarshalText([]byte(c.Param("ID"))); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	chain, err := chainSet.Get(id.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err = chain.HeadTracker().AcknowledgeReorg(c.Request.Context()); err != nil {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.ChainReorgAcknowledged, map[string]interface{}{"id": id.String()})
+	jsonAPIResponseWithStatus(c, nil, "chain", http.StatusNoContent)
+}
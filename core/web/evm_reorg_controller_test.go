@@ -0,0 +1,54 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	evmcfg "github.com/smartcontractkit/chainlink/core/chains/evm/config/v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func Test_EVMReorgController_Acknowledge(t *testing.T) {
+	t.Parallel()
+
+	chainID := utils.NewBig(testutils.NewRandomEVMChainID())
+	controller := setupEVMForwardersControllerTest(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM = evmcfg.EVMConfigs{
+			{ChainID: chainID, Enabled: ptr(true), Chain: evmcfg.DefaultsFrom(chainID, nil)},
+		}
+	})
+
+	testCases := []struct {
+		name           string
+		inputID        string
+		wantStatusCode int
+	}{
+		{
+			name:           "not halted",
+			inputID:        chainID.String(),
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "invalid id",
+			inputID:        "invalidid",
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "unknown chain",
+			inputID:        "234",
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			resp, cleanup := controller.client.Post("/v2/chains/evm/"+tc.inputID+"/reorg/acknowledge", nil)
+			t.Cleanup(cleanup)
+			assert.Equal(t, tc.wantStatusCode, resp.StatusCode)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
@@ -46,3 +47,41 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Rebroadcast replaces a stuck transaction with a new attempt at a bumped gas
+// price, using the same nonce and payload.
+// Example:
+//  "<application>/transactions/evm/:TxHash/rebroadcast"
+func (tc *TransactionsController) Rebroadcast(c *gin.Context) {
+	hash := common.HexToHash(c.Param("TxHash"))
+
+	ethTxAttempt, err := tc.App.TxmORM().FindEthTxAttempt(hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	chain, err := tc.App.GetChains().EVM.Get(ethTxAttempt.EthTx.EVMChainID.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	attempt, err := chain.TxManager().Rebroadcast(c.Request.Context(), hash)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("rebroadcast failed: %v", err))
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionRebroadcast, map[string]interface{}{
+		"previousHash": hash,
+		"hash":         attempt.Hash,
+		"ethTxID":      attempt.EthTxID,
+	})
+
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(attempt), "transaction")
+}
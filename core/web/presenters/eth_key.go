@@ -12,15 +12,16 @@ import (
 // representation of the address plus its ETH & LINK balances
 type ETHKeyResource struct {
 	JAID
-	EVMChainID     utils.Big    `json:"evmChainID"`
-	Address        string       `json:"address"`
-	NextNonce      int64        `json:"nextNonce"`
-	EthBalance     *assets.Eth  `json:"ethBalance"`
-	LinkBalance    *assets.Link `json:"linkBalance"`
-	Disabled       bool         `json:"disabled"`
-	CreatedAt      time.Time    `json:"createdAt"`
-	UpdatedAt      time.Time    `json:"updatedAt"`
-	MaxGasPriceWei utils.Big    `json:"maxGasPriceWei"`
+	EVMChainID            utils.Big    `json:"evmChainID"`
+	Address               string       `json:"address"`
+	NextNonce             int64        `json:"nextNonce"`
+	EthBalance            *assets.Eth  `json:"ethBalance"`
+	LinkBalance           *assets.Link `json:"linkBalance"`
+	Disabled              bool         `json:"disabled"`
+	CreatedAt             time.Time    `json:"createdAt"`
+	UpdatedAt             time.Time    `json:"updatedAt"`
+	MaxGasPriceWei        utils.Big    `json:"maxGasPriceWei"`
+	MaxOutstandingBalance *assets.Eth  `json:"maxOutstandingBalance,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
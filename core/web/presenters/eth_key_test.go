@@ -105,4 +105,38 @@ func TestETHKeyResource(t *testing.T) {
 	)
 
 	assert.JSONEq(t, expected, string(b))
+
+	r, err = NewETHKeyResource(key, state,
+		SetETHKeyEthBalance(assets.NewEth(1)),
+		SetETHKeyLinkBalance(assets.NewLinkFromJuels(1)),
+		SetETHKeyMaxGasPriceWei(*utils.NewBigI(12345)),
+	)
+	require.NoError(t, err)
+	r.MaxOutstandingBalance = assets.NewEth(5)
+	b, err = jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected = fmt.Sprintf(`
+	{
+		"data": {
+			"type":"eTHKeys",
+			"id":"%s",
+			"attributes":{
+				"address":"%s",
+				"evmChainID":"42",
+				"nextNonce": 99,
+				"ethBalance":"1",
+				"linkBalance":"1",
+				"disabled":true,
+				"createdAt":"2000-01-01T00:00:00Z",
+				"updatedAt":"2000-01-01T00:00:00Z",
+				"maxGasPriceWei":"12345",
+				"maxOutstandingBalance":"5"
+			}
+		}
+	}`,
+		addressStr, addressStr,
+	)
+
+	assert.JSONEq(t, expected, string(b))
 }
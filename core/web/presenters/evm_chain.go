@@ -5,6 +5,7 @@ import (
 
 	"gopkg.in/guregu/null.v4"
 
+	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -12,6 +13,7 @@ import (
 // EVMChainResource is an EVM chain JSONAPI resource.
 type EVMChainResource struct {
 	chainResource[*evmtypes.ChainCfg]
+	Alias string `json:"alias"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -21,13 +23,38 @@ func (r EVMChainResource) GetName() string {
 
 // NewEVMChainResource returns a new EVMChainResource for chain.
 func NewEVMChainResource(chain evmtypes.DBChain) EVMChainResource {
-	return EVMChainResource{chainResource[*evmtypes.ChainCfg]{
-		JAID:      NewJAIDInt64(chain.ID.ToInt().Int64()),
-		Config:    chain.Cfg,
-		Enabled:   chain.Enabled,
-		CreatedAt: chain.CreatedAt,
-		UpdatedAt: chain.UpdatedAt,
-	}}
+	return EVMChainResource{
+		chainResource: chainResource[*evmtypes.ChainCfg]{
+			JAID:      NewJAIDInt64(chain.ID.ToInt().Int64()),
+			Config:    chain.Cfg,
+			Enabled:   chain.Enabled,
+			CreatedAt: chain.CreatedAt,
+			UpdatedAt: chain.UpdatedAt,
+		},
+		Alias: evmconfig.ChainAlias(chain.ID.ToInt(), chain.Cfg),
+	}
+}
+
+// EVMChainConfigFieldResource is a JSONAPI resource for the effective value of
+// a single config field of an EVM chain.
+type EVMChainConfigFieldResource struct {
+	JAID
+	Value  string `json:"value"`
+	Source string `json:"source"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EVMChainConfigFieldResource) GetName() string {
+	return "evm_chain_config_field"
+}
+
+// NewEVMChainConfigFieldResource returns a new EVMChainConfigFieldResource for field.
+func NewEVMChainConfigFieldResource(field evmconfig.ConfigField) EVMChainConfigFieldResource {
+	return EVMChainConfigFieldResource{
+		JAID:   NewJAID(field.Name),
+		Value:  field.Value,
+		Source: string(field.Source),
+	}
 }
 
 // EVMNodeResource is an EVM node JSONAPI resource.
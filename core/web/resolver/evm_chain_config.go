@@ -152,6 +152,14 @@ func (r *ChainConfigResolver) EthTxResendAfterThreshold() *string {
 	return nil
 }
 
+func (r *ChainConfigResolver) EvmChainLocked() *bool {
+	if r.cfg.EvmChainLocked.Valid {
+		return r.cfg.EvmChainLocked.Ptr()
+	}
+
+	return nil
+}
+
 func (r *ChainConfigResolver) EvmEIP1559DynamicFees() *bool {
 	if r.cfg.EvmEIP1559DynamicFees.Valid {
 		return r.cfg.EvmEIP1559DynamicFees.Ptr()
@@ -426,6 +434,14 @@ func (r *ChainConfigResolver) ChainType() *ChainType {
 	return nil
 }
 
+func (r *ChainConfigResolver) ChainAlias() *string {
+	if r.cfg.ChainAlias.Valid {
+		return r.cfg.ChainAlias.Ptr()
+	}
+
+	return nil
+}
+
 func (r *ChainConfigResolver) MinIncomingConfirmations() *int32 {
 	if r.cfg.MinIncomingConfirmations.Valid {
 		val := r.cfg.MinIncomingConfirmations.Int64
@@ -447,6 +463,14 @@ func (r *ChainConfigResolver) MinimumContractPayment() *string {
 	return nil
 }
 
+func (r *ChainConfigResolver) OCRKeyBundleID() *string {
+	if r.cfg.OCRKeyBundleID.Valid {
+		return r.cfg.OCRKeyBundleID.Ptr()
+	}
+
+	return nil
+}
+
 func (r *ChainConfigResolver) OCRObservationTimeout() *string {
 	if r.cfg.OCRObservationTimeout != nil {
 		timeout := r.cfg.OCRObservationTimeout.Duration().String()
@@ -491,6 +515,7 @@ type ChainConfigInput struct {
 	BlockHistoryEstimatorBlockHistorySize *int32
 	EthTxReaperThreshold                  *string
 	EthTxResendAfterThreshold             *string
+	EvmChainLocked                        *bool
 	EvmEIP1559DynamicFees                 *bool
 	EvmFinalityDepth                      *int32
 	EvmGasBumpPercent                     *int32
@@ -517,8 +542,10 @@ type ChainConfigInput struct {
 	FlagsContractAddress                  *string
 	GasEstimatorMode                      *GasEstimatorMode
 	ChainType                             *ChainType
+	ChainAlias                            *string
 	MinIncomingConfirmations              *int32
 	MinimumContractPayment                *string
+	OCRKeyBundleID                        *string
 	OCRObservationTimeout                 *string
 	LinkContractAddress                   *string
 }
@@ -558,6 +585,10 @@ func ToChainConfig(input ChainConfigInput) (*types.ChainCfg, map[string]string)
 		}
 	}
 
+	if input.EvmChainLocked != nil {
+		cfg.EvmChainLocked = null.BoolFrom(*input.EvmChainLocked)
+	}
+
 	if input.EvmEIP1559DynamicFees != nil {
 		cfg.EvmEIP1559DynamicFees = null.BoolFrom(*input.EvmEIP1559DynamicFees)
 	}
@@ -692,6 +723,14 @@ func ToChainConfig(input ChainConfigInput) (*types.ChainCfg, map[string]string)
 		cfg.ChainType = null.StringFrom(FromChainType(*input.ChainType))
 	}
 
+	if input.ChainAlias != nil {
+		if *input.ChainAlias == "" {
+			inputErrs["ChainAlias"] = "invalid value"
+		} else {
+			cfg.ChainAlias = null.StringFrom(*input.ChainAlias)
+		}
+	}
+
 	if input.MinIncomingConfirmations != nil {
 		cfg.MinIncomingConfirmations = null.IntFrom(int64(*input.MinIncomingConfirmations))
 	}
@@ -705,6 +744,14 @@ func ToChainConfig(input ChainConfigInput) (*types.ChainCfg, map[string]string)
 		}
 	}
 
+	if input.OCRKeyBundleID != nil {
+		if _, err := models.Sha256HashFromHex(*input.OCRKeyBundleID); err != nil {
+			inputErrs["OCRKeyBundleID"] = "invalid value"
+		} else {
+			cfg.OCRKeyBundleID = null.StringFrom(*input.OCRKeyBundleID)
+		}
+	}
+
 	if input.OCRObservationTimeout != nil {
 		d, err := models.ParseDuration(*input.OCRObservationTimeout)
 		if err != nil {
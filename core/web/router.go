@@ -268,6 +268,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/config", cc.Show)
 		authv2.PATCH("/config", auth.RequiresAdminRole(cc.Patch))
 		authv2.GET("/config/v2", auth.RequiresAdminRole(cc.Dump))
+		authv2.GET("/config/evm", cc.ShowEVM)
 
 		tas := TxAttemptsController{app}
 		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
@@ -276,6 +277,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
+		authv2.POST("/transactions/evm/:TxHash/rebroadcast", auth.RequiresAdminRole(txs.Rebroadcast))
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
@@ -390,6 +392,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			chains.DELETE(chain.path+"/:ID", auth.RequiresEditRole(chain.cc.Delete))
 		}
 
+		erc := EVMReorgController{app}
+		chains.POST("evm/:ID/reorg/acknowledge", auth.RequiresAdminRole(erc.Acknowledge))
+
 		nodes := authv2.Group("nodes")
 		for _, chain := range []struct {
 			path string